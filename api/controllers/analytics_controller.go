@@ -0,0 +1,181 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// AnalyticsController предоставляет отчёты по просмотрам статей через HTTP API.
+type AnalyticsController struct {
+	service *services.AnalyticsService
+}
+
+// NewAnalyticsController создаёт новый экземпляр AnalyticsController.
+func NewAnalyticsController(service *services.AnalyticsService) *AnalyticsController {
+	return &AnalyticsController{service: service}
+}
+
+// @Summary Просмотры статьи по дням
+// @Description Возвращает количество уникальных просмотров статьи за каждый день периода (UTC).
+// @Description Доступно автору статьи и администраторам.
+// @Tags Статистика
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Param from query string false "Начало периода (YYYY-MM-DD)"
+// @Param to query string false "Конец периода включительно (YYYY-MM-DD)"
+// @Security BearerAuth
+// @Success 200 {object} dto.ArticleViewsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /analytics/articles/{id}/views [get]
+func (c *AnalyticsController) GetArticleViews(ctx *gin.Context) {
+	articleID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	query, ok := bindAnalyticsQuery(ctx)
+	if !ok {
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	views, err := c.service.GetArticleViews(uint(articleID), query, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToArticleViewsResponse(views))
+}
+
+// @Summary Источники переходов на статью
+// @Description Возвращает домены, с которых переходили на статью, по убыванию количества просмотров.
+// @Description Доступно автору статьи и администраторам.
+// @Tags Статистика
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Param from query string false "Начало периода (YYYY-MM-DD)"
+// @Param to query string false "Конец периода включительно (YYYY-MM-DD)"
+// @Param limit query int false "Количество источников (по умолчанию 10, не более 100)"
+// @Security BearerAuth
+// @Success 200 {object} dto.ReferrersResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /analytics/articles/{id}/referrers [get]
+func (c *AnalyticsController) GetArticleReferrers(ctx *gin.Context) {
+	articleID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	query, ok := bindAnalyticsQuery(ctx)
+	if !ok {
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	period, referrers, err := c.service.GetArticleReferrers(uint(articleID), query, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToReferrersResponse(period, referrers))
+}
+
+// @Summary Самые просматриваемые статьи
+// @Description Возвращает статьи с наибольшим количеством уникальных просмотров за период.
+// @Description Автор видит только свои статьи, администратор — все.
+// @Tags Статистика
+// @Produce json
+// @Param from query string false "Начало периода (YYYY-MM-DD)"
+// @Param to query string false "Конец периода включительно (YYYY-MM-DD)"
+// @Param limit query int false "Количество статей (по умолчанию 10, не более 100)"
+// @Security BearerAuth
+// @Success 200 {object} dto.TopArticlesResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /analytics/top [get]
+func (c *AnalyticsController) GetTopArticles(ctx *gin.Context) {
+	query, ok := bindAnalyticsQuery(ctx)
+	if !ok {
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	period, top, err := c.service.GetTopArticles(query, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToTopArticlesResponse(period, top))
+}
+
+// @Summary Источники переходов
+// @Description Возвращает домены, с которых переходили на статьи, по убыванию количества просмотров.
+// @Description Автор видит переходы на свои статьи, администратор — на все.
+// @Tags Статистика
+// @Produce json
+// @Param from query string false "Начало периода (YYYY-MM-DD)"
+// @Param to query string false "Конец периода включительно (YYYY-MM-DD)"
+// @Param limit query int false "Количество источников (по умолчанию 10, не более 100)"
+// @Security BearerAuth
+// @Success 200 {object} dto.ReferrersResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /analytics/referrers [get]
+func (c *AnalyticsController) GetReferrers(ctx *gin.Context) {
+	query, ok := bindAnalyticsQuery(ctx)
+	if !ok {
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	period, referrers, err := c.service.GetReferrers(query, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToReferrersResponse(period, referrers))
+}
+
+// bindAnalyticsQuery разбирает параметры отчёта; при ошибке отправляет 400.
+func bindAnalyticsQuery(ctx *gin.Context) (dto.AnalyticsQuery, bool) {
+	var query dto.AnalyticsQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return query, false
+	}
+	return query, true
+}
+
+// respondError преобразует ошибку сервиса статистики в HTTP-ответ.
+func (c *AnalyticsController) respondError(ctx *gin.Context, err error) {
+	switch err.Error() {
+	case apperrors.ErrInvalidDateRange:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidDateRange})
+	case apperrors.ErrAccessDenied:
+		ctx.JSON(http.StatusForbidden, gin.H{"error": apperrors.ErrAccessDenied})
+	case apperrors.ErrArticleNotFound:
+		ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrArticleNotFound})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+	}
+}
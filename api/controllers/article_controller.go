@@ -1,9 +1,13 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/blocks"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
 	"github.com/AsterOzlob/content_managment_api/internal/dto"
 	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
 	"github.com/AsterOzlob/content_managment_api/internal/services"
@@ -14,12 +18,28 @@ import (
 
 // ArticleController предоставляет методы для управления статьями через HTTP API.
 type ArticleController struct {
-	service *services.ArticleService
+	service      *services.ArticleService
+	analytics    *services.AnalyticsService
+	readingLists *services.ReadingListService
+	locales      *config.LocaleConfig
+	site         *config.SiteConfig
 }
 
 // NewArticleController создаёт новый экземпляр ArticleController.
-func NewArticleController(service *services.ArticleService) *ArticleController {
-	return &ArticleController{service: service}
+func NewArticleController(
+	service *services.ArticleService,
+	analytics *services.AnalyticsService,
+	readingLists *services.ReadingListService,
+	locales *config.LocaleConfig,
+	site *config.SiteConfig,
+) *ArticleController {
+	return &ArticleController{
+		service:      service,
+		analytics:    analytics,
+		readingLists: readingLists,
+		locales:      locales,
+		site:         site,
+	}
 }
 
 // @Summary Создать новую статью
@@ -31,6 +51,7 @@ func NewArticleController(service *services.ArticleService) *ArticleController {
 // @Security BearerAuth
 // @Success 201 {object} dto.ArticleResponse
 // @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
 // @Router /articles [post]
 func (c *ArticleController) CreateArticle(ctx *gin.Context) {
 	var input dto.ArticleInput
@@ -45,33 +66,87 @@ func (c *ArticleController) CreateArticle(ctx *gin.Context) {
 	}
 	article, err := c.service.CreateArticle(input, userID)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		var blockErr *blocks.ValidationError
+		if errors.As(err, &blockErr) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidContentBlocks, "details": blockErr.Error()})
+			return
+		}
+		switch err.Error() {
+		case apperrors.ErrCategoryNotFound, apperrors.ErrTagNotFound, apperrors.ErrUnsupportedLocale,
+			apperrors.ErrInvalidOGImage:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case apperrors.ErrUserBanned:
+			ctx.JSON(http.StatusForbidden, gin.H{"error": apperrors.ErrUserBanned})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
 		return
 	}
-	ctx.JSON(http.StatusCreated, mappers.MapToArticleResponse(article))
+	ctx.JSON(http.StatusCreated, c.withSEO(mappers.MapToArticleResponse(article), article))
 }
 
 // @Summary Получить все статьи
 // @Description Возвращает список всех статей с медиафайлами и комментариями.
+// @Description Поддерживает фильтрацию по тегу, рубрике (включая вложенные рубрики), автору и объёму текста,
+// @Description а также сортировку по дате и длине статьи. Объём оценивается по исходному тексту статьи.
+// @Description Текст возвращается на локали из параметра lang или заголовка Accept-Language
+// @Description с откатом на локаль по умолчанию и исходный текст.
+// @Description Черновики возвращаются только их авторам, редакторам, модераторам и администраторам.
 // @Tags Статьи
 // @Produce json
+// @Param tag query string false "Slug тега"
+// @Param category query string false "Slug рубрики"
+// @Param author query int false "ID автора"
+// @Param min_words query int false "Минимальное количество слов"
+// @Param max_words query int false "Максимальное количество слов"
+// @Param min_reading_time query int false "Минимальное время чтения в минутах"
+// @Param max_reading_time query int false "Максимальное время чтения в минутах"
+// @Param sort query string false "Сортировка" Enums(newest, oldest, shortest, longest)
+// @Param lang query string false "Локаль (например, ru или en)"
+// @Param Accept-Language header string false "Предпочитаемые локали"
 // @Success 200 {array} dto.ArticleResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /articles [get]
 func (c *ArticleController) GetAllArticles(ctx *gin.Context) {
-	articles, err := c.service.GetAllArticles()
+	var query dto.ArticleListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleFilter})
+		return
+	}
+	userID, _ := utils.GetUserIDFromContext(ctx)
+	userRoles, _ := utils.GetUserRolesFromContext(ctx)
+	articles, err := c.service.GetAllArticles(query, userID, userRoles)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		switch err.Error() {
+		case apperrors.ErrCategoryNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrCategoryNotFound})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
 		return
 	}
-	ctx.JSON(http.StatusOK, mappers.MapToArticleListResponse(articles))
+	locale := utils.ResolveLocale(ctx, c.locales.Supported, c.locales.Default)
+	ctx.Header("Vary", "Accept-Language")
+	responses := mappers.MapToLocalizedArticleListResponse(articles, locale, c.locales.Default)
+	for i, response := range responses {
+		c.withSEO(response, articles[i])
+	}
+	markSaved(c.readingLists, userID, responses...)
+	ctx.JSON(http.StatusOK, responses)
 }
 
 // @Summary Получить статью по ID
 // @Description Возвращает статью по её уникальному идентификатору.
+// @Description Текст возвращается на локали из параметра lang или заголовка Accept-Language
+// @Description с откатом на локаль по умолчанию и исходный текст.
+// @Description Черновик доступен только автору, редакторам, модераторам и администраторам.
 // @Tags Статьи
 // @Produce json
 // @Param id path uint true "ID статьи"
+// @Param lang query string false "Локаль (например, ru или en)"
+// @Param Accept-Language header string false "Предпочитаемые локали"
 // @Success 200 {object} dto.ArticleResponse
 // @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
@@ -84,7 +159,51 @@ func (c *ArticleController) GetArticleByID(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
 		return
 	}
-	article, err := c.service.GetArticleByID(uint(id))
+	userID, _ := utils.GetUserIDFromContext(ctx)
+	userRoles, _ := utils.GetUserRolesFromContext(ctx)
+	article, err := c.service.GetArticleByID(uint(id), userID, userRoles)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrArticleNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrArticleNotFound})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	locale := utils.ResolveLocale(ctx, c.locales.Supported, c.locales.Default)
+	response := c.withSEO(mappers.MapToLocalizedArticleResponse(article, locale, c.locales.Default), article)
+	markSaved(c.readingLists, userID, response)
+	ctx.Header("Vary", "Accept-Language")
+	ctx.Header("Content-Language", response.Locale)
+	ctx.JSON(http.StatusOK, response)
+	c.analytics.RecordView(article, userID, ctx.ClientIP(), ctx.Request.UserAgent(), ctx.Request.Referer())
+}
+
+// @Summary Получить социальную карточку статьи
+// @Description Возвращает данные для превью статьи в соцсетях и мессенджерах (Open Graph):
+// @Description заголовок, описание, канонический URL и изображение.
+// @Description Текст возвращается на локали из параметра lang или заголовка Accept-Language.
+// @Tags Статьи
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Param lang query string false "Локаль (например, ru или en)"
+// @Param Accept-Language header string false "Предпочитаемые локали"
+// @Success 200 {object} dto.PreviewCardResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /articles/{id}/preview-card [get]
+func (c *ArticleController) GetPreviewCard(ctx *gin.Context) {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	userID, _ := utils.GetUserIDFromContext(ctx)
+	userRoles, _ := utils.GetUserRolesFromContext(ctx)
+	article, err := c.service.GetArticleByID(uint(id), userID, userRoles)
 	if err != nil {
 		switch err.Error() {
 		case apperrors.ErrArticleNotFound:
@@ -94,7 +213,11 @@ func (c *ArticleController) GetArticleByID(ctx *gin.Context) {
 		}
 		return
 	}
-	ctx.JSON(http.StatusOK, mappers.MapToArticleResponse(article))
+	locale := utils.ResolveLocale(ctx, c.locales.Supported, c.locales.Default)
+	response := c.withSEO(mappers.MapToLocalizedArticleResponse(article, locale, c.locales.Default), article)
+	ctx.Header("Vary", "Accept-Language")
+	ctx.Header("Content-Language", response.Locale)
+	ctx.JSON(http.StatusOK, mappers.MapToPreviewCard(response, article, c.site))
 }
 
 // @Summary Обновить статью
@@ -135,7 +258,15 @@ func (c *ArticleController) UpdateArticle(ctx *gin.Context) {
 	}
 	article, err := c.service.UpdateArticle(uint(id), input, userID, userRoles)
 	if err != nil {
+		var blockErr *blocks.ValidationError
+		if errors.As(err, &blockErr) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidContentBlocks, "details": blockErr.Error()})
+			return
+		}
 		switch err.Error() {
+		case apperrors.ErrCategoryNotFound, apperrors.ErrTagNotFound, apperrors.ErrUnsupportedLocale,
+			apperrors.ErrInvalidOGImage:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		case apperrors.ErrAccessDenied:
 			ctx.JSON(http.StatusForbidden, gin.H{"error": apperrors.ErrAccessDenied})
 		case apperrors.ErrArticleNotFound:
@@ -145,7 +276,9 @@ func (c *ArticleController) UpdateArticle(ctx *gin.Context) {
 		}
 		return
 	}
-	ctx.JSON(http.StatusOK, mappers.MapToArticleResponse(article))
+	response := c.withSEO(mappers.MapToArticleResponse(article), article)
+	markSaved(c.readingLists, userID, response)
+	ctx.JSON(http.StatusOK, response)
 }
 
 // @Summary Удалить статью
@@ -191,3 +324,21 @@ func (c *ArticleController) DeleteArticle(ctx *gin.Context) {
 	}
 	ctx.JSON(http.StatusOK, gin.H{"message": "article deleted successfully"})
 }
+
+// withSEO дополняет ответ SEO-метаданными статьи.
+func (c *ArticleController) withSEO(response *dto.ArticleResponse, article *models.Article) *dto.ArticleResponse {
+	response.SEO = mappers.MapToArticleSEO(response, article, c.site)
+	return response
+}
+
+// markSaved отмечает статьи, которые текущий пользователь сохранил в закладки.
+func markSaved(readingLists *services.ReadingListService, userID uint, responses ...*dto.ArticleResponse) {
+	ids := make([]uint, 0, len(responses))
+	for _, response := range responses {
+		ids = append(ids, response.ID)
+	}
+	saved := readingLists.SavedArticleIDs(userID, ids)
+	for _, response := range responses {
+		response.Saved = saved[response.ID]
+	}
+}
@@ -28,6 +28,7 @@ func NewAuthController(service *services.AuthService) *AuthController {
 // @Param input body dto.AuthInput true "Данные регистрации"
 // @Success 201 {object} dto.AuthResponse "Пользователь успешно зарегистрирован"
 // @Failure 400 {object} map[string]string "Неверные входные данные"
+// @Failure 409 {object} map[string]string "Email или имя пользователя уже заняты, в том числе удалённым пользователем"
 // @Failure 500 {object} map[string]string "Внутренняя ошибка сервера"
 // @Router /users/signup [post]
 func (c *AuthController) SignUp(ctx *gin.Context) {
@@ -64,6 +65,7 @@ func (c *AuthController) SignUp(ctx *gin.Context) {
 // @Param input body dto.AuthInput true "Учётные данные"
 // @Success 200 {object} dto.AuthResponse "Аутентификация успешна"
 // @Failure 401 {object} map[string]string "Неверные учётные данные"
+// @Failure 403 {object} map[string]string "Пользователь заблокирован"
 // @Failure 500 {object} map[string]string "Внутренняя ошибка сервера"
 // @Router /users/login [post]
 func (c *AuthController) Login(ctx *gin.Context) {
@@ -81,6 +83,8 @@ func (c *AuthController) Login(ctx *gin.Context) {
 		switch err.Error() {
 		case apperrors.ErrInvalidCredentials:
 			ctx.JSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrInvalidCredentials})
+		case apperrors.ErrUserBanned:
+			ctx.JSON(http.StatusForbidden, gin.H{"error": apperrors.ErrUserBanned})
 		default:
 			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
 		}
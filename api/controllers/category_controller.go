@@ -0,0 +1,176 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// CategoryController предоставляет методы для управления рубриками через HTTP API.
+type CategoryController struct {
+	service *services.CategoryService
+}
+
+// NewCategoryController создаёт новый экземпляр CategoryController.
+func NewCategoryController(service *services.CategoryService) *CategoryController {
+	return &CategoryController{service: service}
+}
+
+// @Summary Создать рубрику
+// @Description Создает новую рубрику, при необходимости вложенную в родительскую.
+// @Tags Рубрики
+// @Accept json
+// @Produce json
+// @Param category body dto.CategoryInput true "Данные рубрики"
+// @Security BearerAuth
+// @Success 201 {object} dto.CategoryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /categories [post]
+func (c *CategoryController) CreateCategory(ctx *gin.Context) {
+	var input dto.CategoryInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	category, err := c.service.CreateCategory(input)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrCategoryNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrCategoryNotFound})
+		case apperrors.ErrInvalidSlug:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidSlug})
+		case apperrors.ErrCategoryAlreadyExists:
+			ctx.JSON(http.StatusConflict, gin.H{"error": apperrors.ErrCategoryAlreadyExists})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusCreated, mappers.MapToCategoryResponse(category))
+}
+
+// @Summary Получить дерево рубрик
+// @Description Возвращает все рубрики в виде дерева.
+// @Tags Рубрики
+// @Produce json
+// @Success 200 {array} dto.CategoryResponse
+// @Failure 500 {object} map[string]string
+// @Router /categories [get]
+func (c *CategoryController) GetCategoryTree(ctx *gin.Context) {
+	categories, err := c.service.GetAllCategories()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToCategoryTreeResponse(categories))
+}
+
+// @Summary Получить рубрику по ID
+// @Description Возвращает рубрику по её уникальному идентификатору.
+// @Tags Рубрики
+// @Produce json
+// @Param id path uint true "ID рубрики"
+// @Success 200 {object} dto.CategoryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /categories/{id} [get]
+func (c *CategoryController) GetCategoryByID(ctx *gin.Context) {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidCategoryID})
+		return
+	}
+	category, err := c.service.GetCategoryByID(uint(id))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrCategoryNotFound})
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToCategoryResponse(category))
+}
+
+// @Summary Обновить рубрику
+// @Description Обновляет рубрику и её положение в дереве.
+// @Tags Рубрики
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID рубрики"
+// @Param category body dto.CategoryInput true "Обновлённые данные рубрики"
+// @Security BearerAuth
+// @Success 200 {object} dto.CategoryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /categories/{id} [put]
+func (c *CategoryController) UpdateCategory(ctx *gin.Context) {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidCategoryID})
+		return
+	}
+	var input dto.CategoryInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	category, err := c.service.UpdateCategory(uint(id), input)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrCategoryNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrCategoryNotFound})
+		case apperrors.ErrCategoryCycle:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrCategoryCycle})
+		case apperrors.ErrInvalidSlug:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidSlug})
+		case apperrors.ErrCategoryAlreadyExists:
+			ctx.JSON(http.StatusConflict, gin.H{"error": apperrors.ErrCategoryAlreadyExists})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToCategoryResponse(category))
+}
+
+// @Summary Удалить рубрику
+// @Description Удаляет рубрику без дочерних рубрик. Статьи рубрики остаются без основной рубрики.
+// @Tags Рубрики
+// @Produce json
+// @Param id path uint true "ID рубрики"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /categories/{id} [delete]
+func (c *CategoryController) DeleteCategory(ctx *gin.Context) {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidCategoryID})
+		return
+	}
+	if err := c.service.DeleteCategory(uint(id)); err != nil {
+		switch err.Error() {
+		case apperrors.ErrCategoryNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrCategoryNotFound})
+		case apperrors.ErrCategoryHasChildren:
+			ctx.JSON(http.StatusConflict, gin.H{"error": apperrors.ErrCategoryHasChildren})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "category deleted successfully"})
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
 	"github.com/AsterOzlob/content_managment_api/internal/dto"
 	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
 	"github.com/AsterOzlob/content_managment_api/internal/services"
@@ -24,6 +25,9 @@ func NewCommentController(service *services.CommentService) *CommentController {
 
 // @Summary Добавить комментарий к статье
 // @Description Добавляет новый комментарий к статье по её ID.
+// @Description Если для статьи включена премодерация, комментарий нового пользователя создаётся в статусе pending
+// @Description и становится виден остальным читателям только после одобрения модератором.
+// @Description Ответить можно только на видимый комментарий той же статьи в незакрытой ветке.
 // @Tags Комментарии
 // @Accept json
 // @Produce json
@@ -32,6 +36,9 @@ func NewCommentController(service *services.CommentService) *CommentController {
 // @Security BearerAuth
 // @Success 201 {object} dto.CommentResponse
 // @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 422 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /articles/{id}/comments [post]
 func (c *CommentController) AddCommentToArticle(ctx *gin.Context) {
@@ -48,27 +55,41 @@ func (c *CommentController) AddCommentToArticle(ctx *gin.Context) {
 		return
 	}
 
-	userID, err := utils.GetUserIDFromContext(ctx)
-	if err != nil {
-		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserNotAuthenticated})
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
 		return
 	}
 
-	comment, err := c.service.AddCommentToArticle(uint(articleID), input, userID)
+	comment, err := c.service.AddCommentToArticle(uint(articleID), input, userID, userRoles)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		switch err.Error() {
+		case apperrors.ErrArticleNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrArticleNotFound})
+		case apperrors.ErrUserBanned, apperrors.ErrCommentThreadLocked:
+			ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		case apperrors.ErrParentCommentNotFound, apperrors.ErrParentCommentMismatch:
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
 		return
 	}
 	ctx.JSON(http.StatusCreated, mappers.MapToCommentResponse(comment))
 }
 
 // @Summary Получить комментарии по ID статьи
-// @Description Возвращает все комментарии для указанной статьи, включая вложенные.
+// @Description Возвращает страницу веток комментариев к статье: комментарии верхнего уровня с ответами
+// @Description на COMMENT_MAX_DEPTH уровней вглубь. Если reply_count комментария больше количества
+// @Description загруженных ответов, остальные подгружаются через /comments/{id}/replies.
+// @Description Неодобренные комментарии видны только их автору, модераторам и администраторам.
 // @Tags Комментарии
 // @Produce json
 // @Param id path uint true "ID статьи"
+// @Param sort query string false "Порядок сортировки: newest, oldest или top (по умолчанию newest)"
+// @Param limit query int false "Количество веток (по умолчанию 20, максимум 100)"
+// @Param offset query int false "Смещение"
 // @Security BearerAuth
-// @Success 200 {array} dto.CommentResponse
+// @Success 200 {object} dto.CommentThreadResponse
 // @Failure 400 {object} map[string]string
 // @Failure 404 {object} map[string]string
 // @Failure 500 {object} map[string]string
@@ -80,8 +101,23 @@ func (c *CommentController) GetCommentsByArticleID(ctx *gin.Context) {
 		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
 		return
 	}
+	var query dto.CommentThreadQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	limit, offset, err := utils.GetPaginationFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
 
-	comments, err := c.service.GetCommentsByArticleID(uint(articleID))
+	comments, total, err := c.service.GetCommentsByArticleID(uint(articleID), query.Sort, limit, offset, userID, userRoles)
 	if err != nil {
 		switch err.Error() {
 		case apperrors.ErrArticleNotFound:
@@ -91,11 +127,64 @@ func (c *CommentController) GetCommentsByArticleID(ctx *gin.Context) {
 		}
 		return
 	}
-	ctx.JSON(http.StatusOK, mappers.MapToCommentListResponse(comments))
+	ctx.JSON(http.StatusOK, mappers.MapToCommentThreadResponse(comments, total))
+}
+
+// @Summary Получить ответы на комментарий
+// @Description Возвращает страницу ответов на комментарий с их ответами на COMMENT_MAX_DEPTH уровней вглубь.
+// @Description Используется для подгрузки ответов, не вошедших в ветку.
+// @Tags Комментарии
+// @Produce json
+// @Param id path uint true "ID комментария"
+// @Param sort query string false "Порядок сортировки: newest, oldest или top (по умолчанию newest)"
+// @Param limit query int false "Количество ответов (по умолчанию 20, максимум 100)"
+// @Param offset query int false "Смещение"
+// @Security BearerAuth
+// @Success 200 {object} dto.CommentThreadResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /comments/{id}/replies [get]
+func (c *CommentController) GetReplies(ctx *gin.Context) {
+	commentID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidCommentID})
+		return
+	}
+	var query dto.CommentThreadQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	limit, offset, err := utils.GetPaginationFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+
+	comments, total, err := c.service.GetReplies(uint(commentID), query.Sort, limit, offset, userID, userRoles)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrCommentNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrCommentNotFound})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToCommentThreadResponse(comments, total))
 }
 
 // @Summary Обновить комментарий
 // @Description Обновляет существующий комментарий по его ID, если пользователь — владелец, модератор или администратор.
+// @Description Автор может редактировать комментарий только в течение COMMENT_EDIT_WINDOW после создания.
+// @Description Прежний текст сохраняется в истории правок, а комментарий получает отметку edited.
+// @Description Новый текст проверяется спам-фильтром и премодерацией: одобренный комментарий может вернуться в статус pending или spam.
 // @Tags Комментарии
 // @Accept json
 // @Produce json
@@ -112,7 +201,7 @@ func (c *CommentController) UpdateComment(ctx *gin.Context) {
 	commentIDStr := ctx.Param("id")
 	commentID, err := strconv.ParseUint(commentIDStr, 10, 64)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid comment ID"})
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidCommentID})
 		return
 	}
 
@@ -139,8 +228,8 @@ func (c *CommentController) UpdateComment(ctx *gin.Context) {
 		switch err.Error() {
 		case apperrors.ErrCommentNotFound:
 			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrCommentNotFound})
-		case apperrors.ErrAccessDenied:
-			ctx.JSON(http.StatusForbidden, gin.H{"error": apperrors.ErrAccessDenied})
+		case apperrors.ErrAccessDenied, apperrors.ErrCommentEditExpired:
+			ctx.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
 		default:
 			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
 		}
@@ -149,21 +238,56 @@ func (c *CommentController) UpdateComment(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, mappers.MapToCommentResponse(comment))
 }
 
+// @Summary Получить историю правок комментария
+// @Description Возвращает прежние версии текста комментария, начиная с последней правки.
+// @Tags Комментарии
+// @Produce json
+// @Param id path uint true "ID комментария"
+// @Security BearerAuth
+// @Success 200 {array} dto.CommentRevisionResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /comments/{id}/history [get]
+func (c *CommentController) GetRevisions(ctx *gin.Context) {
+	commentID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidCommentID})
+		return
+	}
+
+	revisions, err := c.service.GetRevisions(uint(commentID))
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrCommentNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrCommentNotFound})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToCommentRevisionListResponse(revisions))
+}
+
 // @Summary Удалить комментарий
-// @Description Удаляет комментарий по его уникальному идентификатору.
+// @Description Удаляет комментарий по его уникальному идентификатору. Комментарий с ответами заменяется
+// @Description надгробием "[deleted]" без автора и текста, а ответы на него сохраняются;
+// @Description комментарий без ответов перемещается в корзину.
 // @Tags Комментарии
 // @Produce json
 // @Param id path uint true "ID комментария"
 // @Security BearerAuth
 // @Success 200 {object} map[string]string
 // @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
 // @Failure 500 {object} map[string]string
 // @Router /comments/{id} [delete]
 func (c *CommentController) DeleteComment(ctx *gin.Context) {
 	commentIDStr := ctx.Param("id")
 	commentID, err := strconv.ParseUint(commentIDStr, 10, 64)
 	if err != nil {
-		ctx.JSON(http.StatusBadRequest, gin.H{"error": "invalid comment ID"})
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidCommentID})
 		return
 	}
 
@@ -179,7 +303,8 @@ func (c *CommentController) DeleteComment(ctx *gin.Context) {
 		return
 	}
 
-	if err := c.service.DeleteComment(uint(commentID), userID, userRoles); err != nil {
+	tombstoned, err := c.service.DeleteComment(uint(commentID), userID, userRoles)
+	if err != nil {
 		switch err.Error() {
 		case apperrors.ErrCommentNotFound:
 			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrCommentNotFound})
@@ -190,5 +315,235 @@ func (c *CommentController) DeleteComment(ctx *gin.Context) {
 		}
 		return
 	}
+	if tombstoned {
+		ctx.JSON(http.StatusOK, gin.H{"message": "comment replaced with a tombstone"})
+		return
+	}
 	ctx.JSON(http.StatusOK, gin.H{"message": "comment deleted"})
 }
+
+// @Summary Удалить ветку комментариев
+// @Description Окончательно удаляет комментарий вместе со всеми ответами на него, минуя корзину,
+// @Description и записывает удаление с причиной в журнал.
+// @Tags Комментарии
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID комментария"
+// @Param input body dto.CommentRemovalInput true "Причина удаления"
+// @Security BearerAuth
+// @Success 200 {object} dto.CommentRemovalResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /comments/{id}/remove-subtree [post]
+func (c *CommentController) RemoveSubtree(ctx *gin.Context) {
+	commentID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidCommentID})
+		return
+	}
+	var input dto.CommentRemovalInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	moderatorID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+
+	removal, err := c.service.RemoveSubtree(uint(commentID), input.Reason, moderatorID)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrCommentNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrCommentNotFound})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToCommentRemovalResponse(removal))
+}
+
+// @Summary Журнал удалений веток комментариев
+// @Description Возвращает удаления веток комментариев модераторами с причинами, начиная с недавних.
+// @Tags Комментарии
+// @Produce json
+// @Param limit query int false "Количество записей (по умолчанию 20, максимум 100)"
+// @Param offset query int false "Смещение"
+// @Security BearerAuth
+// @Success 200 {object} dto.CommentRemovalListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /comments/moderation/removals [get]
+func (c *CommentController) GetRemovals(ctx *gin.Context) {
+	limit, offset, err := utils.GetPaginationFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	removals, total, err := c.service.GetRemovals(limit, offset)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToCommentRemovalListResponse(removals, total))
+}
+
+// @Summary Очередь модерации комментариев
+// @Description Возвращает комментарии в указанном статусе, начиная с самых старых, с оценкой спам-фильтра.
+// @Description По умолчанию — ожидающие проверки.
+// @Tags Комментарии
+// @Produce json
+// @Param status query string false "Статус: pending (по умолчанию), approved, rejected или spam"
+// @Param limit query int false "Количество комментариев (по умолчанию 20, максимум 100)"
+// @Param offset query int false "Смещение"
+// @Security BearerAuth
+// @Success 200 {object} dto.CommentModerationQueueResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /comments/moderation [get]
+func (c *CommentController) GetModerationQueue(ctx *gin.Context) {
+	var query dto.CommentModerationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	limit, offset, err := utils.GetPaginationFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	comments, total, err := c.service.GetModerationQueue(query.Status, limit, offset)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToModerationQueueResponse(comments, total))
+}
+
+// @Summary Закрыть ветку комментариев
+// @Description Запрещает новые ответы на комментарий и все его вложенные ответы.
+// @Description Модераторы и администраторы могут отвечать в закрытых ветках.
+// @Tags Комментарии
+// @Produce json
+// @Param id path uint true "ID комментария"
+// @Security BearerAuth
+// @Success 200 {object} dto.CommentResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /comments/{id}/lock [put]
+func (c *CommentController) LockThread(ctx *gin.Context) {
+	c.setLocked(ctx, true)
+}
+
+// @Summary Открыть ветку комментариев
+// @Description Снова разрешает ответы на комментарий, если ветка не закрыта выше по дереву.
+// @Tags Комментарии
+// @Produce json
+// @Param id path uint true "ID комментария"
+// @Security BearerAuth
+// @Success 200 {object} dto.CommentResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /comments/{id}/lock [delete]
+func (c *CommentController) UnlockThread(ctx *gin.Context) {
+	c.setLocked(ctx, false)
+}
+
+// setLocked закрывает или открывает ветку под комментарием из пути запроса.
+func (c *CommentController) setLocked(ctx *gin.Context, locked bool) {
+	commentID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidCommentID})
+		return
+	}
+
+	comment, err := c.service.LockThread(uint(commentID), locked)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrCommentNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrCommentNotFound})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToCommentResponse(comment))
+}
+
+// @Summary Одобрить комментарии
+// @Description Публикует указанные комментарии и обучает на них спам-фильтр как на обычных комментариях.
+// @Description Несуществующие ID пропускаются.
+// @Tags Комментарии
+// @Accept json
+// @Produce json
+// @Param input body dto.CommentModerationInput true "ID комментариев"
+// @Security BearerAuth
+// @Success 200 {object} dto.CommentModerationResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /comments/moderation/approve [post]
+func (c *CommentController) ApproveComments(ctx *gin.Context) {
+	c.moderate(ctx, models.CommentStatusApproved)
+}
+
+// @Summary Отклонить комментарии
+// @Description Отклоняет указанные комментарии: они скрываются от всех, кроме автора, модераторов и администраторов.
+// @Description Несуществующие ID пропускаются.
+// @Tags Комментарии
+// @Accept json
+// @Produce json
+// @Param input body dto.CommentModerationInput true "ID комментариев"
+// @Security BearerAuth
+// @Success 200 {object} dto.CommentModerationResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /comments/moderation/reject [post]
+func (c *CommentController) RejectComments(ctx *gin.Context) {
+	c.moderate(ctx, models.CommentStatusRejected)
+}
+
+// @Summary Отметить комментарии как спам
+// @Description Скрывает указанные комментарии как спам и обучает на них спам-фильтр.
+// @Description Несуществующие ID пропускаются.
+// @Tags Комментарии
+// @Accept json
+// @Produce json
+// @Param input body dto.CommentModerationInput true "ID комментариев"
+// @Security BearerAuth
+// @Success 200 {object} dto.CommentModerationResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /comments/moderation/spam [post]
+func (c *CommentController) MarkCommentsAsSpam(ctx *gin.Context) {
+	c.moderate(ctx, models.CommentStatusSpam)
+}
+
+// moderate устанавливает статус модерации комментариям из тела запроса.
+func (c *CommentController) moderate(ctx *gin.Context, status string) {
+	var input dto.CommentModerationInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	comments, err := c.service.ModerateComments(input.IDs, status)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrCommentNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrCommentNotFound})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToCommentModerationResponse(status, comments))
+}
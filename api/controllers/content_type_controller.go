@@ -0,0 +1,164 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/schema"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// ContentTypeController предоставляет методы для управления типами контента через HTTP API.
+type ContentTypeController struct {
+	service *services.ContentTypeService
+}
+
+// NewContentTypeController создаёт новый экземпляр ContentTypeController.
+func NewContentTypeController(service *services.ContentTypeService) *ContentTypeController {
+	return &ContentTypeController{service: service}
+}
+
+// @Summary Создать тип контента
+// @Description Создает пользовательский тип контента с набором полей.
+// @Description Типы полей: string, rich_text, number, date, media, enum, list.
+// @Tags Типы контента
+// @Accept json
+// @Produce json
+// @Param content_type body dto.ContentTypeInput true "Данные типа контента"
+// @Security BearerAuth
+// @Success 201 {object} dto.ContentTypeResponse
+// @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /content-types [post]
+func (c *ContentTypeController) CreateContentType(ctx *gin.Context) {
+	var input dto.ContentTypeInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	contentType, err := c.service.CreateContentType(input)
+	if err != nil {
+		var schemaErr *schema.ValidationError
+		if errors.As(err, &schemaErr) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidContentTypeFields, "details": schemaErr.Error()})
+			return
+		}
+		switch err.Error() {
+		case apperrors.ErrInvalidSlug:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidSlug})
+		case apperrors.ErrContentTypeAlreadyExists:
+			ctx.JSON(http.StatusConflict, gin.H{"error": apperrors.ErrContentTypeAlreadyExists})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusCreated, mappers.MapToContentTypeResponse(contentType))
+}
+
+// @Summary Получить все типы контента
+// @Description Возвращает список пользовательских типов контента с определениями полей.
+// @Tags Типы контента
+// @Produce json
+// @Success 200 {array} dto.ContentTypeResponse
+// @Failure 500 {object} map[string]string
+// @Router /content-types [get]
+func (c *ContentTypeController) GetAllContentTypes(ctx *gin.Context) {
+	contentTypes, err := c.service.GetAllContentTypes()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToContentTypeListResponse(contentTypes))
+}
+
+// @Summary Получить тип контента
+// @Description Возвращает тип контента по его slug.
+// @Tags Типы контента
+// @Produce json
+// @Param type path string true "Slug типа контента"
+// @Success 200 {object} dto.ContentTypeResponse
+// @Failure 404 {object} map[string]string
+// @Router /content-types/{type} [get]
+func (c *ContentTypeController) GetContentType(ctx *gin.Context) {
+	contentType, err := c.service.GetContentType(ctx.Param("type"))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrContentTypeNotFound})
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToContentTypeResponse(contentType))
+}
+
+// @Summary Обновить тип контента
+// @Description Обновляет название и определения полей типа контента.
+// @Description Существующие записи проверяются по новым правилам при следующем сохранении.
+// @Tags Типы контента
+// @Accept json
+// @Produce json
+// @Param type path string true "Slug типа контента"
+// @Param content_type body dto.ContentTypeInput true "Обновлённые данные типа контента"
+// @Security BearerAuth
+// @Success 200 {object} dto.ContentTypeResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /content-types/{type} [put]
+func (c *ContentTypeController) UpdateContentType(ctx *gin.Context) {
+	var input dto.ContentTypeInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	contentType, err := c.service.UpdateContentType(ctx.Param("type"), input)
+	if err != nil {
+		var schemaErr *schema.ValidationError
+		if errors.As(err, &schemaErr) {
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidContentTypeFields, "details": schemaErr.Error()})
+			return
+		}
+		switch err.Error() {
+		case apperrors.ErrContentTypeNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrContentTypeNotFound})
+		case apperrors.ErrInvalidSlug:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidSlug})
+		case apperrors.ErrContentTypeAlreadyExists:
+			ctx.JSON(http.StatusConflict, gin.H{"error": apperrors.ErrContentTypeAlreadyExists})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToContentTypeResponse(contentType))
+}
+
+// @Summary Удалить тип контента
+// @Description Удаляет тип контента, у которого нет записей.
+// @Tags Типы контента
+// @Produce json
+// @Param type path string true "Slug типа контента"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /content-types/{type} [delete]
+func (c *ContentTypeController) DeleteContentType(ctx *gin.Context) {
+	if err := c.service.DeleteContentType(ctx.Param("type")); err != nil {
+		switch err.Error() {
+		case apperrors.ErrContentTypeNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrContentTypeNotFound})
+		case apperrors.ErrContentTypeHasEntries:
+			ctx.JSON(http.StatusConflict, gin.H{"error": apperrors.ErrContentTypeHasEntries})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "content type deleted successfully"})
+}
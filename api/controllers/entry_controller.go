@@ -0,0 +1,212 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/schema"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// EntryController предоставляет методы для управления записями типов контента через HTTP API.
+type EntryController struct {
+	service *services.EntryService
+}
+
+// NewEntryController создаёт новый экземпляр EntryController.
+func NewEntryController(service *services.EntryService) *EntryController {
+	return &EntryController{service: service}
+}
+
+// @Summary Создать запись
+// @Description Создает запись пользовательского типа контента. Данные проверяются по определению полей типа.
+// @Tags Записи типов контента
+// @Accept json
+// @Produce json
+// @Param type path string true "Slug типа контента"
+// @Param entry body dto.EntryInput true "Данные записи"
+// @Security BearerAuth
+// @Success 201 {object} dto.EntryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /content/{type} [post]
+func (c *EntryController) CreateEntry(ctx *gin.Context) {
+	var input dto.EntryInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, err := utils.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserNotAuthenticated})
+		return
+	}
+	entry, contentType, err := c.service.CreateEntry(ctx.Param("type"), input, userID)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, mappers.MapToEntryResponse(entry, contentType))
+}
+
+// @Summary Получить записи
+// @Description Возвращает записи пользовательского типа контента, начиная с новых.
+// @Description Неопубликованные записи видны только их авторам, редакторам, модераторам и администраторам.
+// @Tags Записи типов контента
+// @Produce json
+// @Param type path string true "Slug типа контента"
+// @Param limit query int false "Количество записей (по умолчанию 20, максимум 100)"
+// @Param offset query int false "Смещение"
+// @Success 200 {array} dto.EntryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /content/{type} [get]
+func (c *EntryController) GetEntries(ctx *gin.Context) {
+	limit, offset, err := utils.GetPaginationFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _ := utils.GetUserIDFromContext(ctx)
+	userRoles, _ := utils.GetUserRolesFromContext(ctx)
+	entries, contentType, err := c.service.GetEntries(ctx.Param("type"), limit, offset, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToEntryListResponse(entries, contentType))
+}
+
+// @Summary Получить запись по ID
+// @Description Возвращает запись пользовательского типа контента по её идентификатору.
+// @Description Неопубликованная запись доступна только автору, редакторам, модераторам и администраторам.
+// @Tags Записи типов контента
+// @Produce json
+// @Param type path string true "Slug типа контента"
+// @Param id path uint true "ID записи"
+// @Success 200 {object} dto.EntryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /content/{type}/{id} [get]
+func (c *EntryController) GetEntry(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidEntryID})
+		return
+	}
+	userID, _ := utils.GetUserIDFromContext(ctx)
+	userRoles, _ := utils.GetUserRolesFromContext(ctx)
+	entry, contentType, err := c.service.GetEntry(ctx.Param("type"), uint(id), userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToEntryResponse(entry, contentType))
+}
+
+// @Summary Обновить запись
+// @Description Обновляет запись. Авторы могут изменять свои записи, модераторы и администраторы — любые.
+// @Tags Записи типов контента
+// @Accept json
+// @Produce json
+// @Param type path string true "Slug типа контента"
+// @Param id path uint true "ID записи"
+// @Param entry body dto.EntryInput true "Обновлённые данные записи"
+// @Security BearerAuth
+// @Success 200 {object} dto.EntryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /content/{type}/{id} [put]
+func (c *EntryController) UpdateEntry(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidEntryID})
+		return
+	}
+	var input dto.EntryInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, err := utils.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserNotAuthenticated})
+		return
+	}
+	userRoles, err := utils.GetUserRolesFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserRolesNotFound})
+		return
+	}
+	entry, contentType, err := c.service.UpdateEntry(ctx.Param("type"), uint(id), input, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToEntryResponse(entry, contentType))
+}
+
+// @Summary Удалить запись
+// @Description Удаляет запись. Авторы могут удалять свои записи, модераторы и администраторы — любые.
+// @Tags Записи типов контента
+// @Produce json
+// @Param type path string true "Slug типа контента"
+// @Param id path uint true "ID записи"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /content/{type}/{id} [delete]
+func (c *EntryController) DeleteEntry(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidEntryID})
+		return
+	}
+	userID, err := utils.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserNotAuthenticated})
+		return
+	}
+	userRoles, err := utils.GetUserRolesFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserRolesNotFound})
+		return
+	}
+	if err := c.service.DeleteEntry(ctx.Param("type"), uint(id), userID, userRoles); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "entry deleted successfully"})
+}
+
+// respondError преобразует ошибку сервиса записей в HTTP-ответ.
+func (c *EntryController) respondError(ctx *gin.Context, err error) {
+	var schemaErr *schema.ValidationError
+	if errors.As(err, &schemaErr) {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidEntryData, "details": schemaErr.Error()})
+		return
+	}
+	switch err.Error() {
+	case apperrors.ErrContentTypeNotFound:
+		ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrContentTypeNotFound})
+	case apperrors.ErrEntryNotFound:
+		ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrEntryNotFound})
+	case apperrors.ErrAccessDenied:
+		ctx.JSON(http.StatusForbidden, gin.H{"error": apperrors.ErrAccessDenied})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+	}
+}
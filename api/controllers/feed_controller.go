@@ -0,0 +1,145 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/feeds"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// FeedController предоставляет ленты опубликованных статей в форматах RSS, Atom и JSON Feed.
+type FeedController struct {
+	service *services.FeedService
+}
+
+// NewFeedController создаёт новый экземпляр FeedController.
+func NewFeedController(service *services.FeedService) *FeedController {
+	return &FeedController{service: service}
+}
+
+// @Summary Лента статей в формате RSS 2.0
+// @Description Возвращает последние опубликованные статьи. Поддерживает фильтры по автору, тегу и рубрике.
+// @Tags Ленты
+// @Produce xml
+// @Param author query int false "ID автора"
+// @Param tag query string false "Slug тега"
+// @Param category query string false "Slug рубрики"
+// @Success 200 {string} string
+// @Success 304 {string} string
+// @Failure 404 {object} map[string]string
+// @Router /feeds/articles.rss [get]
+func (c *FeedController) RSS(ctx *gin.Context) {
+	c.serve(ctx, "rss", feeds.ContentTypeRSS, feeds.RenderRSS, c.articleFeed)
+}
+
+// @Summary Лента статей в формате Atom
+// @Description Возвращает последние опубликованные статьи. Поддерживает фильтры по автору, тегу и рубрике.
+// @Tags Ленты
+// @Produce xml
+// @Param author query int false "ID автора"
+// @Param tag query string false "Slug тега"
+// @Param category query string false "Slug рубрики"
+// @Success 200 {string} string
+// @Success 304 {string} string
+// @Failure 404 {object} map[string]string
+// @Router /feeds/articles.atom [get]
+func (c *FeedController) Atom(ctx *gin.Context) {
+	c.serve(ctx, "atom", feeds.ContentTypeAtom, feeds.RenderAtom, c.articleFeed)
+}
+
+// @Summary Лента статей в формате JSON Feed
+// @Description Возвращает последние опубликованные статьи. Поддерживает фильтры по автору, тегу и рубрике.
+// @Tags Ленты
+// @Produce json
+// @Param author query int false "ID автора"
+// @Param tag query string false "Slug тега"
+// @Param category query string false "Slug рубрики"
+// @Success 200 {string} string
+// @Success 304 {string} string
+// @Failure 404 {object} map[string]string
+// @Router /feeds/articles.json [get]
+func (c *FeedController) JSON(ctx *gin.Context) {
+	c.serve(ctx, "json", feeds.ContentTypeJSON, feeds.RenderJSON, c.articleFeed)
+}
+
+// @Summary Лента серии в формате RSS 2.0
+// @Description Возвращает опубликованные части серии в порядке их следования.
+// @Tags Ленты
+// @Produce xml
+// @Param slug path string true "Slug серии"
+// @Success 200 {string} string
+// @Success 304 {string} string
+// @Failure 404 {object} map[string]string
+// @Router /series/{slug}/feed.rss [get]
+func (c *FeedController) SeriesRSS(ctx *gin.Context) {
+	c.serve(ctx, "rss", feeds.ContentTypeRSS, feeds.RenderRSS, c.seriesFeed)
+}
+
+// @Summary Лента серии в формате Atom
+// @Description Возвращает опубликованные части серии в порядке их следования.
+// @Tags Ленты
+// @Produce xml
+// @Param slug path string true "Slug серии"
+// @Success 200 {string} string
+// @Success 304 {string} string
+// @Failure 404 {object} map[string]string
+// @Router /series/{slug}/feed.atom [get]
+func (c *FeedController) SeriesAtom(ctx *gin.Context) {
+	c.serve(ctx, "atom", feeds.ContentTypeAtom, feeds.RenderAtom, c.seriesFeed)
+}
+
+// @Summary Лента серии в формате JSON Feed
+// @Description Возвращает опубликованные части серии в порядке их следования.
+// @Tags Ленты
+// @Produce json
+// @Param slug path string true "Slug серии"
+// @Success 200 {string} string
+// @Success 304 {string} string
+// @Failure 404 {object} map[string]string
+// @Router /series/{slug}/feed.json [get]
+func (c *FeedController) SeriesJSON(ctx *gin.Context) {
+	c.serve(ctx, "json", feeds.ContentTypeJSON, feeds.RenderJSON, c.seriesFeed)
+}
+
+// articleFeed формирует общую ленту статей с фильтрами из параметров запроса.
+func (c *FeedController) articleFeed(ctx *gin.Context) (*feeds.Feed, error) {
+	var query dto.FeedQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		return nil, err
+	}
+	return c.service.BuildArticleFeed(query, ctx.Request.URL.RequestURI())
+}
+
+// seriesFeed формирует ленту серии, указанной в пути запроса.
+func (c *FeedController) seriesFeed(ctx *gin.Context) (*feeds.Feed, error) {
+	return c.service.BuildSeriesFeed(ctx.Param("slug"), ctx.Request.URL.Path)
+}
+
+// serve формирует ленту, проверяет условные заголовки и отдаёт ленту в указанном формате.
+func (c *FeedController) serve(ctx *gin.Context, format, contentType string, render func(*feeds.Feed) ([]byte, error), build func(*gin.Context) (*feeds.Feed, error)) {
+	feed, err := build(ctx)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrUserNotFound, apperrors.ErrTagNotFound, apperrors.ErrCategoryNotFound, apperrors.ErrSeriesNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case apperrors.ErrInternalServerError:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		default:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		}
+		return
+	}
+	if utils.NotModified(ctx, feed.ETag(format), feed.Updated) {
+		return
+	}
+	body, err := render(feed)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.Data(http.StatusOK, contentType, body)
+}
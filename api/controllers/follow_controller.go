@@ -0,0 +1,226 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// FollowController предоставляет методы для управления подписками и персональной лентой через HTTP API.
+type FollowController struct {
+	service      *services.FollowService
+	readingLists *services.ReadingListService
+	locales      *config.LocaleConfig
+	site         *config.SiteConfig
+}
+
+// NewFollowController создаёт новый экземпляр FollowController.
+func NewFollowController(
+	service *services.FollowService,
+	readingLists *services.ReadingListService,
+	locales *config.LocaleConfig,
+	site *config.SiteConfig,
+) *FollowController {
+	return &FollowController{
+		service:      service,
+		readingLists: readingLists,
+		locales:      locales,
+		site:         site,
+	}
+}
+
+// @Summary Подписаться на автора
+// @Description Подписывает текущего пользователя на автора. Повторная подписка ничего не меняет.
+// @Tags Подписки
+// @Produce json
+// @Param id path uint true "ID автора"
+// @Security BearerAuth
+// @Success 200 {object} dto.FollowStatusResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /users/{id}/follow [put]
+func (c *FollowController) FollowAuthor(ctx *gin.Context) {
+	authorID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidUserID})
+		return
+	}
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	if err := c.service.FollowAuthor(uint(authorID), userID); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FollowStatusResponse{ID: uint(authorID), Following: true})
+}
+
+// @Summary Отписаться от автора
+// @Description Отписывает текущего пользователя от автора.
+// @Tags Подписки
+// @Produce json
+// @Param id path uint true "ID автора"
+// @Security BearerAuth
+// @Success 200 {object} dto.FollowStatusResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /users/{id}/follow [delete]
+func (c *FollowController) UnfollowAuthor(ctx *gin.Context) {
+	authorID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidUserID})
+		return
+	}
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	if err := c.service.UnfollowAuthor(uint(authorID), userID); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FollowStatusResponse{ID: uint(authorID), Following: false})
+}
+
+// @Summary Подписаться на тег
+// @Description Подписывает текущего пользователя на тег. Повторная подписка ничего не меняет.
+// @Tags Подписки
+// @Produce json
+// @Param id path uint true "ID тега"
+// @Security BearerAuth
+// @Success 200 {object} dto.FollowStatusResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /tags/{id}/follow [put]
+func (c *FollowController) FollowTag(ctx *gin.Context) {
+	tagID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidTagID})
+		return
+	}
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	if err := c.service.FollowTag(uint(tagID), userID); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FollowStatusResponse{ID: uint(tagID), Following: true})
+}
+
+// @Summary Отписаться от тега
+// @Description Отписывает текущего пользователя от тега.
+// @Tags Подписки
+// @Produce json
+// @Param id path uint true "ID тега"
+// @Security BearerAuth
+// @Success 200 {object} dto.FollowStatusResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /tags/{id}/follow [delete]
+func (c *FollowController) UnfollowTag(ctx *gin.Context) {
+	tagID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidTagID})
+		return
+	}
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	if err := c.service.UnfollowTag(uint(tagID), userID); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.FollowStatusResponse{ID: uint(tagID), Following: false})
+}
+
+// @Summary Получить подписки
+// @Description Возвращает авторов и теги, на которые подписан текущий пользователь.
+// @Tags Подписки
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.FollowingResponse
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /following [get]
+func (c *FollowController) GetFollowing(ctx *gin.Context) {
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	following, err := c.service.GetFollowing(userID)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToFollowingResponse(following))
+}
+
+// @Summary Персональная лента
+// @Description Возвращает опубликованные статьи авторов и тегов, на которые подписан текущий пользователь,
+// @Description от новых к старым. Для получения следующей страницы передайте next_cursor в параметре cursor.
+// @Description Текст возвращается на локали из параметра lang или заголовка Accept-Language.
+// @Tags Подписки
+// @Produce json
+// @Param cursor query string false "Курсор следующей страницы"
+// @Param limit query int false "Количество статей на странице (по умолчанию 20, не более 100)"
+// @Param lang query string false "Локаль (например, ru или en)"
+// @Param Accept-Language header string false "Предпочитаемые локали"
+// @Security BearerAuth
+// @Success 200 {object} dto.PersonalFeedResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /feed/me [get]
+func (c *FollowController) GetPersonalFeed(ctx *gin.Context) {
+	limit, _, err := utils.GetPaginationFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	feed, err := c.service.GetPersonalFeed(userID, ctx.Query("cursor"), limit)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	locale := utils.ResolveLocale(ctx, c.locales.Supported, c.locales.Default)
+	ctx.Header("Vary", "Accept-Language")
+	responses := mappers.MapToLocalizedArticleListResponse(feed.Articles, locale, c.locales.Default)
+	for i, response := range responses {
+		response.SEO = mappers.MapToArticleSEO(response, feed.Articles[i], c.site)
+	}
+	markSaved(c.readingLists, userID, responses...)
+	ctx.JSON(http.StatusOK, dto.PersonalFeedResponse{Articles: responses, NextCursor: feed.NextCursor})
+}
+
+// respondError преобразует ошибку сервиса подписок в HTTP-ответ.
+func (c *FollowController) respondError(ctx *gin.Context, err error) {
+	switch err.Error() {
+	case apperrors.ErrCannotFollowYourself, apperrors.ErrInvalidCursor:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case apperrors.ErrUserNotFound, apperrors.ErrTagNotFound:
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+	}
+}
@@ -0,0 +1,179 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// NotificationController предоставляет методы для работы с уведомлениями через HTTP API.
+type NotificationController struct {
+	service *services.NotificationService
+}
+
+// NewNotificationController создаёт новый экземпляр NotificationController.
+func NewNotificationController(service *services.NotificationService) *NotificationController {
+	return &NotificationController{service: service}
+}
+
+// @Summary Получить уведомления
+// @Description Возвращает уведомления текущего пользователя, начиная с новых: упоминания (mention),
+// @Description ответы на его комментарии (reply) и комментарии к его статьям (article_comment).
+// @Tags Уведомления
+// @Produce json
+// @Param unread query bool false "Только непрочитанные уведомления"
+// @Param limit query int false "Количество уведомлений (по умолчанию 20, максимум 100)"
+// @Param offset query int false "Смещение"
+// @Security BearerAuth
+// @Success 200 {object} dto.NotificationListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /notifications [get]
+func (c *NotificationController) GetNotifications(ctx *gin.Context) {
+	var query dto.NotificationListQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	limit, offset, err := utils.GetPaginationFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+
+	notifications, total, unread, err := c.service.GetNotifications(userID, query.Unread, limit, offset)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToNotificationListResponse(notifications, total, unread))
+}
+
+// @Summary Количество непрочитанных уведомлений
+// @Description Возвращает количество непрочитанных уведомлений текущего пользователя.
+// @Tags Уведомления
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.UnreadNotificationsResponse
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /notifications/unread-count [get]
+func (c *NotificationController) GetUnreadCount(ctx *gin.Context) {
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	unread, err := c.service.CountUnread(userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.UnreadNotificationsResponse{Unread: unread})
+}
+
+// @Summary Отметить уведомления прочитанными
+// @Description Отмечает прочитанными указанные уведомления текущего пользователя; если список ids пуст — все уведомления.
+// @Description Чужие и уже прочитанные уведомления не изменяются.
+// @Tags Уведомления
+// @Accept json
+// @Produce json
+// @Param input body dto.MarkNotificationsReadInput true "ID уведомлений"
+// @Security BearerAuth
+// @Success 200 {object} dto.MarkNotificationsReadResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /notifications/read [post]
+func (c *NotificationController) MarkRead(ctx *gin.Context) {
+	var input dto.MarkNotificationsReadInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+
+	updated, err := c.service.MarkRead(userID, input.IDs)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	unread, err := c.service.CountUnread(userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.MarkNotificationsReadResponse{Updated: updated, Unread: unread})
+}
+
+// @Summary Получить настройки уведомлений
+// @Description Возвращает для каждого типа уведомлений, включён ли он у текущего пользователя. По умолчанию включены все типы.
+// @Tags Уведомления
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.NotificationPreferencesResponse
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /notifications/preferences [get]
+func (c *NotificationController) GetPreferences(ctx *gin.Context) {
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	preferences, err := c.service.GetPreferences(userID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.NotificationPreferencesResponse{Preferences: preferences})
+}
+
+// @Summary Изменить настройки уведомлений
+// @Description Включает или отключает уведомления указанных типов (mention, reply, article_comment).
+// @Description Неуказанные типы не меняются. Возвращает настройки для всех типов.
+// @Tags Уведомления
+// @Accept json
+// @Produce json
+// @Param input body dto.NotificationPreferencesInput true "Настройки по типам"
+// @Security BearerAuth
+// @Success 200 {object} dto.NotificationPreferencesResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 422 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /notifications/preferences [put]
+func (c *NotificationController) UpdatePreferences(ctx *gin.Context) {
+	var input dto.NotificationPreferencesInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+
+	preferences, err := c.service.UpdatePreferences(userID, input.Preferences)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrUnsupportedNotificationType:
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.NotificationPreferencesResponse{Preferences: preferences})
+}
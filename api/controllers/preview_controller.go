@@ -0,0 +1,190 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// PreviewController предоставляет методы для работы со ссылками предпросмотра черновиков через HTTP API.
+type PreviewController struct {
+	service *services.PreviewService
+	site    *config.SiteConfig
+}
+
+// NewPreviewController создаёт новый экземпляр PreviewController.
+func NewPreviewController(service *services.PreviewService, site *config.SiteConfig) *PreviewController {
+	return &PreviewController{service: service, site: site}
+}
+
+// @Summary Выдать ссылку предпросмотра
+// @Description Создает подписанную ссылку с ограниченным сроком действия, по которой внешний рецензент
+// @Description без учётной записи может прочитать черновик статьи. Токен возвращается только один раз.
+// @Description Доступно автору статьи, редакторам, модераторам и администраторам.
+// @Tags Предпросмотр
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Param token body dto.PreviewTokenInput true "Параметры ссылки"
+// @Security BearerAuth
+// @Success 201 {object} dto.CreatedPreviewTokenResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /articles/{id}/preview-tokens [post]
+func (c *PreviewController) CreateToken(ctx *gin.Context) {
+	articleID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	var input dto.PreviewTokenInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, err := utils.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserNotAuthenticated})
+		return
+	}
+	userRoles, err := utils.GetUserRolesFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserRolesNotFound})
+		return
+	}
+	issued, err := c.service.CreateToken(uint(articleID), input, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, mappers.MapToCreatedPreviewTokenResponse(issued.Token, issued.Signed, issued.URL))
+}
+
+// @Summary Получить ссылки предпросмотра статьи
+// @Description Возвращает все ссылки предпросмотра статьи со сроком действия и статистикой просмотров.
+// @Tags Предпросмотр
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Security BearerAuth
+// @Success 200 {array} dto.PreviewTokenResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /articles/{id}/preview-tokens [get]
+func (c *PreviewController) GetTokens(ctx *gin.Context) {
+	articleID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	userID, err := utils.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserNotAuthenticated})
+		return
+	}
+	userRoles, err := utils.GetUserRolesFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserRolesNotFound})
+		return
+	}
+	tokens, err := c.service.GetTokens(uint(articleID), userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToPreviewTokenListResponse(tokens))
+}
+
+// @Summary Отозвать ссылку предпросмотра
+// @Description Отзывает ссылку предпросмотра; после этого она перестаёт открываться.
+// @Tags Предпросмотр
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Param tokenId path uint true "ID ссылки предпросмотра"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /articles/{id}/preview-tokens/{tokenId} [delete]
+func (c *PreviewController) RevokeToken(ctx *gin.Context) {
+	articleID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	tokenID, err := strconv.ParseUint(ctx.Param("tokenId"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidPreviewTokenID})
+		return
+	}
+	userID, err := utils.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserNotAuthenticated})
+		return
+	}
+	userRoles, err := utils.GetUserRolesFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserRolesNotFound})
+		return
+	}
+	if err := c.service.RevokeToken(uint(articleID), uint(tokenID), userID, userRoles); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "preview token revoked successfully"})
+}
+
+// @Summary Открыть предпросмотр черновика
+// @Description Возвращает текущую версию статьи, включая неопубликованный черновик, по подписанной ссылке.
+// @Description Аутентификация не требуется; ответ не кэшируется и не индексируется поисковиками.
+// @Tags Предпросмотр
+// @Produce json
+// @Param token path string true "Токен предпросмотра"
+// @Success 200 {object} dto.ArticleResponse
+// @Failure 404 {object} map[string]string
+// @Failure 410 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /preview/{token} [get]
+func (c *PreviewController) OpenPreview(ctx *gin.Context) {
+	ctx.Header("Cache-Control", "private, no-store")
+	ctx.Header("X-Robots-Tag", "noindex, nofollow")
+	ctx.Header("Referrer-Policy", "no-referrer")
+
+	article, err := c.service.OpenPreview(ctx.Param("token"), ctx.ClientIP())
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	response := mappers.MapToArticleResponse(article)
+	response.SEO = mappers.MapToArticleSEO(response, article, c.site)
+	response.SEO.NoIndex = true
+	ctx.JSON(http.StatusOK, response)
+}
+
+// respondError преобразует ошибку сервиса предпросмотра в HTTP-ответ.
+func (c *PreviewController) respondError(ctx *gin.Context, err error) {
+	switch err.Error() {
+	case apperrors.ErrPreviewTTLTooLong:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case apperrors.ErrAccessDenied:
+		ctx.JSON(http.StatusForbidden, gin.H{"error": apperrors.ErrAccessDenied})
+	case apperrors.ErrArticleNotFound, apperrors.ErrPreviewTokenNotFound, apperrors.ErrInvalidPreviewToken:
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case apperrors.ErrPreviewTokenExpired:
+		ctx.JSON(http.StatusGone, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+	}
+}
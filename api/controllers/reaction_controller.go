@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// ReactionController предоставляет методы для управления реакциями через HTTP API.
+type ReactionController struct {
+	service *services.ReactionService
+}
+
+// NewReactionController создаёт новый экземпляр ReactionController.
+func NewReactionController(service *services.ReactionService) *ReactionController {
+	return &ReactionController{service: service}
+}
+
+// @Summary Получить типы реакций
+// @Description Возвращает типы реакций, доступные для статей и комментариев.
+// @Tags Реакции
+// @Produce json
+// @Success 200 {object} dto.ReactionTypesResponse
+// @Router /reactions [get]
+func (c *ReactionController) GetTypes(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, dto.ReactionTypesResponse{Types: c.service.GetTypes()})
+}
+
+// @Summary Переключить реакцию на статью
+// @Description Ставит реакцию указанного типа на статью или снимает её, если она уже поставлена.
+// @Description Пользователь может поставить статье не более одной реакции каждого типа.
+// @Tags Реакции
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Param type path string true "Тип реакции"
+// @Security BearerAuth
+// @Success 200 {object} dto.ReactionToggleResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /articles/{id}/reactions/{type} [post]
+func (c *ReactionController) ToggleArticleReaction(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	result, err := c.service.ToggleArticleReaction(uint(id), ctx.Param("type"), userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToReactionToggleResponse(result))
+}
+
+// @Summary Переключить реакцию на комментарий
+// @Description Ставит реакцию указанного типа на комментарий или снимает её, если она уже поставлена.
+// @Description Пользователь может поставить комментарию не более одной реакции каждого типа.
+// @Tags Реакции
+// @Produce json
+// @Param id path uint true "ID комментария"
+// @Param type path string true "Тип реакции"
+// @Security BearerAuth
+// @Success 200 {object} dto.ReactionToggleResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /comments/{id}/reactions/{type} [post]
+func (c *ReactionController) ToggleCommentReaction(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidCommentID})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	result, err := c.service.ToggleCommentReaction(uint(id), ctx.Param("type"), userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToReactionToggleResponse(result))
+}
+
+// respondError преобразует ошибку сервиса реакций в HTTP-ответ.
+func (c *ReactionController) respondError(ctx *gin.Context, err error) {
+	switch err.Error() {
+	case apperrors.ErrUnsupportedReactionType:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case apperrors.ErrArticleNotFound, apperrors.ErrCommentNotFound:
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+	}
+}
@@ -0,0 +1,396 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// ReadingListController предоставляет методы для управления закладками и списками для чтения через HTTP API.
+type ReadingListController struct {
+	service *services.ReadingListService
+	site    *config.SiteConfig
+}
+
+// NewReadingListController создаёт новый экземпляр ReadingListController.
+func NewReadingListController(service *services.ReadingListService, site *config.SiteConfig) *ReadingListController {
+	return &ReadingListController{service: service, site: site}
+}
+
+// @Summary Получить закладки
+// @Description Возвращает статьи из закладок текущего пользователя, начиная с последних добавленных.
+// @Tags Закладки и списки для чтения
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.SavedArticleDTO
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /bookmarks [get]
+func (c *ReadingListController) GetBookmarks(ctx *gin.Context) {
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	bookmarks, err := c.service.GetBookmarks(userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToBookmarkListResponse(bookmarks))
+}
+
+// @Summary Добавить статью в закладки
+// @Description Добавляет статью в закладки текущего пользователя. Повторное добавление ничего не меняет.
+// @Tags Закладки и списки для чтения
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Security BearerAuth
+// @Success 200 {object} dto.BookmarkResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /articles/{id}/bookmark [put]
+func (c *ReadingListController) AddBookmark(ctx *gin.Context) {
+	articleID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	if err := c.service.AddBookmark(uint(articleID), userID, userRoles); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.BookmarkResponse{ArticleID: uint(articleID), Saved: true})
+}
+
+// @Summary Удалить статью из закладок
+// @Description Удаляет статью из закладок текущего пользователя.
+// @Tags Закладки и списки для чтения
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Security BearerAuth
+// @Success 200 {object} dto.BookmarkResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /articles/{id}/bookmark [delete]
+func (c *ReadingListController) RemoveBookmark(ctx *gin.Context) {
+	articleID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	if err := c.service.RemoveBookmark(uint(articleID), userID); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.BookmarkResponse{ArticleID: uint(articleID), Saved: false})
+}
+
+// @Summary Создать список для чтения
+// @Description Создает именованный список для чтения. Публичный список доступен всем по ссылке share_url.
+// @Tags Закладки и списки для чтения
+// @Accept json
+// @Produce json
+// @Param list body dto.ReadingListInput true "Данные списка"
+// @Security BearerAuth
+// @Success 201 {object} dto.ReadingListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /reading-lists [post]
+func (c *ReadingListController) CreateList(ctx *gin.Context) {
+	var input dto.ReadingListInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	list, err := c.service.CreateList(input, userID)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, mappers.MapToReadingListResponse(list, c.site))
+}
+
+// @Summary Получить свои списки для чтения
+// @Description Возвращает списки для чтения текущего пользователя.
+// @Tags Закладки и списки для чтения
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.ReadingListResponse
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /reading-lists [get]
+func (c *ReadingListController) GetLists(ctx *gin.Context) {
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	lists, err := c.service.GetLists(userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToReadingListListResponse(lists, c.site))
+}
+
+// @Summary Получить список для чтения
+// @Description Возвращает список для чтения. Приватный список доступен только владельцу.
+// @Tags Закладки и списки для чтения
+// @Produce json
+// @Param id path uint true "ID списка"
+// @Security BearerAuth
+// @Success 200 {object} dto.ReadingListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /reading-lists/{id} [get]
+func (c *ReadingListController) GetList(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidReadingListID})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	list, err := c.service.GetList(uint(id), userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToReadingListResponse(list, c.site))
+}
+
+// @Summary Открыть публичный список для чтения
+// @Description Возвращает публичный список для чтения по ссылке. Аутентификация не требуется;
+// @Description черновики в списке видны только тем, кому они доступны.
+// @Tags Закладки и списки для чтения
+// @Produce json
+// @Param share_id path string true "Идентификатор публичной ссылки"
+// @Success 200 {object} dto.ReadingListResponse
+// @Failure 404 {object} map[string]string
+// @Router /reading-lists/shared/{share_id} [get]
+func (c *ReadingListController) GetSharedList(ctx *gin.Context) {
+	userID, _ := utils.GetUserIDFromContext(ctx)
+	userRoles, _ := utils.GetUserRolesFromContext(ctx)
+	list, err := c.service.GetSharedList(ctx.Param("share_id"), userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToReadingListResponse(list, c.site))
+}
+
+// @Summary Обновить список для чтения
+// @Description Обновляет название, описание и видимость списка. Ссылка на список при этом не меняется.
+// @Tags Закладки и списки для чтения
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID списка"
+// @Param list body dto.ReadingListInput true "Данные списка"
+// @Security BearerAuth
+// @Success 200 {object} dto.ReadingListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /reading-lists/{id} [put]
+func (c *ReadingListController) UpdateList(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidReadingListID})
+		return
+	}
+	var input dto.ReadingListInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	list, err := c.service.UpdateList(uint(id), input, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToReadingListResponse(list, c.site))
+}
+
+// @Summary Удалить список для чтения
+// @Description Удаляет список для чтения. Статьи списка остаются.
+// @Tags Закладки и списки для чтения
+// @Produce json
+// @Param id path uint true "ID списка"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /reading-lists/{id} [delete]
+func (c *ReadingListController) DeleteList(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidReadingListID})
+		return
+	}
+	userID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	if err := c.service.DeleteList(uint(id), userID); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "reading list deleted successfully"})
+}
+
+// @Summary Добавить статью в список для чтения
+// @Description Добавляет статью в конец списка для чтения.
+// @Tags Закладки и списки для чтения
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID списка"
+// @Param item body dto.ReadingListItemInput true "Статья"
+// @Security BearerAuth
+// @Success 200 {object} dto.ReadingListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /reading-lists/{id}/articles [post]
+func (c *ReadingListController) AddItem(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidReadingListID})
+		return
+	}
+	var input dto.ReadingListItemInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	list, err := c.service.AddItem(uint(id), input, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToReadingListResponse(list, c.site))
+}
+
+// @Summary Изменить порядок статей списка
+// @Description Ставит указанные статьи в начало списка в заданном порядке; остальные статьи следуют за ними.
+// @Tags Закладки и списки для чтения
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID списка"
+// @Param order body dto.ReadingListOrderInput true "Статьи в новом порядке"
+// @Security BearerAuth
+// @Success 200 {object} dto.ReadingListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /reading-lists/{id}/articles [put]
+func (c *ReadingListController) ReorderItems(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidReadingListID})
+		return
+	}
+	var input dto.ReadingListOrderInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	list, err := c.service.ReorderItems(uint(id), input, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToReadingListResponse(list, c.site))
+}
+
+// @Summary Удалить статью из списка для чтения
+// @Description Удаляет статью из списка для чтения; следующие статьи сдвигаются.
+// @Tags Закладки и списки для чтения
+// @Produce json
+// @Param id path uint true "ID списка"
+// @Param article_id path uint true "ID статьи"
+// @Security BearerAuth
+// @Success 200 {object} dto.ReadingListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /reading-lists/{id}/articles/{article_id} [delete]
+func (c *ReadingListController) RemoveItem(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidReadingListID})
+		return
+	}
+	articleID, err := strconv.ParseUint(ctx.Param("article_id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	list, err := c.service.RemoveItem(uint(id), uint(articleID), userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToReadingListResponse(list, c.site))
+}
+
+// respondError преобразует ошибку сервиса закладок и списков для чтения в HTTP-ответ.
+func (c *ReadingListController) respondError(ctx *gin.Context, err error) {
+	switch err.Error() {
+	case apperrors.ErrInvalidReadingListOrder:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case apperrors.ErrReadingListNotFound, apperrors.ErrArticleNotFound, apperrors.ErrArticleNotInReadingList:
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case apperrors.ErrArticleAlreadyInReadingList:
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+	}
+}
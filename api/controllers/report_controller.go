@@ -0,0 +1,242 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// ReportController предоставляет методы для управления жалобами через HTTP API.
+type ReportController struct {
+	service *services.ReportService
+}
+
+// NewReportController создаёт новый экземпляр ReportController.
+func NewReportController(service *services.ReportService) *ReportController {
+	return &ReportController{service: service}
+}
+
+// @Summary Получить категории жалоб
+// @Description Возвращает категории, которые можно указать в жалобе.
+// @Tags Жалобы
+// @Produce json
+// @Success 200 {object} dto.ReportReasonsResponse
+// @Router /reports/reasons [get]
+func (c *ReportController) GetReasons(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, dto.ReportReasonsResponse{Reasons: c.service.GetReasons()})
+}
+
+// @Summary Пожаловаться на статью
+// @Description Создаёт жалобу текущего пользователя на статью. Пока предыдущая жалоба
+// @Description пользователя на статью не рассмотрена, повторная не создаётся и возвращается существующая (200).
+// @Description При достижении порога открытых жалоб статья скрывается до решения модератора.
+// @Tags Жалобы
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Param input body dto.ReportInput true "Данные жалобы"
+// @Security BearerAuth
+// @Success 201 {object} dto.ReportResponse
+// @Success 200 {object} dto.ReportResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 422 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /articles/{id}/reports [post]
+func (c *ReportController) ReportArticle(ctx *gin.Context) {
+	articleID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	c.create(ctx, c.service.ReportArticle, uint(articleID))
+}
+
+// @Summary Пожаловаться на комментарий
+// @Description Создаёт жалобу текущего пользователя на комментарий. Пока предыдущая жалоба
+// @Description пользователя на комментарий не рассмотрена, повторная не создаётся и возвращается существующая (200).
+// @Description При достижении порога открытых жалоб комментарий скрывается до решения модератора.
+// @Tags Жалобы
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID комментария"
+// @Param input body dto.ReportInput true "Данные жалобы"
+// @Security BearerAuth
+// @Success 201 {object} dto.ReportResponse
+// @Success 200 {object} dto.ReportResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 422 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /comments/{id}/reports [post]
+func (c *ReportController) ReportComment(ctx *gin.Context) {
+	commentID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidCommentID})
+		return
+	}
+	c.create(ctx, c.service.ReportComment, uint(commentID))
+}
+
+// @Summary Очередь разбора жалоб
+// @Description Возвращает статьи и комментарии с открытыми жалобами, начиная с объектов с наибольшим
+// @Description количеством жалоб, вместе с жалобами, количеством предупреждений автора и признаком его блокировки.
+// @Tags Жалобы
+// @Produce json
+// @Param type query string false "Тип объектов: article или comment (по умолчанию — все)"
+// @Param limit query int false "Количество объектов (по умолчанию 20, максимум 100)"
+// @Param offset query int false "Смещение"
+// @Security BearerAuth
+// @Success 200 {object} dto.ReportTriageResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /reports [get]
+func (c *ReportController) GetTriage(ctx *gin.Context) {
+	var query dto.ReportTriageQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	limit, offset, err := utils.GetPaginationFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	items, total, err := c.service.GetTriage(query.Type, limit, offset)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToReportTriageResponse(items, total))
+}
+
+// @Summary Рассмотреть жалобы на статью
+// @Description Закрывает все открытые жалобы на статью: dismiss отклоняет их и возвращает статью в выдачу,
+// @Description hide скрывает статью, warn дополнительно выносит автору предупреждение,
+// @Description ban блокирует автора и отзывает его сессии. Модераторов и администраторов заблокировать нельзя.
+// @Tags Жалобы
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Param input body dto.ReportResolutionInput true "Решение модератора"
+// @Security BearerAuth
+// @Success 200 {object} dto.ReportResolutionResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /reports/articles/{id}/resolve [post]
+func (c *ReportController) ResolveArticle(ctx *gin.Context) {
+	articleID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	c.resolve(ctx, models.ReportTargetArticle, uint(articleID))
+}
+
+// @Summary Рассмотреть жалобы на комментарий
+// @Description Закрывает все открытые жалобы на комментарий: dismiss отклоняет их и возвращает комментарий в выдачу,
+// @Description hide скрывает комментарий, warn дополнительно выносит автору предупреждение,
+// @Description ban блокирует автора и отзывает его сессии. Модераторов и администраторов заблокировать нельзя.
+// @Description Для комментария, удалённого с сохранением ответов, warn и ban недоступны (409): его автор стёрт.
+// @Tags Жалобы
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID комментария"
+// @Param input body dto.ReportResolutionInput true "Решение модератора"
+// @Security BearerAuth
+// @Success 200 {object} dto.ReportResolutionResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /reports/comments/{id}/resolve [post]
+func (c *ReportController) ResolveComment(ctx *gin.Context) {
+	commentID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidCommentID})
+		return
+	}
+	c.resolve(ctx, models.ReportTargetComment, uint(commentID))
+}
+
+// create создаёт жалобу на объект targetID функцией submit.
+func (c *ReportController) create(
+	ctx *gin.Context,
+	submit func(uint, dto.ReportInput, uint, []string) (*models.Report, bool, error),
+	targetID uint,
+) {
+	var input dto.ReportInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+
+	report, created, err := submit(targetID, input, userID, userRoles)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrArticleNotFound, apperrors.ErrCommentNotFound, apperrors.ErrUserNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case apperrors.ErrUserBanned:
+			ctx.JSON(http.StatusForbidden, gin.H{"error": apperrors.ErrUserBanned})
+		case apperrors.ErrUnsupportedReportReason, apperrors.ErrCannotReportOwnContent:
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	status := http.StatusCreated
+	if !created {
+		status = http.StatusOK
+	}
+	ctx.JSON(status, mappers.MapToReportResponse(report))
+}
+
+// resolve применяет решение модератора к жалобам на объект.
+func (c *ReportController) resolve(ctx *gin.Context, targetType string, targetID uint) {
+	var input dto.ReportResolutionInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	moderatorID, _, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+
+	resolution, err := c.service.Resolve(targetType, targetID, input, moderatorID)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrArticleNotFound, apperrors.ErrCommentNotFound, apperrors.ErrUserNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case apperrors.ErrNoOpenReports, apperrors.ErrReportAuthorDeleted:
+			ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case apperrors.ErrAccessDenied:
+			ctx.JSON(http.StatusForbidden, gin.H{"error": apperrors.ErrAccessDenied})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToReportResolutionResponse(resolution))
+}
@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// SearchController предоставляет методы полнотекстового поиска через HTTP API.
+type SearchController struct {
+	service *services.SearchService
+}
+
+// NewSearchController создаёт новый экземпляр SearchController.
+func NewSearchController(service *services.SearchService) *SearchController {
+	return &SearchController{service: service}
+}
+
+// @Summary Поиск по контенту
+// @Description Выполняет полнотекстовый поиск по опубликованным статьям и комментариям.
+// @Tags Поиск
+// @Produce json
+// @Param q query string true "Поисковый запрос"
+// @Param limit query int false "Количество результатов (по умолчанию 20, максимум 100)"
+// @Param offset query int false "Смещение"
+// @Success 200 {array} dto.SearchHitResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /search [get]
+func (c *SearchController) Search(ctx *gin.Context) {
+	limit, offset, err := utils.GetPaginationFromQuery(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	hits, err := c.service.Search(ctx.Query("q"), limit, offset)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrEmptySearchQuery:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrEmptySearchQuery})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToSearchHitListResponse(hits))
+}
+
+// @Summary Перестроить поисковый индекс
+// @Description Полностью перестраивает поисковый индекс по данным из базы данных.
+// @Tags Поиск
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} dto.ReindexResponse
+// @Failure 500 {object} map[string]string
+// @Router /search/reindex [post]
+func (c *SearchController) Reindex(ctx *gin.Context) {
+	indexed, err := c.service.Reindex()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrReindexFailed})
+		return
+	}
+	ctx.JSON(http.StatusOK, dto.ReindexResponse{Indexed: indexed})
+}
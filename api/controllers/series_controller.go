@@ -0,0 +1,209 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// SeriesController предоставляет методы для управления сериями статей через HTTP API.
+type SeriesController struct {
+	service *services.SeriesService
+}
+
+// NewSeriesController создаёт новый экземпляр SeriesController.
+func NewSeriesController(service *services.SeriesService) *SeriesController {
+	return &SeriesController{service: service}
+}
+
+// @Summary Создать серию
+// @Description Создает серию статей. Порядок частей задаётся порядком article_ids.
+// @Description Авторы могут добавлять в серию только свои статьи; статья может входить лишь в одну серию.
+// @Tags Серии
+// @Accept json
+// @Produce json
+// @Param series body dto.SeriesInput true "Данные серии"
+// @Security BearerAuth
+// @Success 201 {object} dto.SeriesResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /series [post]
+func (c *SeriesController) CreateSeries(ctx *gin.Context) {
+	var input dto.SeriesInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	series, err := c.service.CreateSeries(input, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusCreated, mappers.MapToSeriesResponse(series, false))
+}
+
+// @Summary Получить все серии
+// @Description Возвращает список серий с их опубликованными частями.
+// @Tags Серии
+// @Produce json
+// @Success 200 {array} dto.SeriesResponse
+// @Failure 500 {object} map[string]string
+// @Router /series [get]
+func (c *SeriesController) GetAllSeries(ctx *gin.Context) {
+	series, err := c.service.GetAllSeries()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToSeriesListResponse(series, true))
+}
+
+// @Summary Страница серии
+// @Description Возвращает серию по slug с опубликованными частями в порядке их следования.
+// @Tags Серии
+// @Produce json
+// @Param slug path string true "Slug серии"
+// @Success 200 {object} dto.SeriesResponse
+// @Failure 404 {object} map[string]string
+// @Router /series/{slug} [get]
+func (c *SeriesController) GetSeriesBySlug(ctx *gin.Context) {
+	series, err := c.service.GetSeriesBySlug(ctx.Param("slug"))
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToSeriesResponse(series, true))
+}
+
+// @Summary Обновить серию
+// @Description Обновляет название, slug и описание серии. Состав серии не меняется.
+// @Tags Серии
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID серии"
+// @Param series body dto.SeriesInput true "Данные серии"
+// @Security BearerAuth
+// @Success 200 {object} dto.SeriesResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /series/{id} [put]
+func (c *SeriesController) UpdateSeries(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidSeriesID})
+		return
+	}
+	var input dto.SeriesInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	series, err := c.service.UpdateSeries(uint(id), input, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToSeriesResponse(series, false))
+}
+
+// @Summary Изменить состав серии
+// @Description Задаёт части серии и их порядок. Статьи, не указанные в article_ids, исключаются из серии.
+// @Tags Серии
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID серии"
+// @Param parts body dto.SeriesPartsInput true "Статьи по порядку частей"
+// @Security BearerAuth
+// @Success 200 {object} dto.SeriesResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /series/{id}/articles [put]
+func (c *SeriesController) SetParts(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidSeriesID})
+		return
+	}
+	var input dto.SeriesPartsInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	series, err := c.service.SetParts(uint(id), input, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToSeriesResponse(series, false))
+}
+
+// @Summary Удалить серию
+// @Description Удаляет серию. Статьи серии остаются.
+// @Tags Серии
+// @Produce json
+// @Param id path uint true "ID серии"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /series/{id} [delete]
+func (c *SeriesController) DeleteSeries(ctx *gin.Context) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidSeriesID})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	if err := c.service.DeleteSeries(uint(id), userID, userRoles); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "series deleted successfully"})
+}
+
+// respondError преобразует ошибку сервиса серий в HTTP-ответ.
+func (c *SeriesController) respondError(ctx *gin.Context, err error) {
+	switch err.Error() {
+	case apperrors.ErrDuplicateSeriesPart, apperrors.ErrInvalidSlug:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case apperrors.ErrAccessDenied:
+		ctx.JSON(http.StatusForbidden, gin.H{"error": apperrors.ErrAccessDenied})
+	case apperrors.ErrSeriesNotFound, apperrors.ErrArticleNotFound:
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case apperrors.ErrSeriesAlreadyExists, apperrors.ErrArticleInOtherSeries:
+		ctx.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+	}
+}
@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	"github.com/AsterOzlob/content_managment_api/internal/sitemap"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// SitemapController предоставляет карту сайта и robots.txt.
+type SitemapController struct {
+	service *services.SitemapService
+}
+
+// NewSitemapController создаёт новый экземпляр SitemapController.
+func NewSitemapController(service *services.SitemapService) *SitemapController {
+	return &SitemapController{service: service}
+}
+
+// @Summary Карта сайта
+// @Description Возвращает карту сайта с опубликованными статьями, страницами авторов, тегов и рубрик.
+// @Description Если адресов больше 50 000, возвращается индекс файлов /sitemaps/sitemap-N.xml.
+// @Tags SEO
+// @Produce xml
+// @Success 200 {string} string
+// @Success 304 {string} string
+// @Failure 500 {object} map[string]string
+// @Router /sitemap.xml [get]
+func (c *SitemapController) Sitemap(ctx *gin.Context) {
+	urls, err := c.service.URLs()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	if sitemap.Pages(len(urls)) > 1 {
+		c.render(ctx, "index", urls, func() ([]byte, error) {
+			return sitemap.RenderIndex(c.service.IndexEntries(urls))
+		})
+		return
+	}
+	c.render(ctx, "urlset", urls, func() ([]byte, error) {
+		return sitemap.RenderURLSet(urls)
+	})
+}
+
+// @Summary Файл карты сайта
+// @Description Возвращает один из файлов карты сайта, перечисленных в индексе.
+// @Tags SEO
+// @Produce xml
+// @Param name path string true "Имя файла (sitemap-N.xml)"
+// @Success 200 {string} string
+// @Success 304 {string} string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /sitemaps/{name} [get]
+func (c *SitemapController) SitemapPage(ctx *gin.Context) {
+	name := ctx.Param("name")
+	page, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(name, "sitemap-"), ".xml"))
+	if err != nil || !strings.HasPrefix(name, "sitemap-") || !strings.HasSuffix(name, ".xml") {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrSitemapNotFound})
+		return
+	}
+	urls, err := c.service.URLs()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	pageURLs := sitemap.Page(urls, page)
+	if pageURLs == nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrSitemapNotFound})
+		return
+	}
+	c.render(ctx, name, pageURLs, func() ([]byte, error) {
+		return sitemap.RenderURLSet(pageURLs)
+	})
+}
+
+// @Summary robots.txt
+// @Description Возвращает правила индексации для поисковых роботов и ссылку на карту сайта.
+// @Tags SEO
+// @Produce plain
+// @Success 200 {string} string
+// @Router /robots.txt [get]
+func (c *SitemapController) Robots(ctx *gin.Context) {
+	ctx.String(http.StatusOK, c.service.Robots())
+}
+
+// render проверяет условные заголовки и отдаёт XML карты сайта.
+func (c *SitemapController) render(ctx *gin.Context, kind string, urls []sitemap.URL, build func() ([]byte, error)) {
+	lastModified := sitemap.LatestMod(urls)
+	etag := fmt.Sprintf(`W/"%s-%d-%d"`, kind, len(urls), lastModified.UnixNano())
+	if utils.NotModified(ctx, etag, lastModified) {
+		return
+	}
+	body, err := build()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.Data(http.StatusOK, sitemap.ContentType, body)
+}
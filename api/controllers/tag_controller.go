@@ -0,0 +1,189 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// TagController предоставляет методы для управления тегами через HTTP API.
+type TagController struct {
+	service *services.TagService
+}
+
+// NewTagController создаёт новый экземпляр TagController.
+func NewTagController(service *services.TagService) *TagController {
+	return &TagController{service: service}
+}
+
+// @Summary Создать тег
+// @Description Создает новый тег. Slug формируется из названия, если не указан.
+// @Tags Теги
+// @Accept json
+// @Produce json
+// @Param tag body dto.TagInput true "Данные тега"
+// @Security BearerAuth
+// @Success 201 {object} dto.TagResponse
+// @Failure 400 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /tags [post]
+func (c *TagController) CreateTag(ctx *gin.Context) {
+	var input dto.TagInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	tag, err := c.service.CreateTag(input)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrInvalidSlug:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidSlug})
+		case apperrors.ErrTagAlreadyExists:
+			ctx.JSON(http.StatusConflict, gin.H{"error": apperrors.ErrTagAlreadyExists})
+		case apperrors.ErrTagNameAlreadyExists:
+			ctx.JSON(http.StatusConflict, gin.H{"error": apperrors.ErrTagNameAlreadyExists})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusCreated, mappers.MapToTagResponse(tag))
+}
+
+// @Summary Получить все теги
+// @Description Возвращает список всех тегов с количеством опубликованных статей.
+// @Tags Теги
+// @Produce json
+// @Success 200 {array} dto.TagResponse
+// @Failure 500 {object} map[string]string
+// @Router /tags [get]
+func (c *TagController) GetAllTags(ctx *gin.Context) {
+	tags, err := c.service.GetAllTags()
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToTagWithCountListResponse(tags))
+}
+
+// @Summary Автодополнение тегов
+// @Description Возвращает теги, название которых начинается с введённой строки.
+// @Tags Теги
+// @Produce json
+// @Param q query string true "Начало названия тега"
+// @Success 200 {array} dto.TagResponse
+// @Failure 500 {object} map[string]string
+// @Router /tags/autocomplete [get]
+func (c *TagController) Autocomplete(ctx *gin.Context) {
+	tags, err := c.service.Autocomplete(ctx.Query("q"))
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToTagListResponse(tags))
+}
+
+// @Summary Получить тег по ID
+// @Description Возвращает тег по его уникальному идентификатору.
+// @Tags Теги
+// @Produce json
+// @Param id path uint true "ID тега"
+// @Success 200 {object} dto.TagResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /tags/{id} [get]
+func (c *TagController) GetTagByID(ctx *gin.Context) {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidTagID})
+		return
+	}
+	tag, err := c.service.GetTagByID(uint(id))
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrTagNotFound})
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToTagResponse(tag))
+}
+
+// @Summary Обновить тег
+// @Description Обновляет название и slug тега.
+// @Tags Теги
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID тега"
+// @Param tag body dto.TagInput true "Обновлённые данные тега"
+// @Security BearerAuth
+// @Success 200 {object} dto.TagResponse
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /tags/{id} [put]
+func (c *TagController) UpdateTag(ctx *gin.Context) {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidTagID})
+		return
+	}
+	var input dto.TagInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	tag, err := c.service.UpdateTag(uint(id), input)
+	if err != nil {
+		switch err.Error() {
+		case apperrors.ErrTagNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrTagNotFound})
+		case apperrors.ErrInvalidSlug:
+			ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidSlug})
+		case apperrors.ErrTagAlreadyExists:
+			ctx.JSON(http.StatusConflict, gin.H{"error": apperrors.ErrTagAlreadyExists})
+		case apperrors.ErrTagNameAlreadyExists:
+			ctx.JSON(http.StatusConflict, gin.H{"error": apperrors.ErrTagNameAlreadyExists})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToTagResponse(tag))
+}
+
+// @Summary Удалить тег
+// @Description Удаляет тег и снимает его со всех статей.
+// @Tags Теги
+// @Produce json
+// @Param id path uint true "ID тега"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /tags/{id} [delete]
+func (c *TagController) DeleteTag(ctx *gin.Context) {
+	idStr := ctx.Param("id")
+	id, err := strconv.ParseUint(idStr, 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidTagID})
+		return
+	}
+	if err := c.service.DeleteTag(uint(id)); err != nil {
+		switch err.Error() {
+		case apperrors.ErrTagNotFound:
+			ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrTagNotFound})
+		default:
+			ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+		}
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "tag deleted successfully"})
+}
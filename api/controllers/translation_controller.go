@@ -0,0 +1,174 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// TranslationController предоставляет методы для управления переводами статей через HTTP API.
+type TranslationController struct {
+	service *services.TranslationService
+}
+
+// NewTranslationController создаёт новый экземпляр TranslationController.
+func NewTranslationController(service *services.TranslationService) *TranslationController {
+	return &TranslationController{service: service}
+}
+
+// @Summary Получить переводы статьи
+// @Description Возвращает все переводы статьи, включая неопубликованные.
+// @Tags Переводы
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Security BearerAuth
+// @Success 200 {array} dto.TranslationResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /articles/{id}/translations [get]
+func (c *TranslationController) GetTranslations(ctx *gin.Context) {
+	articleID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	userID, err := utils.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserNotAuthenticated})
+		return
+	}
+	userRoles, err := utils.GetUserRolesFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserRolesNotFound})
+		return
+	}
+	translations, err := c.service.GetTranslations(uint(articleID), userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToTranslationListResponse(translations))
+}
+
+// @Summary Сохранить перевод статьи
+// @Description Создает или обновляет перевод статьи на указанную локаль.
+// @Description Доступно автору статьи, редакторам, модераторам и администраторам.
+// @Tags Переводы
+// @Accept json
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Param locale path string true "Локаль перевода"
+// @Param translation body dto.TranslationInput true "Данные перевода"
+// @Security BearerAuth
+// @Success 200 {object} dto.TranslationResponse
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /articles/{id}/translations/{locale} [put]
+func (c *TranslationController) SaveTranslation(ctx *gin.Context) {
+	articleID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	var input dto.TranslationInput
+	if err := ctx.ShouldBindJSON(&input); err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	userID, err := utils.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserNotAuthenticated})
+		return
+	}
+	userRoles, err := utils.GetUserRolesFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserRolesNotFound})
+		return
+	}
+	translation, err := c.service.SaveTranslation(uint(articleID), ctx.Param("locale"), input, userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToTranslationResponse(translation))
+}
+
+// @Summary Удалить перевод статьи
+// @Description Удаляет перевод статьи на указанную локаль.
+// @Tags Переводы
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Param locale path string true "Локаль перевода"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /articles/{id}/translations/{locale} [delete]
+func (c *TranslationController) DeleteTranslation(ctx *gin.Context) {
+	articleID, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": apperrors.ErrInvalidArticleID})
+		return
+	}
+	userID, err := utils.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserNotAuthenticated})
+		return
+	}
+	userRoles, err := utils.GetUserRolesFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserRolesNotFound})
+		return
+	}
+	if err := c.service.DeleteTranslation(uint(articleID), ctx.Param("locale"), userID, userRoles); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": "translation deleted successfully"})
+}
+
+// @Summary Статьи без переводов
+// @Description Возвращает статьи, у которых нет перевода на указанную локаль
+// @Description (или на любую из поддерживаемых локалей, если локаль не указана).
+// @Tags Переводы
+// @Produce json
+// @Param locale query string false "Локаль"
+// @Security BearerAuth
+// @Success 200 {array} dto.MissingTranslationResponse
+// @Failure 400 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /translations/missing [get]
+func (c *TranslationController) GetMissingTranslations(ctx *gin.Context) {
+	missing, err := c.service.GetMissingTranslations(ctx.Query("locale"))
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapToMissingTranslationListResponse(missing))
+}
+
+// respondError преобразует ошибку сервиса переводов в HTTP-ответ.
+func (c *TranslationController) respondError(ctx *gin.Context, err error) {
+	switch err.Error() {
+	case apperrors.ErrUnsupportedLocale, apperrors.ErrTranslationSameLocale:
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case apperrors.ErrAccessDenied:
+		ctx.JSON(http.StatusForbidden, gin.H{"error": apperrors.ErrAccessDenied})
+	case apperrors.ErrArticleNotFound, apperrors.ErrTranslationNotFound:
+		ctx.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+	}
+}
@@ -0,0 +1,186 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto/mappers"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// TrashController предоставляет методы для работы с корзиной через HTTP API.
+type TrashController struct {
+	service *services.TrashService
+}
+
+// NewTrashController создаёт новый экземпляр TrashController.
+func NewTrashController(service *services.TrashService) *TrashController {
+	return &TrashController{service: service}
+}
+
+// @Summary Статьи в корзине
+// @Description Возвращает удалённые статьи с датой окончательного удаления.
+// @Description Модераторы и администраторы видят все статьи, авторы — только свои.
+// @Tags Корзина
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.TrashItemResponse
+// @Failure 500 {object} map[string]string
+// @Router /trash/articles [get]
+func (c *TrashController) GetDeletedArticles(ctx *gin.Context) {
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	articles, err := c.service.GetDeletedArticles(userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapArticlesToTrashResponse(articles, c.service.Retention()))
+}
+
+// @Summary Комментарии в корзине
+// @Description Возвращает удалённые комментарии с датой окончательного удаления.
+// @Description Модераторы и администраторы видят все комментарии, остальные — только свои.
+// @Tags Корзина
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.TrashItemResponse
+// @Failure 500 {object} map[string]string
+// @Router /trash/comments [get]
+func (c *TrashController) GetDeletedComments(ctx *gin.Context) {
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	comments, err := c.service.GetDeletedComments(userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapCommentsToTrashResponse(comments, c.service.Retention()))
+}
+
+// @Summary Медиафайлы в корзине
+// @Description Возвращает удалённые медиафайлы с датой окончательного удаления.
+// @Description Модераторы и администраторы видят все файлы, авторы — только свои.
+// @Tags Корзина
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {array} dto.TrashItemResponse
+// @Failure 500 {object} map[string]string
+// @Router /trash/media [get]
+func (c *TrashController) GetDeletedMedia(ctx *gin.Context) {
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	media, err := c.service.GetDeletedMedia(userID, userRoles)
+	if err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, mappers.MapMediaToTrashResponse(media, c.service.Retention()))
+}
+
+// @Summary Восстановить статью
+// @Description Возвращает статью из корзины вместе с её комментариями в поисковый индекс.
+// @Tags Корзина
+// @Produce json
+// @Param id path uint true "ID статьи"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /trash/articles/{id}/restore [post]
+func (c *TrashController) RestoreArticle(ctx *gin.Context) {
+	c.restore(ctx, apperrors.ErrInvalidArticleID, c.service.RestoreArticle, "article restored successfully")
+}
+
+// @Summary Восстановить комментарий
+// @Description Возвращает комментарий из корзины.
+// @Tags Корзина
+// @Produce json
+// @Param id path uint true "ID комментария"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /trash/comments/{id}/restore [post]
+func (c *TrashController) RestoreComment(ctx *gin.Context) {
+	c.restore(ctx, apperrors.ErrInvalidCommentID, c.service.RestoreComment, "comment restored successfully")
+}
+
+// @Summary Восстановить медиафайл
+// @Description Возвращает медиафайл из корзины.
+// @Tags Корзина
+// @Produce json
+// @Param id path uint true "ID медиафайла"
+// @Security BearerAuth
+// @Success 200 {object} map[string]string
+// @Failure 400 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /trash/media/{id}/restore [post]
+func (c *TrashController) RestoreMedia(ctx *gin.Context) {
+	c.restore(ctx, apperrors.ErrInvalidMediaID, c.service.RestoreMedia, "media restored successfully")
+}
+
+// restore разбирает ID записи и вызывает функцию восстановления сервиса.
+func (c *TrashController) restore(
+	ctx *gin.Context,
+	invalidIDErr string,
+	restoreFn func(id uint, userID uint, userRoles []string) error,
+	message string,
+) {
+	id, err := strconv.ParseUint(ctx.Param("id"), 10, 64)
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, gin.H{"error": invalidIDErr})
+		return
+	}
+	userID, userRoles, ok := contextUser(ctx)
+	if !ok {
+		return
+	}
+	if err := restoreFn(uint(id), userID, userRoles); err != nil {
+		c.respondError(ctx, err)
+		return
+	}
+	ctx.JSON(http.StatusOK, gin.H{"message": message})
+}
+
+// respondError преобразует ошибку сервиса корзины в HTTP-ответ.
+func (c *TrashController) respondError(ctx *gin.Context, err error) {
+	switch err.Error() {
+	case apperrors.ErrAccessDenied:
+		ctx.JSON(http.StatusForbidden, gin.H{"error": apperrors.ErrAccessDenied})
+	case apperrors.ErrTrashItemNotFound:
+		ctx.JSON(http.StatusNotFound, gin.H{"error": apperrors.ErrTrashItemNotFound})
+	default:
+		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+	}
+}
+
+// contextUser извлекает пользователя и его роли из контекста; при ошибке отправляет 401.
+func contextUser(ctx *gin.Context) (uint, []string, bool) {
+	userID, err := utils.GetUserIDFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserNotAuthenticated})
+		return 0, nil, false
+	}
+	userRoles, err := utils.GetUserRolesFromContext(ctx)
+	if err != nil {
+		ctx.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": apperrors.ErrUserRolesNotFound})
+		return 0, nil, false
+	}
+	return userID, userRoles, true
+}
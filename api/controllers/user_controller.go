@@ -35,7 +35,17 @@ func (c *UserController) GetAllUsers(ctx *gin.Context) {
 		ctx.JSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
 		return
 	}
-	ctx.JSON(http.StatusOK, mappers.MapToUserListResponse(users))
+	responses := mappers.MapToUserListResponse(users)
+	ids := make([]uint, 0, len(responses))
+	for _, response := range responses {
+		ids = append(ids, response.ID)
+	}
+	counts := c.service.GetFollowCounts(ids)
+	for i := range responses {
+		responses[i].FollowersCount = counts[responses[i].ID].Followers
+		responses[i].FollowingCount = counts[responses[i].ID].Following
+	}
+	ctx.JSON(http.StatusOK, responses)
 }
 
 // @Summary Получить пользователя по ID
@@ -67,7 +77,11 @@ func (c *UserController) GetUserByID(ctx *gin.Context) {
 		}
 		return
 	}
-	ctx.JSON(http.StatusOK, mappers.MapToUserResponse(user))
+	response := mappers.MapToUserResponse(user)
+	counts := c.service.GetFollowCounts([]uint{user.ID})[user.ID]
+	response.FollowersCount = counts.Followers
+	response.FollowingCount = counts.Following
+	ctx.JSON(http.StatusOK, response)
 }
 
 // @Summary Удалить пользователя
@@ -5,15 +5,23 @@ import (
 	"net/http"
 
 	"github.com/AsterOzlob/content_managment_api/config"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
 	"github.com/AsterOzlob/content_managment_api/pkg/utils"
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
 )
 
 var ErrUnauthorized = errors.New("unauthorized")
 
-// AuthMiddleware проверяет JWT-токен в заголовке Authorization.
-func AuthMiddleware(jwtConfig *config.JWTConfig) gin.HandlerFunc {
+// BanChecker сообщает, заблокирован ли пользователь.
+type BanChecker interface {
+	IsBanned(userID uint) (bool, error)
+}
+
+// AuthMiddleware проверяет JWT-токен в заголовке Authorization и отклоняет запросы
+// заблокированных пользователей, не дожидаясь истечения их access-токена.
+func AuthMiddleware(jwtConfig *config.JWTConfig, bans BanChecker) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" || len(authHeader) < 7 || authHeader[:7] != "Bearer " {
@@ -32,9 +40,60 @@ func AuthMiddleware(jwtConfig *config.JWTConfig) gin.HandlerFunc {
 		userID := uint(claims["user_id"].(float64))
 		role := claims["role"].(string)
 
+		banned, err := bans.IsBanned(userID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": ErrUnauthorized.Error()})
+			return
+		}
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+			return
+		}
+		if banned {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": apperrors.ErrUserBanned})
+			return
+		}
+
 		// Сохраняем в контекст:
 		c.Set("userID", userID)
 		c.Set("userRoles", []string{role})
 		c.Next()
 	}
 }
+
+// OptionalAuthMiddleware сохраняет в контекст пользователя, если запрос содержит действительный JWT-токен.
+// Запросы без токена, с недействительным токеном или от заблокированного пользователя обрабатываются как анонимные.
+func OptionalAuthMiddleware(jwtConfig *config.JWTConfig, bans BanChecker) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authHeader := c.GetHeader("Authorization")
+		if len(authHeader) < 7 || authHeader[:7] != "Bearer " {
+			c.Next()
+			return
+		}
+
+		token, err := utils.ValidateAccessToken(authHeader[7:], jwtConfig)
+		if err != nil || !token.Valid {
+			c.Next()
+			return
+		}
+
+		claims := token.Claims.(jwt.MapClaims)
+		userID, okID := claims["user_id"].(float64)
+		role, okRole := claims["role"].(string)
+		if !okID || !okRole {
+			c.Next()
+			return
+		}
+
+		banned, err := bans.IsBanned(uint(userID))
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": apperrors.ErrInternalServerError})
+			return
+		}
+		if err == nil && !banned {
+			c.Set("userID", uint(userID))
+			c.Set("userRoles", []string{role})
+		}
+		c.Next()
+	}
+}
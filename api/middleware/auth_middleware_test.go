@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+type stubBans map[uint]bool
+
+func (b stubBans) IsBanned(userID uint) (bool, error) {
+	banned, ok := b[userID]
+	if !ok {
+		return false, gorm.ErrRecordNotFound
+	}
+	return banned, nil
+}
+
+func TestOptionalAuthMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	jwtConfig := &config.JWTConfig{AccessTokenSecret: "secret", AccessTokenTTL: 15}
+	bans := stubBans{1: false, 2: true}
+	token := func(userID uint) string {
+		s, err := utils.GenerateAccessToken(userID, "editor", jwtConfig)
+		if err != nil {
+			t.Fatalf("GenerateAccessToken() error = %v", err)
+		}
+		return "Bearer " + s
+	}
+	tests := []struct {
+		name   string
+		header string
+		wantID uint
+	}{
+		{name: "no token", header: ""},
+		{name: "invalid token", header: "Bearer invalid"},
+		{name: "active user", header: token(1), wantID: 1},
+		{name: "banned user is anonymous", header: token(2)},
+		{name: "deleted user is anonymous", header: token(3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotID uint
+			var gotRoles []string
+			router := gin.New()
+			router.GET("/", OptionalAuthMiddleware(jwtConfig, bans), func(c *gin.Context) {
+				gotID = c.GetUint("userID")
+				gotRoles = c.GetStringSlice("userRoles")
+				c.Status(http.StatusOK)
+			})
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			if w.Code != http.StatusOK {
+				t.Fatalf("status = %d, want %d", w.Code, http.StatusOK)
+			}
+			if gotID != tt.wantID {
+				t.Errorf("userID = %d, want %d", gotID, tt.wantID)
+			}
+			if tt.wantID == 0 && gotRoles != nil {
+				t.Errorf("userRoles = %v, want none", gotRoles)
+			}
+		})
+	}
+}
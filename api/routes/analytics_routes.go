@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterAnalyticsRoutes регистрирует маршруты отчётов по просмотрам статей.
+func RegisterAnalyticsRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	// Авторы видят статистику своих статей, администраторы — всех
+	analytics := r.Group("/analytics")
+	analytics.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+	analytics.Use(middleware.RoleMiddleware("author", "admin"))
+	{
+		analytics.GET("/top", deps.Controllers.AnalyticsCtrl.GetTopArticles)
+		analytics.GET("/referrers", deps.Controllers.AnalyticsCtrl.GetReferrers)
+		analytics.GET("/articles/:id/views", deps.Controllers.AnalyticsCtrl.GetArticleViews)
+		analytics.GET("/articles/:id/referrers", deps.Controllers.AnalyticsCtrl.GetArticleReferrers)
+	}
+}
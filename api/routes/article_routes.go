@@ -10,13 +10,18 @@ import (
 func RegisterArticleRoutes(r *gin.Engine, deps *appinit.Dependencies) {
 	content := r.Group("/articles")
 	{
-		// Открытые эндпоинты (без аутентификации)
-		content.GET("", deps.Controllers.ArticleCtrl.GetAllArticles)     // Получение списка всех статей
-		content.GET("/:id", deps.Controllers.ArticleCtrl.GetArticleByID) // Получение конкретной статьи
+		// Открытые эндпоинты: аутентификация необязательна и нужна только для просмотра черновиков
+		public := content.Group("")
+		public.Use(middleware.OptionalAuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo))
+		{
+			public.GET("", deps.Controllers.ArticleCtrl.GetAllArticles)                  // Получение списка всех статей
+			public.GET("/:id", deps.Controllers.ArticleCtrl.GetArticleByID)              // Получение конкретной статьи
+			public.GET("/:id/preview-card", deps.Controllers.ArticleCtrl.GetPreviewCard) // Социальная карточка статьи
+		}
 
 		// Защищенные эндпоинты
 		protected := content.Group("/")
-		protected.Use(middleware.AuthMiddleware(deps.JWTConfig)) // Middleware для JWT-аутентификации
+		protected.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
 		{
 			// Авторы могут создавать статьи
 			protected.POST("", middleware.RoleMiddleware("author", "admin"),
@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterCategoryRoutes регистрирует маршруты для управления рубриками.
+func RegisterCategoryRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	categories := r.Group("/categories")
+	{
+		// Открытые эндпоинты (без аутентификации)
+		categories.GET("", deps.Controllers.CategoryCtrl.GetCategoryTree)     // Дерево рубрик
+		categories.GET("/:id", deps.Controllers.CategoryCtrl.GetCategoryByID) // Получение конкретной рубрики
+
+		// Управление рубриками доступно только редакторам и администраторам
+		protected := categories.Group("/")
+		protected.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+		protected.Use(middleware.RoleMiddleware("editor", "admin"))
+		{
+			protected.POST("", deps.Controllers.CategoryCtrl.CreateCategory)
+			protected.PUT("/:id", deps.Controllers.CategoryCtrl.UpdateCategory)
+			protected.DELETE("/:id", deps.Controllers.CategoryCtrl.DeleteCategory)
+		}
+	}
+}
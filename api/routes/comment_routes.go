@@ -11,26 +11,66 @@ func RegisterCommentRoutes(r *gin.Engine, deps *appinit.Dependencies) {
 	content := r.Group("/articles")
 	{
 		protected := content.Group("/")
-		protected.Use(middleware.AuthMiddleware(deps.JWTConfig)) // Middleware для JWT-аутентификации
+		protected.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
 		{
 			// Добавление комментария к статье
-			protected.POST("/:id/comments", middleware.RoleMiddleware("user", "author", "moderator", "admin"),
+			protected.POST("/:id/comments", middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"),
 				deps.Controllers.CommentCtrl.AddCommentToArticle)
 
 			// Получение комментариев для статьи
-			protected.GET("/:id/comments", middleware.RoleMiddleware("user", "author", "moderator", "admin"),
+			protected.GET("/:id/comments", middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"),
 				deps.Controllers.CommentCtrl.GetCommentsByArticleID)
 
 			// Редактирование комментария
-			protected.PUT("/comments/:id", middleware.RoleMiddleware("user", "author", "moderator", "admin"),
+			protected.PUT("/comments/:id", middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"),
 				deps.Controllers.CommentCtrl.UpdateComment)
 		}
 	}
 
+	// Очередь модерации и массовое одобрение, отклонение или отметка комментариев как спама
+	moderation := r.Group("/comments/moderation")
+	moderation.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo), middleware.RoleMiddleware("moderator", "admin"))
+	{
+		moderation.GET("", deps.Controllers.CommentCtrl.GetModerationQueue)
+		moderation.POST("/approve", deps.Controllers.CommentCtrl.ApproveComments)
+		moderation.POST("/reject", deps.Controllers.CommentCtrl.RejectComments)
+		moderation.POST("/spam", deps.Controllers.CommentCtrl.MarkCommentsAsSpam)
+		moderation.GET("/removals", deps.Controllers.CommentCtrl.GetRemovals) // Журнал удалений веток
+	}
+
+	// История правок комментария
+	r.GET("/comments/:id/history",
+		middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo),
+		middleware.RoleMiddleware("moderator", "admin"),
+		deps.Controllers.CommentCtrl.GetRevisions,
+	)
+
+	// Удаление ветки комментариев модератором
+	r.POST("/comments/:id/remove-subtree",
+		middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo),
+		middleware.RoleMiddleware("moderator", "admin"),
+		deps.Controllers.CommentCtrl.RemoveSubtree,
+	)
+
+	// Закрытие и открытие веток комментариев
+	locks := r.Group("/comments/:id/lock")
+	locks.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo), middleware.RoleMiddleware("moderator", "admin"))
+	{
+		locks.PUT("", deps.Controllers.CommentCtrl.LockThread)
+		locks.DELETE("", deps.Controllers.CommentCtrl.UnlockThread)
+	}
+
+	// Подгрузка ответов на комментарий
+	r.GET("/comments/:id/replies",
+		middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo),
+		middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"),
+		deps.Controllers.CommentCtrl.GetReplies,
+	)
+
 	// Удаление комментария
 	r.DELETE("/comments/:id",
-		middleware.AuthMiddleware(deps.JWTConfig),
-		middleware.RoleMiddleware("user", "author", "moderator", "admin"),
+		middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo),
+		middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"),
 		deps.Controllers.CommentCtrl.DeleteComment,
 	)
 }
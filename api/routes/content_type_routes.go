@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterContentTypeRoutes регистрирует маршруты для управления типами контента.
+func RegisterContentTypeRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	contentTypes := r.Group("/content-types")
+	{
+		// Открытые эндпоинты (без аутентификации)
+		contentTypes.GET("", deps.Controllers.ContentTypeCtrl.GetAllContentTypes)   // Список типов контента
+		contentTypes.GET("/:type", deps.Controllers.ContentTypeCtrl.GetContentType) // Получение конкретного типа
+
+		// Управление определениями полей доступно только администраторам
+		protected := contentTypes.Group("/")
+		protected.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+		protected.Use(middleware.RoleMiddleware("admin"))
+		{
+			protected.POST("", deps.Controllers.ContentTypeCtrl.CreateContentType)
+			protected.PUT("/:type", deps.Controllers.ContentTypeCtrl.UpdateContentType)
+			protected.DELETE("/:type", deps.Controllers.ContentTypeCtrl.DeleteContentType)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterEntryRoutes регистрирует маршруты для записей пользовательских типов контента.
+func RegisterEntryRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	entries := r.Group("/content/:type")
+	{
+		// Открытые эндпоинты: аутентификация необязательна и нужна только для просмотра черновиков
+		public := entries.Group("")
+		public.Use(middleware.OptionalAuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo))
+		{
+			public.GET("", deps.Controllers.EntryCtrl.GetEntries)   // Получение списка записей типа
+			public.GET("/:id", deps.Controllers.EntryCtrl.GetEntry) // Получение конкретной записи
+		}
+
+		// Защищенные эндпоинты
+		protected := entries.Group("/")
+		protected.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+		{
+			// Авторы могут создавать записи
+			protected.POST("", middleware.RoleMiddleware("author", "admin"),
+				deps.Controllers.EntryCtrl.CreateEntry)
+
+			// Авторы могут редактировать свои записи, модераторы и администраторы — любые
+			protected.PUT("/:id", middleware.RoleMiddleware("author", "moderator", "admin"),
+				deps.Controllers.EntryCtrl.UpdateEntry)
+
+			// Авторы могут удалять свои записи, модераторы и администраторы — любые
+			protected.DELETE("/:id", middleware.RoleMiddleware("author", "moderator", "admin"),
+				deps.Controllers.EntryCtrl.DeleteEntry)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterFeedRoutes регистрирует маршруты лент опубликованных статей.
+func RegisterFeedRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	feeds := r.Group("/feeds")
+	{
+		// Открытые эндпоинты; фильтры задаются параметрами ?author=, ?tag=, ?category=
+		feeds.GET("/articles.rss", deps.Controllers.FeedCtrl.RSS)
+		feeds.GET("/articles.atom", deps.Controllers.FeedCtrl.Atom)
+		feeds.GET("/articles.json", deps.Controllers.FeedCtrl.JSON)
+	}
+}
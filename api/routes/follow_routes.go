@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterFollowRoutes регистрирует маршруты для подписок и персональной ленты.
+func RegisterFollowRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	// Подписываться могут все аутентифицированные пользователи
+	follows := r.Group("")
+	follows.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+	follows.Use(middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"))
+	{
+		follows.PUT("/users/:id/follow", deps.Controllers.FollowCtrl.FollowAuthor)
+		follows.DELETE("/users/:id/follow", deps.Controllers.FollowCtrl.UnfollowAuthor)
+		follows.PUT("/tags/:id/follow", deps.Controllers.FollowCtrl.FollowTag)
+		follows.DELETE("/tags/:id/follow", deps.Controllers.FollowCtrl.UnfollowTag)
+		follows.GET("/following", deps.Controllers.FollowCtrl.GetFollowing)
+		follows.GET("/feed/me", deps.Controllers.FollowCtrl.GetPersonalFeed) // Персональная лента
+	}
+}
@@ -11,7 +11,7 @@ func RegisterMediaRoutes(r *gin.Engine, deps *appinit.Dependencies) {
 	mediaGroup := r.Group("/media")
 	{
 		protected := mediaGroup.Group("/")
-		protected.Use(middleware.AuthMiddleware(deps.JWTConfig)) // Middleware для JWT-аутентификации
+		protected.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
 		{
 			// Только авторы могут загружать файлы
 			protected.POST("/upload", middleware.RoleMiddleware("author", "admin"),
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterNotificationRoutes регистрирует маршруты для уведомлений.
+func RegisterNotificationRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	// Пользователи работают только со своими уведомлениями
+	notifications := r.Group("/notifications")
+	notifications.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+	notifications.Use(middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"))
+	{
+		notifications.GET("", deps.Controllers.NotificationCtrl.GetNotifications)
+		notifications.GET("/unread-count", deps.Controllers.NotificationCtrl.GetUnreadCount)
+		notifications.POST("/read", deps.Controllers.NotificationCtrl.MarkRead)
+		notifications.GET("/preferences", deps.Controllers.NotificationCtrl.GetPreferences)
+		notifications.PUT("/preferences", deps.Controllers.NotificationCtrl.UpdatePreferences)
+	}
+}
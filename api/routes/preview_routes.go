@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterPreviewRoutes регистрирует маршруты для ссылок предпросмотра черновиков.
+func RegisterPreviewRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	// Ссылками предпросмотра управляют автор статьи, редакторы, модераторы и администраторы
+	tokens := r.Group("/articles/:id/preview-tokens")
+	tokens.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+	tokens.Use(middleware.RoleMiddleware("author", "editor", "moderator", "admin"))
+	{
+		tokens.POST("", deps.Controllers.PreviewCtrl.CreateToken)
+		tokens.GET("", deps.Controllers.PreviewCtrl.GetTokens)
+		tokens.DELETE("/:tokenId", deps.Controllers.PreviewCtrl.RevokeToken)
+	}
+
+	// Открытый эндпоинт: доступ определяется подписанным токеном
+	r.GET("/preview/:token", deps.Controllers.PreviewCtrl.OpenPreview)
+}
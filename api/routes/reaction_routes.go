@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterReactionRoutes регистрирует маршруты для реакций на статьи и комментарии.
+func RegisterReactionRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	// Открытый эндпоинт: список доступных типов реакций
+	r.GET("/reactions", deps.Controllers.ReactionCtrl.GetTypes)
+
+	// Реагировать могут все аутентифицированные пользователи
+	r.POST("/articles/:id/reactions/:type",
+		middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo),
+		middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"),
+		deps.Controllers.ReactionCtrl.ToggleArticleReaction,
+	)
+	r.POST("/comments/:id/reactions/:type",
+		middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo),
+		middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"),
+		deps.Controllers.ReactionCtrl.ToggleCommentReaction,
+	)
+}
@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterReadingListRoutes регистрирует маршруты для закладок и списков для чтения.
+func RegisterReadingListRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	// Открытый эндпоинт: публичный список по ссылке; аутентификация нужна только для просмотра черновиков
+	r.GET("/reading-lists/shared/:share_id",
+		middleware.OptionalAuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo),
+		deps.Controllers.ReadingListCtrl.GetSharedList,
+	)
+
+	// Закладки доступны всем аутентифицированным пользователям
+	bookmarks := r.Group("")
+	bookmarks.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+	bookmarks.Use(middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"))
+	{
+		bookmarks.GET("/bookmarks", deps.Controllers.ReadingListCtrl.GetBookmarks)
+		bookmarks.PUT("/articles/:id/bookmark", deps.Controllers.ReadingListCtrl.AddBookmark)
+		bookmarks.DELETE("/articles/:id/bookmark", deps.Controllers.ReadingListCtrl.RemoveBookmark)
+	}
+
+	// Пользователи управляют только своими списками для чтения
+	lists := r.Group("/reading-lists")
+	lists.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+	lists.Use(middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"))
+	{
+		lists.GET("", deps.Controllers.ReadingListCtrl.GetLists)
+		lists.POST("", deps.Controllers.ReadingListCtrl.CreateList)
+		lists.GET("/:id", deps.Controllers.ReadingListCtrl.GetList)
+		lists.PUT("/:id", deps.Controllers.ReadingListCtrl.UpdateList)
+		lists.DELETE("/:id", deps.Controllers.ReadingListCtrl.DeleteList)
+		lists.POST("/:id/articles", deps.Controllers.ReadingListCtrl.AddItem)
+		lists.PUT("/:id/articles", deps.Controllers.ReadingListCtrl.ReorderItems)
+		lists.DELETE("/:id/articles/:article_id", deps.Controllers.ReadingListCtrl.RemoveItem)
+	}
+}
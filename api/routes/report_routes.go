@@ -0,0 +1,32 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterReportRoutes регистрирует маршруты для жалоб на статьи и комментарии.
+func RegisterReportRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	// Публичный маршрут
+	r.GET("/reports/reasons", deps.Controllers.ReportCtrl.GetReasons)
+
+	// Жаловаться могут все аутентифицированные пользователи
+	reports := r.Group("")
+	reports.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+	reports.Use(middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"))
+	{
+		reports.POST("/articles/:id/reports", deps.Controllers.ReportCtrl.ReportArticle)
+		reports.POST("/comments/:id/reports", deps.Controllers.ReportCtrl.ReportComment)
+	}
+
+	// Разбор жалоб доступен модераторам и администраторам
+	triage := r.Group("/reports")
+	triage.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+	triage.Use(middleware.RoleMiddleware("moderator", "admin"))
+	{
+		triage.GET("", deps.Controllers.ReportCtrl.GetTriage)
+		triage.POST("/articles/:id/resolve", deps.Controllers.ReportCtrl.ResolveArticle)
+		triage.POST("/comments/:id/resolve", deps.Controllers.ReportCtrl.ResolveComment)
+	}
+}
@@ -12,8 +12,8 @@ func RegisterRoleRoutes(router *gin.Engine, deps *appinit.Dependencies) {
 	{
 		// Защищенные эндпоинты
 		protected := roleGroup.Group("/")
-		protected.Use(middleware.AuthMiddleware(deps.JWTConfig)) // Middleware для JWT-аутентификации
-		protected.Use(middleware.RoleMiddleware("admin"))        // Только администраторы имеют доступ
+		protected.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+		protected.Use(middleware.RoleMiddleware("admin"))                                    // Только администраторы имеют доступ
 		{
 			// Создание роли
 			protected.POST("", deps.Controllers.RoleCtrl.CreateRole)
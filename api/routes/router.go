@@ -30,6 +30,11 @@ func SetupRouter(deps *appinit.Dependencies, logger logger.Logger) *gin.Engine {
 	r.Use(middleware.SecurityHeaders())
 	r.Use(middleware.NewRateLimiter(100).Middleware())
 
+	// Карта сайта и правила для поисковых роботов
+	r.GET("/robots.txt", deps.Controllers.SitemapCtrl.Robots)
+	r.GET("/sitemap.xml", deps.Controllers.SitemapCtrl.Sitemap)
+	r.GET("/sitemaps/:name", deps.Controllers.SitemapCtrl.SitemapPage)
+
 	// Регистрируем маршруты API
 	SetupRoutes(r, deps)
 
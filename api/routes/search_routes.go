@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterSearchRoutes регистрирует маршруты для полнотекстового поиска.
+func RegisterSearchRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	searchGroup := r.Group("/search")
+	{
+		// Открытый эндпоинт поиска
+		searchGroup.GET("", deps.Controllers.SearchCtrl.Search)
+
+		// Только администраторы могут перестраивать индекс
+		searchGroup.POST("/reindex",
+			middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo),
+			middleware.RoleMiddleware("admin"),
+			deps.Controllers.SearchCtrl.Reindex,
+		)
+	}
+}
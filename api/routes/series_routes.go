@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterSeriesRoutes регистрирует маршруты для серий статей.
+func RegisterSeriesRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	series := r.Group("/series")
+	{
+		// Открытые эндпоинты
+		series.GET("", deps.Controllers.SeriesCtrl.GetAllSeries)          // Список серий
+		series.GET("/:slug", deps.Controllers.SeriesCtrl.GetSeriesBySlug) // Страница серии
+		series.GET("/:slug/feed.rss", deps.Controllers.FeedCtrl.SeriesRSS)
+		series.GET("/:slug/feed.atom", deps.Controllers.FeedCtrl.SeriesAtom)
+		series.GET("/:slug/feed.json", deps.Controllers.FeedCtrl.SeriesJSON)
+
+		// Защищенные эндпоинты
+		protected := series.Group("/")
+		protected.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+		{
+			// Авторы создают серии из своих статей, редакторы и администраторы — из любых
+			protected.POST("", middleware.RoleMiddleware("author", "editor", "admin"),
+				deps.Controllers.SeriesCtrl.CreateSeries)
+
+			// Авторы управляют своими сериями, редакторы, модераторы и администраторы — любыми
+			manage := protected.Group("/:id")
+			manage.Use(middleware.RoleMiddleware("author", "editor", "moderator", "admin"))
+			{
+				manage.PUT("", deps.Controllers.SeriesCtrl.UpdateSeries)
+				manage.PUT("/articles", deps.Controllers.SeriesCtrl.SetParts)
+				manage.DELETE("", deps.Controllers.SeriesCtrl.DeleteSeries)
+			}
+		}
+	}
+}
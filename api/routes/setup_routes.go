@@ -18,4 +18,36 @@ func SetupRoutes(router *gin.Engine, deps *appinit.Dependencies) {
 	RegisterMediaRoutes(router, deps)
 	// Регистрация маршрутов для медиа ролей
 	RegisterRoleRoutes(router, deps)
+	// Регистрация маршрутов для поиска
+	RegisterSearchRoutes(router, deps)
+	// Регистрация маршрутов для тегов
+	RegisterTagRoutes(router, deps)
+	// Регистрация маршрутов для рубрик
+	RegisterCategoryRoutes(router, deps)
+	// Регистрация маршрутов для типов контента
+	RegisterContentTypeRoutes(router, deps)
+	// Регистрация маршрутов для записей типов контента
+	RegisterEntryRoutes(router, deps)
+	// Регистрация маршрутов для переводов статей
+	RegisterTranslationRoutes(router, deps)
+	// Регистрация маршрутов для лент
+	RegisterFeedRoutes(router, deps)
+	// Регистрация маршрутов для ссылок предпросмотра
+	RegisterPreviewRoutes(router, deps)
+	// Регистрация маршрутов для корзины
+	RegisterTrashRoutes(router, deps)
+	// Регистрация маршрутов для серий статей
+	RegisterSeriesRoutes(router, deps)
+	// Регистрация маршрутов для статистики просмотров
+	RegisterAnalyticsRoutes(router, deps)
+	// Регистрация маршрутов для реакций
+	RegisterReactionRoutes(router, deps)
+	// Регистрация маршрутов для закладок и списков для чтения
+	RegisterReadingListRoutes(router, deps)
+	// Регистрация маршрутов для подписок и персональной ленты
+	RegisterFollowRoutes(router, deps)
+	// Регистрация маршрутов для жалоб
+	RegisterReportRoutes(router, deps)
+	// Регистрация маршрутов для уведомлений
+	RegisterNotificationRoutes(router, deps)
 }
@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterTagRoutes регистрирует маршруты для управления тегами.
+func RegisterTagRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	tags := r.Group("/tags")
+	{
+		// Открытые эндпоинты (без аутентификации)
+		tags.GET("", deps.Controllers.TagCtrl.GetAllTags)                // Список тегов с количеством статей
+		tags.GET("/autocomplete", deps.Controllers.TagCtrl.Autocomplete) // Автодополнение тегов
+		tags.GET("/:id", deps.Controllers.TagCtrl.GetTagByID)            // Получение конкретного тега
+
+		// Управление тегами доступно только редакторам и администраторам
+		protected := tags.Group("/")
+		protected.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+		protected.Use(middleware.RoleMiddleware("editor", "admin"))
+		{
+			protected.POST("", deps.Controllers.TagCtrl.CreateTag)
+			protected.PUT("/:id", deps.Controllers.TagCtrl.UpdateTag)
+			protected.DELETE("/:id", deps.Controllers.TagCtrl.DeleteTag)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterTranslationRoutes регистрирует маршруты для управления переводами статей.
+func RegisterTranslationRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	// Переводы конкретной статьи: автор управляет переводами своих статей,
+	// редакторы, модераторы и администраторы — любых
+	translations := r.Group("/articles/:id/translations")
+	translations.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+	translations.Use(middleware.RoleMiddleware("author", "editor", "moderator", "admin"))
+	{
+		translations.GET("", deps.Controllers.TranslationCtrl.GetTranslations)
+		translations.PUT("/:locale", deps.Controllers.TranslationCtrl.SaveTranslation)
+		translations.DELETE("/:locale", deps.Controllers.TranslationCtrl.DeleteTranslation)
+	}
+
+	// Отчёт о недостающих переводах доступен редакторам и администраторам
+	r.GET("/translations/missing",
+		middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo),
+		middleware.RoleMiddleware("editor", "admin"),
+		deps.Controllers.TranslationCtrl.GetMissingTranslations,
+	)
+}
@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"github.com/AsterOzlob/content_managment_api/api/middleware"
+	"github.com/AsterOzlob/content_managment_api/pkg/appinit"
+	"github.com/gin-gonic/gin"
+)
+
+// RegisterTrashRoutes регистрирует маршруты для работы с корзиной.
+func RegisterTrashRoutes(r *gin.Engine, deps *appinit.Dependencies) {
+	trash := r.Group("/trash")
+	trash.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
+	{
+		// Авторы восстанавливают свои статьи и файлы, модераторы и администраторы — любые
+		trash.GET("/articles", middleware.RoleMiddleware("author", "moderator", "admin"),
+			deps.Controllers.TrashCtrl.GetDeletedArticles)
+		trash.POST("/articles/:id/restore", middleware.RoleMiddleware("author", "moderator", "admin"),
+			deps.Controllers.TrashCtrl.RestoreArticle)
+		trash.GET("/media", middleware.RoleMiddleware("author", "moderator", "admin"),
+			deps.Controllers.TrashCtrl.GetDeletedMedia)
+		trash.POST("/media/:id/restore", middleware.RoleMiddleware("author", "moderator", "admin"),
+			deps.Controllers.TrashCtrl.RestoreMedia)
+
+		// Комментарии может восстановить их автор, модератор или администратор
+		trash.GET("/comments", middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"),
+			deps.Controllers.TrashCtrl.GetDeletedComments)
+		trash.POST("/comments/:id/restore", middleware.RoleMiddleware("user", "author", "editor", "moderator", "admin"),
+			deps.Controllers.TrashCtrl.RestoreComment)
+	}
+}
@@ -12,7 +12,7 @@ func RegisterUserRoutes(r *gin.Engine, deps *appinit.Dependencies) {
 	{
 		// Защищенные эндпоинты
 		protected := user.Group("/")
-		protected.Use(middleware.AuthMiddleware(deps.JWTConfig)) // Middleware для JWT-аутентификации
+		protected.Use(middleware.AuthMiddleware(deps.JWTConfig, deps.Repositories.UserRepo)) // Middleware для JWT-аутентификации
 		{
 			// Пользователь может получить информацию только о себе
 			protected.GET("/:id", middleware.RoleMiddleware("user", "admin"),
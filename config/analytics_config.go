@@ -0,0 +1,46 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+// AnalyticsConfig содержит настройки сбора статистики просмотров статей.
+type AnalyticsConfig struct {
+	Enabled       bool `env:"ANALYTICS_ENABLED" env-default:"true"`       // Включён ли сбор просмотров.
+	QueueSize     int  `env:"ANALYTICS_QUEUE_SIZE" env-default:"10000"`   // Размер очереди необработанных просмотров.
+	BatchSize     int  `env:"ANALYTICS_BATCH_SIZE" env-default:"500"`     // Количество агрегатов, при котором буфер сбрасывается в БД досрочно.
+	FlushInterval int  `env:"ANALYTICS_FLUSH_INTERVAL" env-default:"30"`  // Интервал сброса буфера в БД (в секундах).
+	MaxRangeDays  int  `env:"ANALYTICS_MAX_RANGE_DAYS" env-default:"366"` // Максимальная длина периода в отчётах (в днях).
+}
+
+// LoadAnalyticsConfig загружает настройки статистики просмотров из переменных окружения.
+func LoadAnalyticsConfig() (*AnalyticsConfig, error) {
+	var cfg AnalyticsConfig
+
+	err := cleanenv.ReadEnv(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Analytics config from environment: %w", err)
+	}
+	if cfg.QueueSize <= 0 {
+		return nil, fmt.Errorf("ANALYTICS_QUEUE_SIZE must be positive, got %d", cfg.QueueSize)
+	}
+	if cfg.BatchSize <= 0 {
+		return nil, fmt.Errorf("ANALYTICS_BATCH_SIZE must be positive, got %d", cfg.BatchSize)
+	}
+	if cfg.FlushInterval <= 0 {
+		return nil, fmt.Errorf("ANALYTICS_FLUSH_INTERVAL must be positive, got %d", cfg.FlushInterval)
+	}
+	if cfg.MaxRangeDays <= 0 {
+		return nil, fmt.Errorf("ANALYTICS_MAX_RANGE_DAYS must be positive, got %d", cfg.MaxRangeDays)
+	}
+
+	return &cfg, nil
+}
+
+// Interval возвращает интервал сброса буфера просмотров в БД.
+func (c *AnalyticsConfig) Interval() time.Duration {
+	return time.Duration(c.FlushInterval) * time.Second
+}
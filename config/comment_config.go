@@ -0,0 +1,52 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+// CommentConfig содержит настройки модерации и загрузки комментариев.
+type CommentConfig struct {
+	PremoderateNewUsers bool `env:"COMMENT_PREMODERATE_NEW_USERS" env-default:"false"` // Премодерация комментариев новых пользователей по умолчанию.
+	NewUserDays         int  `env:"COMMENT_NEW_USER_DAYS" env-default:"7"`             // Возраст учётной записи (в днях), до которого пользователь считается новым (0 — не учитывать).
+	TrustedAfter        int  `env:"COMMENT_TRUSTED_AFTER" env-default:"3"`             // Количество одобренных комментариев, после которого пользователь перестаёт считаться новым (0 — не учитывать).
+	MaxDepth            int  `env:"COMMENT_MAX_DEPTH" env-default:"5"`                 // Количество уровней ответов, загружаемых вместе с веткой.
+	EditWindowMinutes   int  `env:"COMMENT_EDIT_WINDOW" env-default:"15"`              // Время после создания (в минутах), в течение которого автор может редактировать комментарий (0 — без ограничения).
+}
+
+// LoadCommentConfig загружает настройки комментариев из переменных окружения.
+func LoadCommentConfig() (*CommentConfig, error) {
+	var cfg CommentConfig
+
+	err := cleanenv.ReadEnv(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Comment config from environment: %w", err)
+	}
+	if cfg.NewUserDays < 0 {
+		return nil, fmt.Errorf("COMMENT_NEW_USER_DAYS must not be negative, got %d", cfg.NewUserDays)
+	}
+	if cfg.TrustedAfter < 0 {
+		return nil, fmt.Errorf("COMMENT_TRUSTED_AFTER must not be negative, got %d", cfg.TrustedAfter)
+	}
+
+	if cfg.EditWindowMinutes < 0 {
+		return nil, fmt.Errorf("COMMENT_EDIT_WINDOW must not be negative, got %d", cfg.EditWindowMinutes)
+	}
+	if cfg.MaxDepth < 1 {
+		return nil, fmt.Errorf("COMMENT_MAX_DEPTH must be positive, got %d", cfg.MaxDepth)
+	}
+
+	return &cfg, nil
+}
+
+// NewUserPeriod возвращает срок, в течение которого пользователь считается новым.
+func (c *CommentConfig) NewUserPeriod() time.Duration {
+	return time.Duration(c.NewUserDays) * 24 * time.Hour
+}
+
+// EditWindow возвращает время после создания комментария, в течение которого автор может его редактировать.
+func (c *CommentConfig) EditWindow() time.Duration {
+	return time.Duration(c.EditWindowMinutes) * time.Minute
+}
@@ -8,9 +8,20 @@ import (
 
 // Config объединяет все конфигурации приложения.
 type Config struct {
-	DBConfig    *DBConfig
-	JWTConfig   *JWTConfig
-	MediaConfig *MediaConfig
+	DBConfig        *DBConfig
+	JWTConfig       *JWTConfig
+	MediaConfig     *MediaConfig
+	SearchConfig    *SearchConfig
+	LocaleConfig    *LocaleConfig
+	SiteConfig      *SiteConfig
+	SEOConfig       *SEOConfig
+	PreviewConfig   *PreviewConfig
+	TrashConfig     *TrashConfig
+	AnalyticsConfig *AnalyticsConfig
+	ReactionConfig  *ReactionConfig
+	CommentConfig   *CommentConfig
+	SpamConfig      *SpamConfig
+	ReportConfig    *ReportConfig
 }
 
 // LoadConfig загружает общую конфигурацию приложения.
@@ -33,9 +44,86 @@ func LoadConfig(logger logger.Logger) (*Config, error) {
 		return nil, fmt.Errorf("failed to load Media config: %w", err)
 	}
 
+	searchConfig, err := LoadSearchConfig()
+	if err != nil {
+		logger.WithError(err).Error("Failed to load Search config")
+		return nil, fmt.Errorf("failed to load Search config: %w", err)
+	}
+
+	localeConfig, err := LoadLocaleConfig()
+	if err != nil {
+		logger.WithError(err).Error("Failed to load Locale config")
+		return nil, fmt.Errorf("failed to load Locale config: %w", err)
+	}
+
+	siteConfig, err := LoadSiteConfig()
+	if err != nil {
+		logger.WithError(err).Error("Failed to load Site config")
+		return nil, fmt.Errorf("failed to load Site config: %w", err)
+	}
+
+	seoConfig, err := LoadSEOConfig()
+	if err != nil {
+		logger.WithError(err).Error("Failed to load SEO config")
+		return nil, fmt.Errorf("failed to load SEO config: %w", err)
+	}
+
+	previewConfig, err := LoadPreviewConfig()
+	if err != nil {
+		logger.WithError(err).Error("Failed to load Preview config")
+		return nil, fmt.Errorf("failed to load Preview config: %w", err)
+	}
+
+	trashConfig, err := LoadTrashConfig()
+	if err != nil {
+		logger.WithError(err).Error("Failed to load Trash config")
+		return nil, fmt.Errorf("failed to load Trash config: %w", err)
+	}
+
+	analyticsConfig, err := LoadAnalyticsConfig()
+	if err != nil {
+		logger.WithError(err).Error("Failed to load Analytics config")
+		return nil, fmt.Errorf("failed to load Analytics config: %w", err)
+	}
+
+	reactionConfig, err := LoadReactionConfig()
+	if err != nil {
+		logger.WithError(err).Error("Failed to load Reaction config")
+		return nil, fmt.Errorf("failed to load Reaction config: %w", err)
+	}
+
+	commentConfig, err := LoadCommentConfig()
+	if err != nil {
+		logger.WithError(err).Error("Failed to load Comment config")
+		return nil, fmt.Errorf("failed to load Comment config: %w", err)
+	}
+
+	spamConfig, err := LoadSpamConfig()
+	if err != nil {
+		logger.WithError(err).Error("Failed to load Spam config")
+		return nil, fmt.Errorf("failed to load Spam config: %w", err)
+	}
+
+	reportConfig, err := LoadReportConfig()
+	if err != nil {
+		logger.WithError(err).Error("Failed to load Report config")
+		return nil, fmt.Errorf("failed to load Report config: %w", err)
+	}
+
 	return &Config{
-		DBConfig:    dbConfig,
-		JWTConfig:   jwtConfig,
-		MediaConfig: mediaConfig,
+		DBConfig:        dbConfig,
+		JWTConfig:       jwtConfig,
+		MediaConfig:     mediaConfig,
+		SearchConfig:    searchConfig,
+		LocaleConfig:    localeConfig,
+		SiteConfig:      siteConfig,
+		SEOConfig:       seoConfig,
+		PreviewConfig:   previewConfig,
+		TrashConfig:     trashConfig,
+		AnalyticsConfig: analyticsConfig,
+		ReactionConfig:  reactionConfig,
+		CommentConfig:   commentConfig,
+		SpamConfig:      spamConfig,
+		ReportConfig:    reportConfig,
 	}, nil
 }
@@ -0,0 +1,34 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+// LocaleConfig содержит настройки локализации контента.
+type LocaleConfig struct {
+	Default   string   `env:"LOCALE_DEFAULT" env-default:"ru"`      // Локаль по умолчанию, используемая при отсутствии перевода.
+	Supported []string `env:"LOCALE_SUPPORTED" env-default:"ru,en"` // Поддерживаемые локали.
+}
+
+// LoadLocaleConfig загружает конфигурацию локализации из переменных окружения.
+func LoadLocaleConfig() (*LocaleConfig, error) {
+	var cfg LocaleConfig
+
+	err := cleanenv.ReadEnv(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Locale config from environment: %w", err)
+	}
+	if !slices.Contains(cfg.Supported, cfg.Default) {
+		return nil, fmt.Errorf("default locale %q is not in supported locales %v", cfg.Default, cfg.Supported)
+	}
+
+	return &cfg, nil
+}
+
+// IsSupported сообщает, поддерживается ли указанная локаль.
+func (c *LocaleConfig) IsSupported(locale string) bool {
+	return slices.Contains(c.Supported, locale)
+}
@@ -0,0 +1,29 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+// PreviewConfig содержит настройки ссылок предпросмотра черновиков.
+type PreviewConfig struct {
+	TokenSecret string `env:"PREVIEW_TOKEN_SECRET" env-default:"default_preview_secret"` // Секрет для подписи токенов предпросмотра.
+	DefaultTTL  int    `env:"PREVIEW_TOKEN_TTL" env-default:"72"`                        // Срок действия токена по умолчанию (в часах).
+	MaxTTL      int    `env:"PREVIEW_TOKEN_MAX_TTL" env-default:"720"`                   // Максимальный срок действия токена (в часах).
+}
+
+// LoadPreviewConfig загружает настройки предпросмотра из переменных окружения.
+func LoadPreviewConfig() (*PreviewConfig, error) {
+	var cfg PreviewConfig
+
+	err := cleanenv.ReadEnv(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Preview config from environment: %w", err)
+	}
+	if cfg.DefaultTTL <= 0 || cfg.MaxTTL < cfg.DefaultTTL {
+		return nil, fmt.Errorf("PREVIEW_TOKEN_TTL must be positive and not exceed PREVIEW_TOKEN_MAX_TTL, got %d and %d", cfg.DefaultTTL, cfg.MaxTTL)
+	}
+
+	return &cfg, nil
+}
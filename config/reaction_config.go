@@ -0,0 +1,42 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+// reactionTypePattern — допустимый формат идентификатора типа реакции.
+var reactionTypePattern = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+// ReactionConfig содержит настройки реакций на статьи и комментарии.
+type ReactionConfig struct {
+	Types []string `env:"REACTION_TYPES" env-default:"like,love,insightful,funny,sad"` // Доступные типы реакций.
+}
+
+// LoadReactionConfig загружает настройки реакций из переменных окружения.
+func LoadReactionConfig() (*ReactionConfig, error) {
+	var cfg ReactionConfig
+
+	err := cleanenv.ReadEnv(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Reaction config from environment: %w", err)
+	}
+	if len(cfg.Types) == 0 {
+		return nil, fmt.Errorf("REACTION_TYPES must not be empty")
+	}
+	for _, reactionType := range cfg.Types {
+		if !reactionTypePattern.MatchString(reactionType) {
+			return nil, fmt.Errorf("invalid reaction type %q in REACTION_TYPES", reactionType)
+		}
+	}
+
+	return &cfg, nil
+}
+
+// IsSupported сообщает, разрешён ли указанный тип реакции.
+func (c *ReactionConfig) IsSupported(reactionType string) bool {
+	return slices.Contains(c.Types, reactionType)
+}
@@ -0,0 +1,46 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+// reportReasonPattern — допустимый формат идентификатора категории жалобы.
+var reportReasonPattern = regexp.MustCompile(`^[a-z0-9_-]{1,32}$`)
+
+// ReportConfig содержит настройки жалоб на статьи и комментарии.
+type ReportConfig struct {
+	Reasons           []string `env:"REPORT_REASONS" env-default:"spam,abuse,harassment,misinformation,copyright,other"` // Доступные категории жалоб.
+	AutoHideThreshold int      `env:"REPORT_AUTO_HIDE_THRESHOLD" env-default:"5"`                                        // Количество открытых жалоб, после которого контент скрывается до решения модератора (0 — не скрывать).
+}
+
+// LoadReportConfig загружает настройки жалоб из переменных окружения.
+func LoadReportConfig() (*ReportConfig, error) {
+	var cfg ReportConfig
+
+	err := cleanenv.ReadEnv(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Report config from environment: %w", err)
+	}
+	if len(cfg.Reasons) == 0 {
+		return nil, fmt.Errorf("REPORT_REASONS must not be empty")
+	}
+	for _, reason := range cfg.Reasons {
+		if !reportReasonPattern.MatchString(reason) {
+			return nil, fmt.Errorf("invalid report reason %q in REPORT_REASONS", reason)
+		}
+	}
+	if cfg.AutoHideThreshold < 0 {
+		return nil, fmt.Errorf("REPORT_AUTO_HIDE_THRESHOLD must not be negative, got %d", cfg.AutoHideThreshold)
+	}
+
+	return &cfg, nil
+}
+
+// IsSupported сообщает, разрешена ли указанная категория жалобы.
+func (c *ReportConfig) IsSupported(reason string) bool {
+	return slices.Contains(c.Reasons, reason)
+}
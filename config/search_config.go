@@ -0,0 +1,33 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+// SearchConfig содержит настройки полнотекстового поиска.
+type SearchConfig struct {
+	Backend    string `env:"SEARCH_BACKEND" env-default:"postgres"`               // Бэкенд индекса: "postgres" или "bleve".
+	BlevePath  string `env:"SEARCH_BLEVE_PATH" env-default:"./data/search.bleve"` // Путь к индексу Bleve на диске.
+	PGLanguage string `env:"SEARCH_PG_LANGUAGE" env-default:"simple"`             // Конфигурация текстового поиска PostgreSQL.
+	QueueSize  int    `env:"SEARCH_QUEUE_SIZE" env-default:"1000"`                // Размер очереди асинхронной индексации.
+}
+
+// LoadSearchConfig загружает конфигурацию поиска из переменных окружения.
+func LoadSearchConfig() (*SearchConfig, error) {
+	var cfg SearchConfig
+
+	err := cleanenv.ReadEnv(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Search config from environment: %w", err)
+	}
+	if cfg.Backend != "postgres" && cfg.Backend != "bleve" {
+		return nil, fmt.Errorf("SEARCH_BACKEND must be postgres or bleve, got %q", cfg.Backend)
+	}
+	if cfg.QueueSize <= 0 {
+		return nil, fmt.Errorf("SEARCH_QUEUE_SIZE must be positive, got %d", cfg.QueueSize)
+	}
+
+	return &cfg, nil
+}
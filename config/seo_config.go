@@ -0,0 +1,28 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+// SEOConfig содержит настройки карты сайта и robots.txt.
+type SEOConfig struct {
+	SitemapImages   bool     `env:"SITEMAP_IMAGES" env-default:"true"`                                  // Добавлять ли изображения статей в карту сайта.
+	SitemapCacheTTL int      `env:"SITEMAP_CACHE_TTL" env-default:"300"`                                // Время кэширования карты сайта в секундах (0 — без кэша).
+	RobotsBlockAll  bool     `env:"ROBOTS_BLOCK_ALL" env-default:"false"`                               // Запретить индексацию всего сайта (например, для стендов).
+	RobotsAllow     []string `env:"ROBOTS_ALLOW"`                                                       // Пути, явно разрешённые для индексации.
+	RobotsDisallow  []string `env:"ROBOTS_DISALLOW" env-default:"/auth,/users,/media,/search,/preview"` // Пути, запрещённые для индексации.
+}
+
+// LoadSEOConfig загружает настройки карты сайта и robots.txt из переменных окружения.
+func LoadSEOConfig() (*SEOConfig, error) {
+	var cfg SEOConfig
+
+	err := cleanenv.ReadEnv(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SEO config from environment: %w", err)
+	}
+
+	return &cfg, nil
+}
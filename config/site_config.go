@@ -0,0 +1,41 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+// SiteConfig содержит публичные настройки сайта, используемые в лентах и ссылках.
+type SiteConfig struct {
+	BaseURL     string `env:"SITE_BASE_URL" env-default:"http://localhost:8080"`   // Базовый URL сайта для абсолютных ссылок.
+	Title       string `env:"SITE_TITLE" env-default:"Content Management API"`     // Название сайта.
+	Description string `env:"SITE_DESCRIPTION" env-default:"Последние публикации"` // Описание сайта.
+	FeedLimit   int    `env:"FEED_ITEM_LIMIT" env-default:"20"`                    // Количество записей в лентах.
+}
+
+// LoadSiteConfig загружает настройки сайта из переменных окружения.
+func LoadSiteConfig() (*SiteConfig, error) {
+	var cfg SiteConfig
+
+	err := cleanenv.ReadEnv(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Site config from environment: %w", err)
+	}
+	if cfg.FeedLimit <= 0 {
+		return nil, fmt.Errorf("FEED_ITEM_LIMIT must be positive, got %d", cfg.FeedLimit)
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+
+	return &cfg, nil
+}
+
+// URL возвращает абсолютный URL для пути на сайте.
+func (c *SiteConfig) URL(path string) string {
+	path = strings.TrimPrefix(path, "./")
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return c.BaseURL + path
+}
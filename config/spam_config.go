@@ -0,0 +1,69 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+// SpamConfig содержит настройки спам-фильтра комментариев.
+type SpamConfig struct {
+	Enabled          bool    `env:"SPAM_FILTER_ENABLED" env-default:"true"`   // Включить проверку новых комментариев спам-фильтром.
+	RejectThreshold  float64 `env:"SPAM_REJECT_THRESHOLD" env-default:"0.9"`  // Оценка, начиная с которой комментарий отклоняется как спам.
+	QueueThreshold   float64 `env:"SPAM_QUEUE_THRESHOLD" env-default:"0.5"`   // Оценка, начиная с которой комментарий отправляется на модерацию.
+	MaxLinks         int     `env:"SPAM_MAX_LINKS" env-default:"2"`           // Количество ссылок в комментарии, не считающееся подозрительным.
+	VelocityLimit    int     `env:"SPAM_VELOCITY_LIMIT" env-default:"5"`      // Количество комментариев пользователя за окно, после которого частота считается подозрительной.
+	VelocityWindow   int     `env:"SPAM_VELOCITY_WINDOW" env-default:"10"`    // Окно подсчёта частоты комментариев (в минутах).
+	DuplicateWindow  int     `env:"SPAM_DUPLICATE_WINDOW" env-default:"24"`   // Окно поиска комментариев с тем же текстом (в часах).
+	NewAccountHours  int     `env:"SPAM_NEW_ACCOUNT_HOURS" env-default:"24"`  // Возраст учётной записи, до которого она считается новой (в часах).
+	MinTrainingCount int     `env:"SPAM_MIN_TRAINING_COUNT" env-default:"20"` // Минимальное количество обучающих комментариев каждой метки для учёта классификатора.
+}
+
+// LoadSpamConfig загружает настройки спам-фильтра из переменных окружения.
+func LoadSpamConfig() (*SpamConfig, error) {
+	var cfg SpamConfig
+
+	err := cleanenv.ReadEnv(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Spam config from environment: %w", err)
+	}
+	if cfg.QueueThreshold <= 0 || cfg.QueueThreshold > 1 {
+		return nil, fmt.Errorf("SPAM_QUEUE_THRESHOLD must be in (0, 1], got %g", cfg.QueueThreshold)
+	}
+	if cfg.RejectThreshold < cfg.QueueThreshold || cfg.RejectThreshold > 1 {
+		return nil, fmt.Errorf("SPAM_REJECT_THRESHOLD must be in [SPAM_QUEUE_THRESHOLD, 1], got %g", cfg.RejectThreshold)
+	}
+	if cfg.MaxLinks < 0 {
+		return nil, fmt.Errorf("SPAM_MAX_LINKS must not be negative, got %d", cfg.MaxLinks)
+	}
+	if cfg.VelocityLimit <= 0 || cfg.VelocityWindow <= 0 {
+		return nil, fmt.Errorf("SPAM_VELOCITY_LIMIT and SPAM_VELOCITY_WINDOW must be positive")
+	}
+	if cfg.DuplicateWindow <= 0 {
+		return nil, fmt.Errorf("SPAM_DUPLICATE_WINDOW must be positive, got %d", cfg.DuplicateWindow)
+	}
+	if cfg.NewAccountHours < 0 {
+		return nil, fmt.Errorf("SPAM_NEW_ACCOUNT_HOURS must not be negative, got %d", cfg.NewAccountHours)
+	}
+	if cfg.MinTrainingCount <= 0 {
+		return nil, fmt.Errorf("SPAM_MIN_TRAINING_COUNT must be positive, got %d", cfg.MinTrainingCount)
+	}
+
+	return &cfg, nil
+}
+
+// Velocity возвращает окно подсчёта частоты комментариев.
+func (c *SpamConfig) Velocity() time.Duration {
+	return time.Duration(c.VelocityWindow) * time.Minute
+}
+
+// Duplicates возвращает окно поиска комментариев с тем же текстом.
+func (c *SpamConfig) Duplicates() time.Duration {
+	return time.Duration(c.DuplicateWindow) * time.Hour
+}
+
+// NewAccount возвращает возраст, до которого учётная запись считается новой.
+func (c *SpamConfig) NewAccount() time.Duration {
+	return time.Duration(c.NewAccountHours) * time.Hour
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ilyakaznacheev/cleanenv"
+)
+
+// TrashConfig содержит настройки корзины удалённого контента.
+type TrashConfig struct {
+	RetentionDays int `env:"TRASH_RETENTION_DAYS" env-default:"30"` // Срок хранения удалённых записей (в днях).
+	PurgeInterval int `env:"TRASH_PURGE_INTERVAL" env-default:"60"` // Интервал запуска очистки корзины (в минутах).
+}
+
+// LoadTrashConfig загружает настройки корзины из переменных окружения.
+func LoadTrashConfig() (*TrashConfig, error) {
+	var cfg TrashConfig
+
+	err := cleanenv.ReadEnv(&cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Trash config from environment: %w", err)
+	}
+	if cfg.RetentionDays <= 0 {
+		return nil, fmt.Errorf("TRASH_RETENTION_DAYS must be positive, got %d", cfg.RetentionDays)
+	}
+	if cfg.PurgeInterval <= 0 {
+		return nil, fmt.Errorf("TRASH_PURGE_INTERVAL must be positive, got %d", cfg.PurgeInterval)
+	}
+
+	return &cfg, nil
+}
+
+// Retention возвращает срок хранения удалённых записей.
+func (c *TrashConfig) Retention() time.Duration {
+	return time.Duration(c.RetentionDays) * 24 * time.Hour
+}
+
+// Interval возвращает интервал запуска очистки корзины.
+func (c *TrashConfig) Interval() time.Duration {
+	return time.Duration(c.PurgeInterval) * time.Minute
+}
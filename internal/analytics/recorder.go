@@ -0,0 +1,241 @@
+// Package analytics собирает просмотры статей без хранения IP-адресов:
+// посетитель идентифицируется хешем IP-адреса и User-Agent с суточной солью,
+// которая хранится только в памяти и заменяется в начале каждых суток (UTC).
+package analytics
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/logger"
+)
+
+// maxReferrerLength — максимальная длина домена источника перехода.
+const maxReferrerLength = 255
+
+// View описывает один просмотр статьи.
+type View struct {
+	ArticleID uint
+	IP        string
+	UserAgent string
+	Referrer  string
+	At        time.Time
+}
+
+// visitorKey идентифицирует посетителя статьи в пределах суток.
+type visitorKey struct {
+	articleID uint
+	visitor   [16]byte
+}
+
+// dayKey идентифицирует дневной агрегат просмотров статьи.
+type dayKey struct {
+	articleID uint
+	day       time.Time
+}
+
+// referrerKey идентифицирует дневной агрегат просмотров статьи по источнику перехода.
+type referrerKey struct {
+	dayKey
+	referrer string
+}
+
+// Recorder принимает просмотры в очередь, отбрасывает повторные просмотры посетителя
+// за сутки, накапливает дневные агрегаты в памяти и пакетно сохраняет их в БД,
+// чтобы чтение статей не ждало записи статистики.
+type Recorder struct {
+	repo      *repositories.AnalyticsRepository
+	queue     chan View
+	batchSize int
+	interval  time.Duration
+	Logger    logger.Logger
+	stop      chan struct{}
+	done      chan struct{}
+	stopOnce  sync.Once
+
+	day       time.Time
+	salt      []byte
+	seen      map[visitorKey]struct{}
+	views     map[dayKey]int
+	referrers map[referrerKey]int
+}
+
+// NewRecorder создаёт Recorder и запускает фоновый обработчик очереди.
+func NewRecorder(
+	repo *repositories.AnalyticsRepository,
+	queueSize int,
+	batchSize int,
+	interval time.Duration,
+	logger logger.Logger,
+) *Recorder {
+	recorder := &Recorder{
+		repo:      repo,
+		queue:     make(chan View, queueSize),
+		batchSize: batchSize,
+		interval:  interval,
+		Logger:    logger,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+		views:     make(map[dayKey]int),
+		referrers: make(map[referrerKey]int),
+	}
+	go recorder.run()
+	return recorder
+}
+
+// Record ставит просмотр в очередь; при переполнении очереди просмотр отбрасывается.
+func (r *Recorder) Record(view View) {
+	select {
+	case r.queue <- view:
+	default:
+		r.Logger.WithField("article_id", view.ArticleID).Warn("Analytics queue is full, dropping view")
+	}
+}
+
+// Stop останавливает обработчик: учитывает оставшиеся в очереди просмотры, сохраняет
+// накопленные агрегаты в БД и дожидается завершения. Просмотры, записанные после Stop, отбрасываются.
+func (r *Recorder) Stop() {
+	r.stopOnce.Do(func() { close(r.stop) })
+	<-r.done
+}
+
+// run обрабатывает просмотры из очереди и периодически сбрасывает агрегаты в БД.
+func (r *Recorder) run() {
+	defer close(r.done)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case view := <-r.queue:
+			r.add(view)
+			if len(r.views)+len(r.referrers) >= r.batchSize {
+				r.flush()
+			}
+		case <-ticker.C:
+			r.flush()
+		case <-r.stop:
+			r.drain()
+			r.flush()
+			return
+		}
+	}
+}
+
+// drain учитывает просмотры, оставшиеся в очереди.
+func (r *Recorder) drain() {
+	for {
+		select {
+		case view := <-r.queue:
+			r.add(view)
+		default:
+			return
+		}
+	}
+}
+
+// add учитывает просмотр, если посетитель ещё не просматривал статью в эти сутки.
+// Соль меняется только при переходе к следующим суткам. Просмотры прошлых суток, попавшие
+// в очередь после полуночи, отбрасываются: посетители тех суток уже забыты, и такой просмотр
+// нельзя проверить на повтор.
+func (r *Recorder) add(view View) {
+	day := view.At.UTC().Truncate(24 * time.Hour)
+	if day.Before(r.day) {
+		return
+	}
+	if day.After(r.day) {
+		r.rotate(day)
+	}
+	key := visitorKey{articleID: view.ArticleID, visitor: r.visitorHash(view)}
+	if _, ok := r.seen[key]; ok {
+		return
+	}
+	r.seen[key] = struct{}{}
+	dk := dayKey{articleID: view.ArticleID, day: day}
+	r.views[dk]++
+	r.referrers[referrerKey{dayKey: dk, referrer: ReferrerHost(view.Referrer)}]++
+}
+
+// rotate начинает новые сутки: генерирует новую соль и забывает посетителей прошлых суток.
+func (r *Recorder) rotate(day time.Time) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		r.Logger.WithError(err).Error("Failed to generate analytics salt")
+	}
+	r.day = day
+	r.salt = salt
+	r.seen = make(map[visitorKey]struct{})
+}
+
+// visitorHash возвращает хеш посетителя с суточной солью.
+func (r *Recorder) visitorHash(view View) [16]byte {
+	h := sha256.New()
+	h.Write(r.salt)
+	h.Write([]byte(view.IP))
+	h.Write([]byte{0})
+	h.Write([]byte(view.UserAgent))
+	var visitor [16]byte
+	copy(visitor[:], h.Sum(nil))
+	return visitor
+}
+
+// flush сохраняет накопленные агрегаты в БД. При ошибке агрегаты отбрасываются.
+func (r *Recorder) flush() {
+	if len(r.views) == 0 && len(r.referrers) == 0 {
+		return
+	}
+	views := make([]models.ArticleDailyViews, 0, len(r.views))
+	for key, count := range r.views {
+		views = append(views, models.ArticleDailyViews{ArticleID: key.articleID, Day: key.day, Views: count})
+	}
+	referrers := make([]models.ArticleDailyReferrer, 0, len(r.referrers))
+	for key, count := range r.referrers {
+		referrers = append(referrers, models.ArticleDailyReferrer{
+			ArticleID: key.articleID,
+			Day:       key.day,
+			Referrer:  key.referrer,
+			Views:     count,
+		})
+	}
+	r.views = make(map[dayKey]int)
+	r.referrers = make(map[referrerKey]int)
+	if err := r.repo.AddViews(views, referrers); err != nil {
+		r.Logger.WithField("rows", len(views)+len(referrers)).WithError(err).Error("Failed to flush article views")
+	}
+}
+
+// ReferrerHost возвращает домен источника перехода без префикса www.
+// Для прямых заходов и некорректных адресов возвращается пустая строка.
+func ReferrerHost(referrer string) string {
+	if referrer == "" {
+		return ""
+	}
+	parsed, err := url.Parse(referrer)
+	if err != nil || parsed.Hostname() == "" {
+		return ""
+	}
+	host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+	if len(host) > maxReferrerLength {
+		host = host[:maxReferrerLength]
+	}
+	return host
+}
+
+// IsBot сообщает, похож ли User-Agent на поискового робота или сервис предпросмотра ссылок.
+func IsBot(userAgent string) bool {
+	if userAgent == "" {
+		return true
+	}
+	ua := strings.ToLower(userAgent)
+	for _, marker := range []string{"bot", "crawler", "spider", "slurp", "preview", "facebookexternalhit", "curl", "wget", "python-requests", "headless"} {
+		if strings.Contains(ua, marker) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+package analytics
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReferrerHost(t *testing.T) {
+	cases := map[string]string{
+		"":                                     "",
+		"https://News.Example.COM/path":        "news.example.com",
+		"https://www.example.com/":             "example.com",
+		"https://www2.example.com/":            "www2.example.com",
+		"https://example.com:8443/a?x=1&y=2":   "example.com",
+		"android-app://org.telegram.messenger": "org.telegram.messenger",
+		"/articles/1":                          "",
+		"example.com/articles/1":               "",
+		"http://%zz":                           "",
+	}
+	for referrer, want := range cases {
+		if got := ReferrerHost(referrer); got != want {
+			t.Errorf("ReferrerHost(%q) = %q, want %q", referrer, got, want)
+		}
+	}
+
+	long := ReferrerHost("https://" + strings.Repeat("a", 300) + ".com")
+	if len(long) != maxReferrerLength {
+		t.Errorf("ReferrerHost() of a long host has length %d, want %d", len(long), maxReferrerLength)
+	}
+}
+
+func TestIsBot(t *testing.T) {
+	bots := []string{
+		"",
+		"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+		"Mozilla/5.0 (compatible; YandexBot/3.0)",
+		"facebookexternalhit/1.1",
+		"TelegramBot (like TwitterBot)",
+		"curl/8.4.0",
+		"python-requests/2.31",
+		"Mozilla/5.0 (X11; Linux x86_64) HeadlessChrome/120.0",
+	}
+	for _, ua := range bots {
+		if !IsBot(ua) {
+			t.Errorf("IsBot(%q) = false, want true", ua)
+		}
+	}
+	browsers := []string{
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 Chrome/120.0 Safari/537.36",
+		"Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) Version/17.0 Mobile/15E148 Safari/604.1",
+	}
+	for _, ua := range browsers {
+		if IsBot(ua) {
+			t.Errorf("IsBot(%q) = true, want false", ua)
+		}
+	}
+}
+
+// newTestRecorder создаёт Recorder без фонового обработчика, чтобы вызывать add напрямую.
+func newTestRecorder() *Recorder {
+	return &Recorder{
+		views:     make(map[dayKey]int),
+		referrers: make(map[referrerKey]int),
+	}
+}
+
+func TestRecorderCountsVisitorOncePerDay(t *testing.T) {
+	r := newTestRecorder()
+	morning := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	day := dayKey{articleID: 1, day: morning.Truncate(24 * time.Hour)}
+
+	r.add(View{ArticleID: 1, IP: "10.0.0.1", UserAgent: "Firefox", Referrer: "https://www.google.com/search", At: morning})
+	r.add(View{ArticleID: 1, IP: "10.0.0.1", UserAgent: "Firefox", Referrer: "https://t.me/", At: morning.Add(10 * time.Hour)})
+	r.add(View{ArticleID: 1, IP: "10.0.0.1", UserAgent: "Chrome", At: morning.Add(time.Hour)})
+	r.add(View{ArticleID: 2, IP: "10.0.0.1", UserAgent: "Firefox", At: morning})
+
+	if r.views[day] != 2 {
+		t.Errorf("views of article 1 = %d, want 2: same IP with another User-Agent is another visitor", r.views[day])
+	}
+	if r.views[dayKey{articleID: 2, day: day.day}] != 1 {
+		t.Error("view of another article by the same visitor was not counted")
+	}
+	if r.referrers[referrerKey{dayKey: day, referrer: "google.com"}] != 1 || r.referrers[referrerKey{dayKey: day, referrer: ""}] != 1 {
+		t.Errorf("referrers = %v, want google.com and a direct visit", r.referrers)
+	}
+	if _, ok := r.referrers[referrerKey{dayKey: day, referrer: "t.me"}]; ok {
+		t.Error("referrer of a repeated view was counted")
+	}
+}
+
+func TestRecorderForgetsVisitorsOnNewDay(t *testing.T) {
+	r := newTestRecorder()
+	evening := time.Date(2024, 3, 1, 23, 59, 0, 0, time.UTC)
+	view := View{ArticleID: 1, IP: "10.0.0.1", UserAgent: "Firefox", At: evening}
+
+	r.add(view)
+	firstSalt := r.salt
+	view.At = evening.Add(2 * time.Minute)
+	r.add(view)
+
+	if r.views[dayKey{articleID: 1, day: view.At.Truncate(24 * time.Hour)}] != 1 {
+		t.Error("visitor of the previous day was not counted again after midnight")
+	}
+	if string(firstSalt) == string(r.salt) {
+		t.Error("salt was not replaced after midnight")
+	}
+	if len(r.seen) != 1 {
+		t.Errorf("seen visitors = %d, want only the visitors of the new day", len(r.seen))
+	}
+}
+
+func TestRecorderUsesUTCDays(t *testing.T) {
+	r := newTestRecorder()
+	moscow := time.FixedZone("MSK", 3*60*60)
+	r.add(View{ArticleID: 1, IP: "10.0.0.1", At: time.Date(2024, 3, 2, 1, 0, 0, 0, moscow)})
+
+	if r.views[dayKey{articleID: 1, day: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}] != 1 {
+		t.Errorf("views = %v, want the view on the UTC day 2024-03-01", r.views)
+	}
+}
+
+func TestRecorderDropsViewsOfPreviousDay(t *testing.T) {
+	r := newTestRecorder()
+	midnight := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+	r.add(View{ArticleID: 1, IP: "10.0.0.1", At: midnight.Add(time.Minute)})
+	salt := r.salt
+
+	r.add(View{ArticleID: 1, IP: "10.0.0.2", At: midnight.Add(-time.Second)})
+
+	if string(r.salt) != string(salt) || !r.day.Equal(midnight) {
+		t.Error("a late view of the previous day rotated the salt back")
+	}
+	if r.views[dayKey{articleID: 1, day: midnight.AddDate(0, 0, -1)}] != 0 {
+		t.Errorf("views = %v, want the late view of the previous day dropped", r.views)
+	}
+}
@@ -0,0 +1,75 @@
+package blocks
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Типы блоков контента.
+const (
+	TypeParagraph = "paragraph"
+	TypeHeading   = "heading"
+	TypeImage     = "image"
+	TypeQuote     = "quote"
+	TypeCode      = "code"
+	TypeEmbed     = "embed"
+)
+
+// Block представляет один типизированный блок контента.
+// Набор используемых полей зависит от типа блока.
+type Block struct {
+	Type     string `json:"type"`               // Тип блока.
+	Text     string `json:"text,omitempty"`     // Текст (paragraph, heading, quote, code).
+	Level    int    `json:"level,omitempty"`    // Уровень заголовка 1–6 (heading).
+	MediaID  uint   `json:"media_id,omitempty"` // Идентификатор медиафайла (image).
+	Alt      string `json:"alt,omitempty"`      // Альтернативный текст изображения (image).
+	Caption  string `json:"caption,omitempty"`  // Подпись (image, embed).
+	Cite     string `json:"cite,omitempty"`     // Источник цитаты (quote).
+	Language string `json:"language,omitempty"` // Язык программирования (code).
+	URL      string `json:"url,omitempty"`      // Адрес встраиваемого ресурса (embed).
+}
+
+// Document — упорядоченный список блоков, хранящийся в БД как JSON.
+type Document []Block
+
+// Value сериализует документ в JSON для записи в БД.
+func (d Document) Value() (driver.Value, error) {
+	if d == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(d)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan десериализует документ из JSON, прочитанного из БД.
+func (d *Document) Scan(value interface{}) error {
+	if value == nil {
+		*d = nil
+		return nil
+	}
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported type for blocks document: %T", value)
+	}
+	return json.Unmarshal(data, d)
+}
+
+// MediaIDs возвращает идентификаторы медиафайлов, на которые ссылается документ.
+func (d Document) MediaIDs() []uint {
+	var ids []uint
+	for _, block := range d {
+		if block.Type == TypeImage && block.MediaID != 0 {
+			ids = append(ids, block.MediaID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,119 @@
+package blocks
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+)
+
+// MediaResolver возвращает адрес медиафайла по его идентификатору.
+type MediaResolver func(mediaID uint) (src string, ok bool)
+
+// codeFormatter рендерит блоки кода с CSS-классами chroma, как и Markdown-рендерер.
+var codeFormatter = chromahtml.New(chromahtml.WithClasses(true))
+
+// RenderHTML преобразует санитизированный документ в HTML.
+// Изображения, для которых resolver не вернул адрес, пропускаются.
+func RenderHTML(doc Document, resolver MediaResolver) string {
+	var b strings.Builder
+	headingIDs := make(map[string]int)
+
+	for _, block := range doc {
+		switch block.Type {
+		case TypeParagraph:
+			fmt.Fprintf(&b, "<p>%s</p>\n", block.Text)
+		case TypeHeading:
+			id := headingID(block.Text, headingIDs)
+			fmt.Fprintf(&b, "<h%d id=\"%s\">%s</h%d>\n", block.Level, html.EscapeString(id), block.Text, block.Level)
+		case TypeImage:
+			src, ok := resolver(block.MediaID)
+			if !ok {
+				continue
+			}
+			fmt.Fprintf(&b, "<figure><img src=\"%s\" alt=\"%s\">", html.EscapeString(src), block.Alt)
+			if block.Caption != "" {
+				fmt.Fprintf(&b, "<figcaption>%s</figcaption>", block.Caption)
+			}
+			b.WriteString("</figure>\n")
+		case TypeQuote:
+			fmt.Fprintf(&b, "<blockquote><p>%s</p>", block.Text)
+			if block.Cite != "" {
+				fmt.Fprintf(&b, "<cite>%s</cite>", block.Cite)
+			}
+			b.WriteString("</blockquote>\n")
+		case TypeCode:
+			b.WriteString(highlightCode(block.Text, block.Language))
+			b.WriteString("\n")
+		case TypeEmbed:
+			caption := block.Caption
+			if caption == "" {
+				caption = html.EscapeString(block.URL)
+			}
+			fmt.Fprintf(&b, "<figure class=\"embed\"><a href=\"%s\" rel=\"nofollow noopener\" target=\"_blank\">%s</a></figure>\n",
+				html.EscapeString(block.URL), caption)
+		}
+	}
+	return b.String()
+}
+
+// RenderPlainText преобразует документ в простой текст для поиска и лент.
+func RenderPlainText(doc Document) string {
+	parts := make([]string, 0, len(doc))
+	for _, block := range doc {
+		var text string
+		switch block.Type {
+		case TypeParagraph, TypeHeading, TypeQuote:
+			text = utils.StripHTML(block.Text)
+		case TypeCode:
+			text = block.Text
+		case TypeImage:
+			text = utils.StripHTML(block.Caption)
+			if text == "" {
+				text = utils.StripHTML(block.Alt)
+			}
+		case TypeEmbed:
+			text = utils.StripHTML(block.Caption)
+		}
+		if text = strings.TrimSpace(text); text != "" {
+			parts = append(parts, text)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+// headingID формирует уникальный якорь заголовка.
+func headingID(text string, used map[string]int) string {
+	base := utils.Slugify(utils.StripHTML(text))
+	if base == "" {
+		base = "heading"
+	}
+	id := base
+	if count := used[base]; count > 0 {
+		id = base + "-" + strconv.Itoa(count)
+	}
+	used[base]++
+	return id
+}
+
+// highlightCode рендерит код с подсветкой синтаксиса; при ошибке возвращает экранированный код.
+func highlightCode(code, language string) string {
+	lexer := lexers.Get(language)
+	if lexer == nil {
+		lexer = lexers.Fallback
+	}
+	iterator, err := lexer.Tokenise(nil, code)
+	if err == nil {
+		var buf bytes.Buffer
+		if err := codeFormatter.Format(&buf, styles.Fallback, iterator); err == nil {
+			return buf.String()
+		}
+	}
+	return "<pre><code>" + html.EscapeString(code) + "</code></pre>"
+}
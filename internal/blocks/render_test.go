@@ -0,0 +1,117 @@
+package blocks
+
+import (
+	"strings"
+	"testing"
+)
+
+func resolveMedia(id uint) (string, bool) {
+	if id == 1 {
+		return "/uploads/1.png", true
+	}
+	return "", false
+}
+
+func TestSanitizeKeepsOnlyInlineMarkup(t *testing.T) {
+	doc := Sanitize(Document{
+		{Type: TypeParagraph, Text: `<b>bold</b> <a href="https://ex.com">link</a><script>alert(1)</script><img src=x onerror=alert(1)>`},
+		{Type: TypeHeading, Level: 2, Text: "<em>Intro</em>"},
+		{Type: TypeQuote, Text: "<i>quote</i>", Cite: "<b>Author</b>"},
+		{Type: TypeImage, MediaID: 1, Alt: `"><script>x</script>`, Caption: "<u>caption</u>"},
+		{Type: TypeCode, Text: "<script>alert(1)</script>"},
+	})
+
+	if want := `<b>bold</b> <a href="https://ex.com" rel="nofollow">link</a>`; doc[0].Text != want {
+		t.Errorf("paragraph = %q, want %q", doc[0].Text, want)
+	}
+	if doc[1].Text != "Intro" {
+		t.Errorf("heading = %q, want markup removed", doc[1].Text)
+	}
+	if doc[2].Text != "<i>quote</i>" || doc[2].Cite != "Author" {
+		t.Errorf("quote = %q, cite = %q", doc[2].Text, doc[2].Cite)
+	}
+	if strings.Contains(doc[3].Alt, "<") || doc[3].Caption != "caption" {
+		t.Errorf("image alt = %q, caption = %q", doc[3].Alt, doc[3].Caption)
+	}
+	if doc[4].Text != "<script>alert(1)</script>" {
+		t.Errorf("code = %q, want the source unchanged", doc[4].Text)
+	}
+}
+
+func TestRenderHTML(t *testing.T) {
+	out := RenderHTML(Document{
+		{Type: TypeHeading, Level: 2, Text: "Intro"},
+		{Type: TypeHeading, Level: 3, Text: "Intro"},
+		{Type: TypeHeading, Level: 2, Text: "!!!"},
+		{Type: TypeImage, MediaID: 1, Alt: "Alt", Caption: "Caption"},
+		{Type: TypeImage, MediaID: 2, Alt: "Missing"},
+		{Type: TypeQuote, Text: "Quote", Cite: "Author"},
+		{Type: TypeEmbed, URL: "https://youtu.be/1?a=1&b=2"},
+	}, resolveMedia)
+
+	for _, want := range []string{
+		`<h2 id="intro">Intro</h2>`,
+		`<h3 id="intro-1">Intro</h3>`,
+		`<h2 id="heading">!!!</h2>`,
+		`<figure><img src="/uploads/1.png" alt="Alt"><figcaption>Caption</figcaption></figure>`,
+		`<blockquote><p>Quote</p><cite>Author</cite></blockquote>`,
+		`<a href="https://youtu.be/1?a=1&amp;b=2" rel="nofollow noopener" target="_blank">https://youtu.be/1?a=1&amp;b=2</a>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("RenderHTML() is missing %s\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Missing") {
+		t.Errorf("RenderHTML() rendered an image without a resolved media file:\n%s", out)
+	}
+}
+
+func TestRenderHTMLEscapesCode(t *testing.T) {
+	for _, language := range []string{"html", "no-such-language", ""} {
+		out := RenderHTML(Document{{Type: TypeCode, Text: "<script>alert(1)</script>", Language: language}}, resolveMedia)
+		if strings.Contains(out, "<script>") {
+			t.Errorf("language %q: code is not escaped:\n%s", language, out)
+		}
+	}
+}
+
+func TestRenderPlainText(t *testing.T) {
+	got := RenderPlainText(Document{
+		{Type: TypeHeading, Level: 2, Text: "Tom &amp; Jerry"},
+		{Type: TypeParagraph, Text: "<b>a</b> &lt; b"},
+		{Type: TypeCode, Text: "if a < b {}"},
+		{Type: TypeImage, MediaID: 1, Alt: "Alt only"},
+		{Type: TypeImage, MediaID: 1, Alt: "Alt", Caption: "Caption wins"},
+		{Type: TypeEmbed, URL: "https://youtu.be/1"},
+		{Type: TypeParagraph, Text: "   "},
+	})
+	want := "Tom & Jerry\n\na < b\n\nif a < b {}\n\nAlt only\n\nCaption wins"
+	if got != want {
+		t.Errorf("RenderPlainText() = %q, want %q", got, want)
+	}
+}
+
+func TestDocumentRoundTrip(t *testing.T) {
+	doc := Document{{Type: TypeImage, MediaID: 3}, {Type: TypeParagraph, Text: "x"}, {Type: TypeImage}}
+	value, err := doc.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	var scanned Document
+	if err := scanned.Scan([]byte(value.(string))); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if len(scanned) != 3 || scanned[0].MediaID != 3 || scanned[1].Text != "x" {
+		t.Errorf("Scan() = %+v, want %+v", scanned, doc)
+	}
+	if ids := scanned.MediaIDs(); len(ids) != 1 || ids[0] != 3 {
+		t.Errorf("MediaIDs() = %v, want [3]", ids)
+	}
+
+	if value, err := Document(nil).Value(); value != nil || err != nil {
+		t.Errorf("nil Value() = %v, %v; want NULL", value, err)
+	}
+	if err := scanned.Scan(42); err == nil {
+		t.Error("Scan(int) error = nil, want unsupported type")
+	}
+}
@@ -0,0 +1,42 @@
+package blocks
+
+import "github.com/microcosm-cc/bluemonday"
+
+// inlinePolicy разрешает только строчное форматирование внутри абзацев и цитат.
+var inlinePolicy = newInlinePolicy()
+
+// strictPolicy удаляет любую разметку из заголовков, подписей и атрибутов.
+var strictPolicy = bluemonday.StrictPolicy()
+
+func newInlinePolicy() *bluemonday.Policy {
+	policy := bluemonday.NewPolicy()
+	policy.AllowElements("b", "strong", "i", "em", "u", "s", "code", "br", "sub", "sup", "mark")
+	policy.AllowStandardURLs()
+	policy.AllowAttrs("href").OnElements("a")
+	policy.RequireNoFollowOnLinks(true)
+	return policy
+}
+
+// Sanitize очищает поля каждого блока по правилам его типа.
+// Текст блоков кода не изменяется: он экранируется при рендеринге.
+func Sanitize(doc Document) Document {
+	sanitized := make(Document, 0, len(doc))
+	for _, block := range doc {
+		switch block.Type {
+		case TypeParagraph:
+			block.Text = inlinePolicy.Sanitize(block.Text)
+		case TypeQuote:
+			block.Text = inlinePolicy.Sanitize(block.Text)
+			block.Cite = strictPolicy.Sanitize(block.Cite)
+		case TypeHeading:
+			block.Text = strictPolicy.Sanitize(block.Text)
+		case TypeImage:
+			block.Alt = strictPolicy.Sanitize(block.Alt)
+			block.Caption = strictPolicy.Sanitize(block.Caption)
+		case TypeEmbed:
+			block.Caption = strictPolicy.Sanitize(block.Caption)
+		}
+		sanitized = append(sanitized, block)
+	}
+	return sanitized
+}
@@ -0,0 +1,125 @@
+package blocks
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Ограничения документа и блоков.
+const (
+	MaxBlocks        = 500
+	maxTextLength    = 20000
+	maxHeadingLength = 255
+	maxCaptionLength = 500
+)
+
+// languagePattern ограничивает допустимые идентификаторы языков в блоках кода.
+var languagePattern = regexp.MustCompile(`^[a-zA-Z0-9+#\-_.]{1,32}$`)
+
+// EmbedHosts — хосты, контент которых разрешено встраивать.
+var EmbedHosts = map[string]bool{
+	"youtube.com":      true,
+	"www.youtube.com":  true,
+	"youtu.be":         true,
+	"vimeo.com":        true,
+	"player.vimeo.com": true,
+	"twitter.com":      true,
+	"x.com":            true,
+	"gist.github.com":  true,
+	"codepen.io":       true,
+}
+
+// ValidationError описывает ошибку в конкретном блоке документа.
+type ValidationError struct {
+	Index   int    // Порядковый номер блока.
+	Type    string // Тип блока.
+	Message string // Описание ошибки.
+}
+
+func (e *ValidationError) Error() string {
+	if e.Index < 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("block %d (%s): %s", e.Index, e.Type, e.Message)
+}
+
+// validators содержит правила проверки для каждого типа блока.
+var validators = map[string]func(Block) string{
+	TypeParagraph: func(b Block) string {
+		return requireText(b.Text, maxTextLength)
+	},
+	TypeHeading: func(b Block) string {
+		if b.Level < 1 || b.Level > 6 {
+			return "level must be between 1 and 6"
+		}
+		return requireText(b.Text, maxHeadingLength)
+	},
+	TypeImage: func(b Block) string {
+		if b.MediaID == 0 {
+			return "media_id is required"
+		}
+		if utf8.RuneCountInString(b.Alt) > maxHeadingLength {
+			return "alt is too long"
+		}
+		if utf8.RuneCountInString(b.Caption) > maxCaptionLength {
+			return "caption is too long"
+		}
+		return ""
+	},
+	TypeQuote: func(b Block) string {
+		if utf8.RuneCountInString(b.Cite) > maxHeadingLength {
+			return "cite is too long"
+		}
+		return requireText(b.Text, maxTextLength)
+	},
+	TypeCode: func(b Block) string {
+		if b.Language != "" && !languagePattern.MatchString(b.Language) {
+			return "invalid language"
+		}
+		return requireText(b.Text, maxTextLength)
+	},
+	TypeEmbed: func(b Block) string {
+		parsed, err := url.Parse(b.URL)
+		if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+			return "url must be an absolute https URL"
+		}
+		if !EmbedHosts[strings.ToLower(parsed.Hostname())] {
+			return "embedding from this host is not allowed"
+		}
+		if utf8.RuneCountInString(b.Caption) > maxCaptionLength {
+			return "caption is too long"
+		}
+		return ""
+	},
+}
+
+// Validate проверяет документ по схеме каждого типа блока.
+func Validate(doc Document) error {
+	if len(doc) > MaxBlocks {
+		return &ValidationError{Index: -1, Message: fmt.Sprintf("document must contain at most %d blocks", MaxBlocks)}
+	}
+	for i, block := range doc {
+		validate, ok := validators[block.Type]
+		if !ok {
+			return &ValidationError{Index: i, Type: block.Type, Message: "unknown block type"}
+		}
+		if message := validate(block); message != "" {
+			return &ValidationError{Index: i, Type: block.Type, Message: message}
+		}
+	}
+	return nil
+}
+
+// requireText проверяет, что текст не пустой и не превышает ограничение длины.
+func requireText(text string, maxLength int) string {
+	if strings.TrimSpace(text) == "" {
+		return "text is required"
+	}
+	if utf8.RuneCountInString(text) > maxLength {
+		return "text is too long"
+	}
+	return ""
+}
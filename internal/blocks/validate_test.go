@@ -0,0 +1,80 @@
+package blocks
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateAcceptsEveryBlockType(t *testing.T) {
+	doc := Document{
+		{Type: TypeHeading, Level: 2, Text: "Введение"},
+		{Type: TypeParagraph, Text: "Текст"},
+		{Type: TypeImage, MediaID: 1, Alt: "Схема"},
+		{Type: TypeQuote, Text: "Цитата", Cite: "Автор"},
+		{Type: TypeCode, Text: "fmt.Println()", Language: "go"},
+		{Type: TypeCode, Text: "int main() {}", Language: "c++"},
+		{Type: TypeEmbed, URL: "https://WWW.YouTube.com/watch?v=1"},
+	}
+	if err := Validate(doc); err != nil {
+		t.Fatalf("Validate() error = %v, want nil", err)
+	}
+}
+
+func TestValidateReportsBlockIndexAndType(t *testing.T) {
+	err := Validate(Document{{Type: TypeParagraph, Text: "ok"}, {Type: TypeQuote, Text: " \n\t"}})
+
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Validate() error = %v, want *ValidationError", err)
+	}
+	if verr.Index != 1 || verr.Type != TypeQuote || verr.Message != "text is required" {
+		t.Errorf("ValidationError = %+v, want block 1 (quote) with missing text", verr)
+	}
+	if err.Error() != "block 1 (quote): text is required" {
+		t.Errorf("Error() = %q", err.Error())
+	}
+}
+
+func TestValidateCountsRunesNotBytes(t *testing.T) {
+	heading := strings.Repeat("ж", maxHeadingLength)
+	if err := Validate(Document{{Type: TypeHeading, Level: 1, Text: heading}}); err != nil {
+		t.Errorf("Cyrillic heading of %d runes: error = %v, want nil", maxHeadingLength, err)
+	}
+	if err := Validate(Document{{Type: TypeHeading, Level: 1, Text: heading + "ж"}}); err == nil {
+		t.Errorf("Cyrillic heading of %d runes: error = nil, want too long", maxHeadingLength+1)
+	}
+}
+
+func TestValidateRejects(t *testing.T) {
+	for _, tt := range []struct {
+		block Block
+		want  string
+	}{
+		{Block{Type: "table"}, "unknown block type"},
+		{Block{Type: TypeHeading, Level: 0, Text: "Title"}, "level must be between 1 and 6"},
+		{Block{Type: TypeHeading, Level: 7, Text: "Title"}, "level must be between 1 and 6"},
+		{Block{Type: TypeImage}, "media_id is required"},
+		{Block{Type: TypeImage, MediaID: 1, Caption: strings.Repeat("a", maxCaptionLength+1)}, "caption is too long"},
+		{Block{Type: TypeCode, Text: "x", Language: "go lang"}, "invalid language"},
+		{Block{Type: TypeCode, Text: "x", Language: "<script>"}, "invalid language"},
+		{Block{Type: TypeEmbed, URL: "http://youtube.com/watch?v=1"}, "url must be an absolute https URL"},
+		{Block{Type: TypeEmbed, URL: "//youtube.com/watch?v=1"}, "url must be an absolute https URL"},
+		{Block{Type: TypeEmbed, URL: "javascript:alert(1)"}, "url must be an absolute https URL"},
+		{Block{Type: TypeEmbed, URL: "https://m.youtube.com/watch?v=1"}, "embedding from this host is not allowed"},
+		{Block{Type: TypeEmbed, URL: "https://youtube.com.evil.io/watch"}, "embedding from this host is not allowed"},
+	} {
+		err := Validate(Document{tt.block})
+		if err == nil || !strings.HasSuffix(err.Error(), tt.want) {
+			t.Errorf("Validate(%+v) error = %v, want %q", tt.block, err, tt.want)
+		}
+	}
+}
+
+func TestValidateBlockLimit(t *testing.T) {
+	doc := make(Document, MaxBlocks+1)
+	err := Validate(doc)
+	if err == nil || err.Error() != "document must contain at most 500 blocks" {
+		t.Fatalf("Validate() error = %v, want block limit error", err)
+	}
+}
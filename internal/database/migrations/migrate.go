@@ -7,6 +7,7 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/stats"
 )
 
 // MigrateModels выполняет миграцию моделей.
@@ -15,9 +16,35 @@ func MigrateModels(db *gorm.DB, logger logger.Logger) error {
 		&models.User{},
 		&models.Role{},
 		&models.RefreshToken{},
+		&models.Category{},
+		&models.Tag{},
 		&models.Article{},
+		&models.ArticleTranslation{},
 		&models.Media{},
 		&models.Comment{},
+		&models.SearchDocument{},
+		&models.ContentType{},
+		&models.Entry{},
+		&models.PreviewToken{},
+		&models.Series{},
+		&models.SeriesPart{},
+		&models.ArticleDailyViews{},
+		&models.ArticleDailyReferrer{},
+		&models.Reaction{},
+		&models.Bookmark{},
+		&models.ReadingList{},
+		&models.ReadingListItem{},
+		&models.AuthorFollow{},
+		&models.TagFollow{},
+		&models.SpamToken{},
+		&models.SpamDocument{},
+		&models.Report{},
+		&models.UserWarning{},
+		&models.CommentRemoval{},
+		&models.CommentRevision{},
+		&models.CommentMention{},
+		&models.Notification{},
+		&models.NotificationPreference{},
 	}
 
 	if err := db.AutoMigrate(models...); err != nil {
@@ -25,5 +52,123 @@ func MigrateModels(db *gorm.DB, logger logger.Logger) error {
 		return fmt.Errorf("failed to migrate models: %w", err)
 	}
 
+	if err := relaxCommentRepliesConstraint(db); err != nil {
+		logger.WithError(err).Error("Failed to update comment replies constraint")
+		return fmt.Errorf("failed to update comment replies constraint: %w", err)
+	}
+
+	if err := backfillArticleContent(db); err != nil {
+		logger.WithError(err).Error("Failed to backfill article content")
+		return fmt.Errorf("failed to backfill article content: %w", err)
+	}
+
+	if err := backfillContentStats(db); err != nil {
+		logger.WithError(err).Error("Failed to backfill content stats")
+		return fmt.Errorf("failed to backfill content stats: %w", err)
+	}
+
+	if err := backfillPublishedAt(db); err != nil {
+		logger.WithError(err).Error("Failed to backfill article publish dates")
+		return fmt.Errorf("failed to backfill article publish dates: %w", err)
+	}
+
+	if err := deleteTombstoneRevisions(db); err != nil {
+		logger.WithError(err).Error("Failed to delete revisions of tombstoned comments")
+		return fmt.Errorf("failed to delete revisions of tombstoned comments: %w", err)
+	}
+
 	return nil
 }
+
+// relaxCommentRepliesConstraint заменяет каскадное удаление ответов, созданное прежними версиями
+// схемы, на ON DELETE SET NULL. AutoMigrate не изменяет действия существующих внешних ключей.
+func relaxCommentRepliesConstraint(db *gorm.DB) error {
+	var action string
+	err := db.Raw("SELECT confdeltype FROM pg_constraint WHERE conname = ? AND conrelid = 'comments'::regclass",
+		"fk_comments_replies").Scan(&action).Error
+	if err != nil || action != "c" {
+		return err
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("ALTER TABLE comments DROP CONSTRAINT fk_comments_replies").Error; err != nil {
+			return err
+		}
+		return tx.Exec("ALTER TABLE comments ADD CONSTRAINT fk_comments_replies " +
+			"FOREIGN KEY (parent_id) REFERENCES comments(id) ON DELETE SET NULL").Error
+	})
+}
+
+// backfillArticleContent рендерит HTML и простой текст для статей, включая статьи в корзине,
+// сохранённых до появления колонок text_html и plain_text. Пустой text_html у статьи без текста —
+// результат рендеринга, поэтому признаком служит только NULL.
+func backfillArticleContent(db *gorm.DB) error {
+	var articles []models.Article
+	if err := db.Unscoped().Where("text_html IS NULL OR plain_text IS NULL").Find(&articles).Error; err != nil {
+		return err
+	}
+	for i := range articles {
+		if err := articles[i].RenderContent(db); err != nil {
+			return err
+		}
+		if err := db.Unscoped().Model(&articles[i]).UpdateColumns(map[string]interface{}{
+			"text_html":  articles[i].TextHTML,
+			"plain_text": articles[i].PlainText,
+		}).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillContentStats вычисляет статистику текста для статей и переводов,
+// сохранённых до появления колонок статистики.
+func backfillContentStats(db *gorm.DB) error {
+	var articles []models.Article
+	if err := db.Unscoped().Where("outline IS NULL").Find(&articles).Error; err != nil {
+		return err
+	}
+	for i := range articles {
+		articles[i].Stats = stats.Compute(articles[i].TextHTML, articles[i].Locale)
+		if err := db.Unscoped().Model(&articles[i]).UpdateColumns(statsColumns(articles[i].Stats)).Error; err != nil {
+			return err
+		}
+	}
+
+	var translations []models.ArticleTranslation
+	if err := db.Where("outline IS NULL").Find(&translations).Error; err != nil {
+		return err
+	}
+	for i := range translations {
+		translations[i].Stats = stats.Compute(translations[i].TextHTML, translations[i].Locale)
+		if err := db.Model(&translations[i]).UpdateColumns(statsColumns(translations[i].Stats)).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// backfillPublishedAt заполняет дату публикации статей, опубликованных до появления колонки
+// published_at. Точная дата неизвестна, поэтому используется дата создания статьи.
+func backfillPublishedAt(db *gorm.DB) error {
+	return db.Unscoped().Model(&models.Article{}).
+		Where("published = ? AND published_at IS NULL", true).
+		UpdateColumn("published_at", gorm.Expr("created_at")).Error
+}
+
+// deleteTombstoneRevisions удаляет историю правок комментариев, заменённых надгробиями
+// до того, как она стала удаляться вместе с текстом.
+func deleteTombstoneRevisions(db *gorm.DB) error {
+	return db.Where("comment_id IN (?)", db.Unscoped().Model(&models.Comment{}).Select("id").Where("tombstone = ?", true)).
+		Delete(&models.CommentRevision{}).Error
+}
+
+// statsColumns возвращает значения колонок статистики текста для обновления.
+func statsColumns(s stats.Stats) map[string]interface{} {
+	return map[string]interface{}{
+		"word_count":   s.WordCount,
+		"char_count":   s.CharCount,
+		"reading_time": s.ReadingTime,
+		"image_count":  s.ImageCount,
+		"outline":      s.Outline,
+	}
+}
@@ -1,37 +1,142 @@
 package models
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/AsterOzlob/content_managment_api/internal/blocks"
+	"github.com/AsterOzlob/content_managment_api/internal/stats"
 	"github.com/AsterOzlob/content_managment_api/pkg/utils"
 	"gorm.io/gorm"
 )
 
 // Article представляет контент (статью или новость).
 type Article struct {
-	ID        uint      `json:"id" gorm:"primaryKey"`                 // Уникальный идентификатор контента.
-	AuthorID  uint      `json:"author_id" gorm:"not null;index"`      // Идентификатор автора контента.
-	Title     string    `json:"title" gorm:"not null;size:255"`       // Заголовок контента.
-	Text      string    `json:"text" gorm:"not null;type:text"`       // Текст контента.
-	Published bool      `json:"published" gorm:"default:false;index"` // Опубликован ли контент.
-	CreatedAt time.Time `json:"created_at"`                           // Дата создания записи.
-	UpdatedAt time.Time `json:"updated_at"`                           // Дата последнего обновления записи.
-	Comments  []Comment `json:"comments"`                             // Комментарии к контенту.
-	Media     []Media   `json:"media"`                                // Медиафайлы, связанные с контентом.
+	ID                  uint                 `json:"id" gorm:"primaryKey"`                                                         // Уникальный идентификатор контента.
+	AuthorID            uint                 `json:"author_id" gorm:"not null;index"`                                              // Идентификатор автора контента.
+	Title               string               `json:"title" gorm:"not null;size:255"`                                               // Заголовок контента.
+	Text                string               `json:"text" gorm:"not null;type:text"`                                               // Исходный текст контента в формате Markdown.
+	Blocks              blocks.Document      `json:"blocks" gorm:"type:jsonb"`                                                     // Структурированный контент в виде блоков.
+	TextHTML            string               `json:"text_html" gorm:"type:text"`                                                   // Отрендеренный и очищенный HTML текста.
+	PlainText           string               `json:"-" gorm:"type:text"`                                                           // Простой текст для поиска и лент.
+	Published           bool                 `json:"published" gorm:"default:false;index"`                                         // Опубликован ли контент.
+	PublishedAt         *time.Time           `json:"published_at" gorm:"index"`                                                    // Дата первой публикации (nil, если статья не публиковалась).
+	Locale              string               `json:"locale" gorm:"not null;size:8;default:ru"`                                     // Локаль исходного текста.
+	CategoryID          *uint                `json:"category_id" gorm:"index"`                                                     // Идентификатор основной рубрики.
+	Category            *Category            `json:"category,omitempty" gorm:"constraint:OnDelete:SET NULL;"`                      // Основная рубрика контента.
+	Tags                []Tag                `json:"tags" gorm:"many2many:article_tags;"`                                          // Теги контента.
+	CreatedAt           time.Time            `json:"created_at"`                                                                   // Дата создания записи.
+	UpdatedAt           time.Time            `json:"updated_at"`                                                                   // Дата последнего обновления записи.
+	DeletedAt           gorm.DeletedAt       `json:"deleted_at,omitempty" gorm:"index"`                                            // Дата мягкого удаления (запись в корзине).
+	Comments            []Comment            `json:"comments"`                                                                     // Комментарии к контенту.
+	Media               []Media              `json:"media"`                                                                        // Медиафайлы, связанные с контентом.
+	Translations        []ArticleTranslation `json:"translations" gorm:"constraint:OnDelete:CASCADE;"`                             // Переводы контента.
+	MetaTitle           string               `json:"meta_title" gorm:"size:255"`                                                   // SEO-заголовок страницы.
+	MetaDescription     string               `json:"meta_description" gorm:"size:500"`                                             // SEO-описание страницы.
+	CanonicalURL        string               `json:"canonical_url" gorm:"size:2048"`                                               // Канонический URL страницы.
+	OGImageID           *uint                `json:"og_image_id" gorm:"index"`                                                     // Идентификатор изображения Open Graph.
+	OGImage             *Media               `json:"og_image,omitempty" gorm:"foreignKey:OGImageID;constraint:OnDelete:SET NULL;"` // Изображение Open Graph.
+	NoIndex             bool                 `json:"no_index" gorm:"default:false"`                                                // Запрещена ли индексация страницы поисковиками.
+	Keywords            string               `json:"keywords" gorm:"size:1024"`                                                    // Ключевые слова через запятую.
+	SeriesPart          *SeriesPart          `json:"series_part,omitempty" gorm:"constraint:OnDelete:CASCADE;"`                    // Место статьи в серии.
+	Stats               stats.Stats          `json:"stats" gorm:"embedded"`                                                        // Статистика текста, вычисляемая при сохранении.
+	ReactionCounts      ReactionCounts       `json:"reaction_counts" gorm:"type:jsonb;not null;default:'{}'"`                      // Количество реакций по типам.
+	Hidden              bool                 `json:"hidden" gorm:"not null;default:false;index"`                                   // Скрыта ли статья модератором по жалобам.
+	PremoderateComments *bool                `json:"premoderate_comments"`                                                         // Премодерация комментариев новых пользователей (nil — по глобальной настройке).
 }
 
 // BeforeCreate вызывается перед сохранением новой записи.
-// Предназначена для санитизации строковых полей и защиты от XSS-атак.
+// Санитизирует заголовок, запоминает дату публикации и рендерит контент в безопасный HTML.
+// Исходный Markdown сохраняется без изменений.
 func (a *Article) BeforeCreate(tx *gorm.DB) (err error) {
 	a.Title = utils.Sanitize(a.Title)
-	a.Text = utils.Sanitize(a.Text)
-	return nil
+	a.sanitizeSEO()
+	a.markPublished()
+	return a.RenderContent(tx)
 }
 
 // BeforeUpdate вызывается перед обновлением записи.
-// Используется для очистки данных от потенциально опасного HTML/JS.
+// Санитизирует заголовок, запоминает дату первой публикации и заново рендерит HTML из исходного контента.
 func (a *Article) BeforeUpdate(tx *gorm.DB) (err error) {
 	a.Title = utils.Sanitize(a.Title)
-	a.Text = utils.Sanitize(a.Text)
+	a.sanitizeSEO()
+	a.markPublished()
+	return a.RenderContent(tx)
+}
+
+// markPublished запоминает дату первой публикации статьи. При повторной публикации
+// после снятия с публикации дата не меняется, чтобы статья не поднималась в лентах.
+func (a *Article) markPublished() {
+	if a.Published && a.PublishedAt == nil {
+		now := time.Now()
+		a.PublishedAt = &now
+	}
+}
+
+// RenderContent обновляет кэшированные HTML, простой текст и статистику текста.
+// Если у статьи есть блоки, они санитизируются поблочно и имеют приоритет над Markdown-текстом.
+func (a *Article) RenderContent(tx *gorm.DB) error {
+	if len(a.Blocks) > 0 {
+		a.Blocks = blocks.Sanitize(a.Blocks)
+		resolver, err := mediaResolver(tx, a.Blocks.MediaIDs())
+		if err != nil {
+			return fmt.Errorf("failed to resolve article block media: %w", err)
+		}
+		a.TextHTML = blocks.RenderHTML(a.Blocks, resolver)
+		a.PlainText = blocks.RenderPlainText(a.Blocks)
+		a.Stats = stats.Compute(a.TextHTML, a.Locale)
+		return nil
+	}
+
+	textHTML, err := utils.RenderMarkdown(a.Text)
+	if err != nil {
+		return fmt.Errorf("failed to render article markdown: %w", err)
+	}
+	a.TextHTML = textHTML
+	a.PlainText = utils.StripHTML(textHTML)
+	a.Stats = stats.Compute(a.TextHTML, a.Locale)
 	return nil
 }
+
+// sanitizeSEO очищает текстовые SEO-метаданные от HTML. Значения хранятся без HTML-экранирования
+// и экранируются при выводе. Канонический URL проверяется при привязке запроса и хранится как есть.
+func (a *Article) sanitizeSEO() {
+	a.MetaTitle = utils.StripHTML(a.MetaTitle)
+	a.MetaDescription = utils.StripHTML(a.MetaDescription)
+	a.Keywords = utils.StripHTML(a.Keywords)
+}
+
+// IsPublic сообщает, видна ли статья всем читателям: опубликована и не скрыта модератором.
+func (a *Article) IsPublic() bool {
+	return a.Published && !a.Hidden
+}
+
+// KeywordList возвращает ключевые слова статьи в виде списка.
+func (a *Article) KeywordList() []string {
+	keywords := []string{}
+	for _, keyword := range strings.Split(a.Keywords, ",") {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			keywords = append(keywords, keyword)
+		}
+	}
+	return keywords
+}
+
+// mediaResolver загружает медиафайлы, на которые ссылаются блоки, и возвращает функцию поиска их путей.
+func mediaResolver(tx *gorm.DB, ids []uint) (blocks.MediaResolver, error) {
+	paths := make(map[uint]string, len(ids))
+	if len(ids) > 0 {
+		var media []Media
+		if err := tx.Session(&gorm.Session{NewDB: true}).Where("id IN ?", ids).Find(&media).Error; err != nil {
+			return nil, err
+		}
+		for _, m := range media {
+			paths[m.ID] = m.FilePath
+		}
+	}
+	return func(mediaID uint) (string, bool) {
+		path, ok := paths[mediaID]
+		return path, ok
+	}, nil
+}
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// ArticleDailyViews содержит количество уникальных просмотров статьи за сутки (UTC).
+type ArticleDailyViews struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`                                                    // Уникальный идентификатор записи.
+	ArticleID uint      `json:"article_id" gorm:"not null;uniqueIndex:idx_article_daily_views"`          // Идентификатор статьи.
+	Article   *Article  `json:"-" gorm:"constraint:OnDelete:CASCADE;"`                                   // Статья.
+	Day       time.Time `json:"day" gorm:"type:date;not null;uniqueIndex:idx_article_daily_views;index"` // Дата просмотров.
+	Views     int       `json:"views" gorm:"not null;default:0"`                                         // Количество уникальных просмотров.
+}
+
+// ArticleDailyReferrer содержит количество уникальных просмотров статьи за сутки (UTC)
+// с разбивкой по источнику перехода.
+type ArticleDailyReferrer struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`                                                                // Уникальный идентификатор записи.
+	ArticleID uint      `json:"article_id" gorm:"not null;uniqueIndex:idx_article_daily_referrer"`                   // Идентификатор статьи.
+	Article   *Article  `json:"-" gorm:"constraint:OnDelete:CASCADE;"`                                               // Статья.
+	Day       time.Time `json:"day" gorm:"type:date;not null;uniqueIndex:idx_article_daily_referrer;index"`          // Дата просмотров.
+	Referrer  string    `json:"referrer" gorm:"not null;size:255;default:'';uniqueIndex:idx_article_daily_referrer"` // Домен источника перехода (пусто — прямой заход).
+	Views     int       `json:"views" gorm:"not null;default:0"`                                                     // Количество уникальных просмотров.
+}
@@ -0,0 +1,57 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestArticleSanitizeSEO(t *testing.T) {
+	article := Article{
+		MetaTitle:       "<b>Tom &amp; Jerry</b>'s",
+		MetaDescription: "<script>alert(1)</script>a < b & c",
+		CanonicalURL:    "https://ex.com/a?x=1&y=2",
+		Keywords:        "<i>go</i>,R&D",
+	}
+	article.sanitizeSEO()
+
+	want := Article{
+		MetaTitle:       "Tom & Jerry's",
+		MetaDescription: "a < b & c",
+		CanonicalURL:    "https://ex.com/a?x=1&y=2",
+		Keywords:        "go,R&D",
+	}
+	if article.MetaTitle != want.MetaTitle || article.MetaDescription != want.MetaDescription ||
+		article.CanonicalURL != want.CanonicalURL || article.Keywords != want.Keywords {
+		t.Errorf("sanitizeSEO() = %q, %q, %q, %q; want %q, %q, %q, %q",
+			article.MetaTitle, article.MetaDescription, article.CanonicalURL, article.Keywords,
+			want.MetaTitle, want.MetaDescription, want.CanonicalURL, want.Keywords)
+	}
+
+	// Значения санитизируются при каждом сохранении, поэтому повторная очистка не должна их менять.
+	article.sanitizeSEO()
+	if article.MetaTitle != want.MetaTitle || article.MetaDescription != want.MetaDescription {
+		t.Errorf("second sanitizeSEO() changed the values to %q, %q", article.MetaTitle, article.MetaDescription)
+	}
+}
+
+func TestArticleMarkPublished(t *testing.T) {
+	draft := Article{}
+	draft.markPublished()
+	if draft.PublishedAt != nil {
+		t.Errorf("markPublished() set PublishedAt = %v for a draft", draft.PublishedAt)
+	}
+
+	first := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	republished := Article{Published: true, PublishedAt: &first}
+	republished.markPublished()
+	if !republished.PublishedAt.Equal(first) {
+		t.Errorf("markPublished() moved the first publish date to %v", republished.PublishedAt)
+	}
+
+	before := time.Now()
+	published := Article{Published: true, CreatedAt: first}
+	published.markPublished()
+	if published.PublishedAt == nil || published.PublishedAt.Before(before) {
+		t.Errorf("markPublished() = %v, want the current time rather than the creation date", published.PublishedAt)
+	}
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/stats"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// ArticleTranslation представляет перевод статьи на одну из поддерживаемых локалей.
+type ArticleTranslation struct {
+	ID        uint        `json:"id" gorm:"primaryKey"`                                                    // Уникальный идентификатор перевода.
+	ArticleID uint        `json:"article_id" gorm:"not null;uniqueIndex:idx_article_translation"`          // Идентификатор переводимой статьи.
+	Locale    string      `json:"locale" gorm:"not null;size:8;uniqueIndex:idx_article_translation;index"` // Локаль перевода (например, "en").
+	Title     string      `json:"title" gorm:"not null;size:255"`                                          // Заголовок перевода.
+	Text      string      `json:"text" gorm:"not null;type:text"`                                          // Текст перевода в формате Markdown.
+	TextHTML  string      `json:"text_html" gorm:"type:text"`                                              // Отрендеренный и очищенный HTML текста.
+	Published bool        `json:"published" gorm:"default:false"`                                          // Опубликован ли перевод.
+	CreatedAt time.Time   `json:"created_at"`                                                              // Дата создания записи.
+	UpdatedAt time.Time   `json:"updated_at"`                                                              // Дата последнего обновления записи.
+	Stats     stats.Stats `json:"stats" gorm:"embedded"`                                                   // Статистика текста перевода.
+}
+
+// BeforeCreate вызывается перед сохранением новой записи.
+// Санитизирует заголовок и рендерит Markdown-текст в безопасный HTML.
+func (t *ArticleTranslation) BeforeCreate(tx *gorm.DB) (err error) {
+	t.Title = utils.Sanitize(t.Title)
+	return t.renderText()
+}
+
+// BeforeUpdate вызывается перед обновлением записи.
+// Санитизирует заголовок и заново рендерит HTML из исходного Markdown.
+func (t *ArticleTranslation) BeforeUpdate(tx *gorm.DB) (err error) {
+	t.Title = utils.Sanitize(t.Title)
+	return t.renderText()
+}
+
+// renderText обновляет кэшированный HTML и статистику по исходному Markdown-тексту.
+func (t *ArticleTranslation) renderText() error {
+	textHTML, err := utils.RenderMarkdown(t.Text)
+	if err != nil {
+		return fmt.Errorf("failed to render translation markdown: %w", err)
+	}
+	t.TextHTML = textHTML
+	t.Stats = stats.Compute(t.TextHTML, t.Locale)
+	return nil
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// Category представляет рубрику в иерархическом дереве разделов.
+type Category struct {
+	ID          uint       `json:"id" gorm:"primaryKey"`                                                        // Уникальный идентификатор рубрики.
+	ParentID    *uint      `json:"parent_id" gorm:"index"`                                                      // Идентификатор родительской рубрики (если есть).
+	Name        string     `json:"name" gorm:"not null;size:128"`                                               // Название рубрики.
+	Slug        string     `json:"slug" gorm:"unique;not null;size:128"`                                        // Человекочитаемый идентификатор для URL.
+	Description string     `json:"description" gorm:"type:text"`                                                // Описание рубрики.
+	CreatedAt   time.Time  `json:"created_at"`                                                                  // Дата создания записи.
+	UpdatedAt   time.Time  `json:"updated_at"`                                                                  // Дата последнего обновления записи.
+	Children    []Category `json:"children,omitempty" gorm:"foreignKey:ParentID;constraint:OnDelete:RESTRICT;"` // Дочерние рубрики.
+}
+
+// BeforeCreate вызывается перед сохранением новой записи.
+// Предназначена для санитизации строковых полей и защиты от XSS-атак.
+func (c *Category) BeforeCreate(tx *gorm.DB) (err error) {
+	c.Name = utils.Sanitize(c.Name)
+	c.Description = utils.Sanitize(c.Description)
+	return nil
+}
+
+// BeforeUpdate вызывается перед обновлением записи.
+// Используется для очистки данных от потенциально опасного HTML/JS.
+func (c *Category) BeforeUpdate(tx *gorm.DB) (err error) {
+	c.Name = utils.Sanitize(c.Name)
+	c.Description = utils.Sanitize(c.Description)
+	return nil
+}
@@ -7,18 +7,48 @@ import (
 	"gorm.io/gorm"
 )
 
+// Статусы модерации комментария.
+const (
+	CommentStatusPending  = "pending"  // Ожидает проверки модератором.
+	CommentStatusApproved = "approved" // Опубликован.
+	CommentStatusRejected = "rejected" // Отклонён модератором.
+	CommentStatusSpam     = "spam"     // Отмечен как спам.
+)
+
+// CommentTombstoneText — текст, который остаётся вместо удалённого комментария с ответами.
+const CommentTombstoneText = "[deleted]"
+
 // Comment представляет комментарий к контенту.
 type Comment struct {
-	ID        uint      `json:"id" gorm:"primaryKey"`             // Уникальный идентификатор комментария.
-	ParentID  *uint     `json:"parent_id" gorm:"index"`           // Идентификатор родительского комментария (если есть).
-	ArticleID uint      `json:"article_id" gorm:"not null;index"` // Идентификатор контента, к которому относится комментарий.
-	AuthorID  uint      `json:"author_id" gorm:"not null;index"`  // Идентификатор автора комментария.
-	Text      string    `json:"text" gorm:"not null;type:text"`   // Текст комментария.
-	CreatedAt time.Time `json:"created_at"`                       // Дата создания комментария.
-	UpdatedAt time.Time `json:"updated_at"`                       // Дата последнего обновления комментария.
-
-	// Вложенные комментарии (рекурсивная связь)
-	Replies []Comment `json:"replies,omitempty" gorm:"foreignKey:ParentID;constraint:OnDelete:CASCADE;"` // Дочерние комментарии.
+	ID             uint           `json:"id" gorm:"primaryKey"`                                    // Уникальный идентификатор комментария.
+	ParentID       *uint          `json:"parent_id" gorm:"index"`                                  // Идентификатор родительского комментария (если есть).
+	ArticleID      uint           `json:"article_id" gorm:"not null;index"`                        // Идентификатор контента, к которому относится комментарий.
+	AuthorID       uint           `json:"author_id" gorm:"not null;index"`                         // Идентификатор автора комментария.
+	Text           string         `json:"text" gorm:"not null;type:text"`                          // Текст комментария.
+	CreatedAt      time.Time      `json:"created_at"`                                              // Дата создания комментария.
+	UpdatedAt      time.Time      `json:"updated_at"`                                              // Дата последнего обновления комментария.
+	EditedAt       *time.Time     `json:"edited_at"`                                               // Дата последнего редактирования текста (nil — не редактировался).
+	DeletedAt      gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index"`                       // Дата мягкого удаления (комментарий в корзине).
+	ReactionCounts ReactionCounts `json:"reaction_counts" gorm:"type:jsonb;not null;default:'{}'"` // Количество реакций по типам.
+	Status         string         `json:"status" gorm:"not null;size:16;default:approved;index"`   // Статус модерации комментария.
+	SpamScore      float64        `json:"spam_score" gorm:"not null;default:0"`                    // Оценка спам-фильтра от 0 до 1.
+	SpamReasons    string         `json:"spam_reasons" gorm:"size:255"`                            // Сработавшие признаки спама через запятую.
+	Hidden         bool           `json:"hidden" gorm:"not null;default:false"`                    // Скрыт ли комментарий по жалобам.
+	Locked         bool           `json:"locked" gorm:"not null;default:false"`                    // Закрыта ли ветка под комментарием для новых ответов.
+	Tombstone      bool           `json:"tombstone" gorm:"not null;default:false"`                 // Удалён ли комментарий с сохранением ветки ответов (автор и текст стёрты).
+	ReplyCount     int            `json:"reply_count" gorm:"-"`                                    // Количество видимых читателю прямых ответов (вычисляется при загрузке ветки).
+
+	// Упомянутые в тексте пользователи
+	Mentions []CommentMention `json:"mentions,omitempty" gorm:"constraint:OnDelete:CASCADE;"` // Упоминания пользователей.
+
+	// Вложенные комментарии (рекурсивная связь). Ответы не удаляются каскадно вместе с родителем:
+	// ветки удаляются явно, а удаление одного комментария не должно стирать ответы на него.
+	Replies []Comment `json:"replies,omitempty" gorm:"foreignKey:ParentID;constraint:OnDelete:SET NULL;"` // Дочерние комментарии.
+}
+
+// IsPublic сообщает, виден ли комментарий всем читателям.
+func (c *Comment) IsPublic() bool {
+	return c.Status == CommentStatusApproved && !c.Hidden
 }
 
 // BeforeCreate вызывается перед сохранением новой записи.
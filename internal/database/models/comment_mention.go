@@ -0,0 +1,8 @@
+package models
+
+// CommentMention представляет упоминание пользователя (@username) в тексте комментария.
+type CommentMention struct {
+	CommentID uint  `json:"comment_id" gorm:"primaryKey"`                       // Идентификатор комментария.
+	UserID    uint  `json:"user_id" gorm:"primaryKey;index"`                    // Идентификатор упомянутого пользователя.
+	User      *User `json:"user,omitempty" gorm:"constraint:OnDelete:CASCADE;"` // Упомянутый пользователь.
+}
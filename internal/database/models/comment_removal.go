@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// CommentRemoval представляет запись журнала удаления модератором ветки комментариев.
+// Запись не связана с комментарием внешним ключом и сохраняется после его удаления.
+type CommentRemoval struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`               // Уникальный идентификатор записи.
+	CommentID    uint      `json:"comment_id" gorm:"not null;index"`   // Идентификатор удалённого корневого комментария ветки.
+	ArticleID    uint      `json:"article_id" gorm:"not null;index"`   // Идентификатор статьи.
+	AuthorID     uint      `json:"author_id" gorm:"not null"`          // Идентификатор автора корневого комментария.
+	ModeratorID  uint      `json:"moderator_id" gorm:"not null;index"` // Идентификатор модератора.
+	Reason       string    `json:"reason" gorm:"not null;size:1000"`   // Причина удаления.
+	RemovedCount int       `json:"removed_count" gorm:"not null"`      // Количество удалённых комментариев, включая корневой.
+	CreatedAt    time.Time `json:"created_at"`                         // Дата удаления.
+}
+
+// BeforeCreate вызывается перед сохранением новой записи.
+// Санитизирует причину удаления.
+func (r *CommentRemoval) BeforeCreate(tx *gorm.DB) (err error) {
+	r.Reason = utils.Sanitize(r.Reason)
+	return nil
+}
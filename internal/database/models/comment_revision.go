@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+// CommentRevision представляет прежнюю версию текста комментария, сохранённую при редактировании.
+type CommentRevision struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`                  // Уникальный идентификатор версии.
+	CommentID uint      `json:"comment_id" gorm:"not null;index"`      // Идентификатор комментария.
+	Comment   *Comment  `json:"-" gorm:"constraint:OnDelete:CASCADE;"` // Комментарий.
+	EditorID  uint      `json:"editor_id" gorm:"not null"`             // Идентификатор пользователя, заменившего этот текст.
+	Text      string    `json:"text" gorm:"not null;type:text"`        // Текст комментария до редактирования.
+	CreatedAt time.Time `json:"created_at"`                            // Дата редактирования.
+}
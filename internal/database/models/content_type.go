@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/schema"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// ContentType представляет пользовательский тип контента с набором полей (например, событие или вакансия).
+type ContentType struct {
+	ID          uint          `json:"id" gorm:"primaryKey"`                 // Уникальный идентификатор типа контента.
+	Name        string        `json:"name" gorm:"not null;size:128"`        // Название типа контента.
+	Slug        string        `json:"slug" gorm:"unique;not null;size:128"` // Идентификатор типа в URL (/content/:type).
+	Description string        `json:"description" gorm:"type:text"`         // Описание типа контента.
+	Fields      schema.Fields `json:"fields" gorm:"type:jsonb;not null"`    // Определения полей.
+	CreatedAt   time.Time     `json:"created_at"`                           // Дата создания записи.
+	UpdatedAt   time.Time     `json:"updated_at"`                           // Дата последнего обновления записи.
+}
+
+// BeforeCreate вызывается перед сохранением новой записи.
+// Предназначена для санитизации строковых полей и защиты от XSS-атак.
+func (c *ContentType) BeforeCreate(tx *gorm.DB) (err error) {
+	c.sanitize()
+	return nil
+}
+
+// BeforeUpdate вызывается перед обновлением записи.
+// Используется для очистки данных от потенциально опасного HTML/JS.
+func (c *ContentType) BeforeUpdate(tx *gorm.DB) (err error) {
+	c.sanitize()
+	return nil
+}
+
+// sanitize очищает название, описание и подписи полей типа контента.
+func (c *ContentType) sanitize() {
+	c.Name = utils.Sanitize(c.Name)
+	c.Description = utils.Sanitize(c.Description)
+	for i := range c.Fields {
+		c.Fields[i].Label = utils.Sanitize(c.Fields[i].Label)
+		for j := range c.Fields[i].Options {
+			c.Fields[i].Options[j] = utils.Sanitize(c.Fields[i].Options[j])
+		}
+	}
+}
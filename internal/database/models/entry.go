@@ -0,0 +1,20 @@
+package models
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/schema"
+)
+
+// Entry представляет запись пользовательского типа контента.
+// Данные записи хранятся в JSON и проверяются по определению полей её типа.
+type Entry struct {
+	ID            uint          `json:"id" gorm:"primaryKey"`                                        // Уникальный идентификатор записи.
+	ContentTypeID uint          `json:"content_type_id" gorm:"not null;index"`                       // Идентификатор типа контента.
+	ContentType   *ContentType  `json:"content_type,omitempty" gorm:"constraint:OnDelete:RESTRICT;"` // Тип контента.
+	AuthorID      uint          `json:"author_id" gorm:"not null;index"`                             // Идентификатор автора записи.
+	Data          schema.Values `json:"data" gorm:"type:jsonb;not null"`                             // Значения полей записи.
+	Published     bool          `json:"published" gorm:"default:false;index"`                        // Опубликована ли запись.
+	CreatedAt     time.Time     `json:"created_at"`                                                  // Дата создания записи.
+	UpdatedAt     time.Time     `json:"updated_at"`                                                  // Дата последнего обновления записи.
+}
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// AuthorFollow представляет подписку пользователя на автора.
+type AuthorFollow struct {
+	ID         uint      `json:"id" gorm:"primaryKey"`                                                     // Уникальный идентификатор подписки.
+	FollowerID uint      `json:"follower_id" gorm:"not null;uniqueIndex:idx_author_follow"`                // Идентификатор подписчика.
+	Follower   *User     `json:"-" gorm:"foreignKey:FollowerID;constraint:OnDelete:CASCADE;"`              // Подписчик.
+	AuthorID   uint      `json:"author_id" gorm:"not null;uniqueIndex:idx_author_follow;index"`            // Идентификатор автора.
+	Author     *User     `json:"author,omitempty" gorm:"foreignKey:AuthorID;constraint:OnDelete:CASCADE;"` // Автор.
+	CreatedAt  time.Time `json:"created_at"`                                                               // Дата подписки.
+}
+
+// TagFollow представляет подписку пользователя на тег.
+type TagFollow struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`                                    // Уникальный идентификатор подписки.
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_tag_follow"`      // Идентификатор подписчика.
+	User      *User     `json:"-" gorm:"constraint:OnDelete:CASCADE;"`                   // Подписчик.
+	TagID     uint      `json:"tag_id" gorm:"not null;uniqueIndex:idx_tag_follow;index"` // Идентификатор тега.
+	Tag       *Tag      `json:"tag,omitempty" gorm:"constraint:OnDelete:CASCADE;"`       // Тег.
+	CreatedAt time.Time `json:"created_at"`                                              // Дата подписки.
+}
@@ -9,14 +9,15 @@ import (
 
 // Media представляет медиафайл, связанный с контентом.
 type Media struct {
-	ID        uint      `json:"id" gorm:"primaryKey"`                           // Уникальный идентификатор медиафайла.
-	ArticleID *uint     `json:"article_id,omitempty" gorm:"index,default:null"` // Идентификатор контента, к которому относится файл.
-	AuthorID  uint      `json:"author_id" gorm:"not null;index"`                // Идентификатор автора файла.
-	FilePath  string    `json:"file_path" gorm:"not null"`                      // Путь к файлу на сервере.
-	FileType  string    `json:"file_type" gorm:"not null;size:50"`              // Тип файла (например, "image/jpeg").
-	FileSize  int64     `json:"file_size" gorm:"not null"`                      // Размер файла в байтах.
-	CreatedAt time.Time `json:"created_at"`                                     // Дата загрузки файла.
-	UpdatedAt time.Time `json:"updated_at"`                                     // Дата последнего обновления записи.
+	ID        uint           `json:"id" gorm:"primaryKey"`                           // Уникальный идентификатор медиафайла.
+	ArticleID *uint          `json:"article_id,omitempty" gorm:"index,default:null"` // Идентификатор контента, к которому относится файл.
+	AuthorID  uint           `json:"author_id" gorm:"not null;index"`                // Идентификатор автора файла.
+	FilePath  string         `json:"file_path" gorm:"not null"`                      // Путь к файлу на сервере.
+	FileType  string         `json:"file_type" gorm:"not null;size:50"`              // Тип файла (например, "image/jpeg").
+	FileSize  int64          `json:"file_size" gorm:"not null"`                      // Размер файла в байтах.
+	CreatedAt time.Time      `json:"created_at"`                                     // Дата загрузки файла.
+	UpdatedAt time.Time      `json:"updated_at"`                                     // Дата последнего обновления записи.
+	DeletedAt gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index"`              // Дата мягкого удаления (файл в корзине).
 }
 
 // BeforeCreate вызывается перед сохранением новой записи.
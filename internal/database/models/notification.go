@@ -0,0 +1,38 @@
+package models
+
+import "time"
+
+// Типы уведомлений.
+const (
+	NotificationTypeMention        = "mention"         // Пользователя упомянули в комментарии.
+	NotificationTypeReply          = "reply"           // Ответ на комментарий пользователя.
+	NotificationTypeArticleComment = "article_comment" // Комментарий к статье пользователя.
+)
+
+// NotificationTypes перечисляет все типы уведомлений.
+var NotificationTypes = []string{NotificationTypeMention, NotificationTypeReply, NotificationTypeArticleComment}
+
+// Notification представляет уведомление пользователя внутри приложения.
+type Notification struct {
+	ID        uint       `json:"id" gorm:"primaryKey"`                                // Уникальный идентификатор уведомления.
+	UserID    uint       `json:"user_id" gorm:"not null;index:idx_notification_user"` // Идентификатор получателя.
+	User      *User      `json:"-" gorm:"constraint:OnDelete:CASCADE;"`               // Получатель.
+	ActorID   uint       `json:"actor_id" gorm:"not null"`                            // Идентификатор пользователя, вызвавшего уведомление.
+	Actor     *User      `json:"actor,omitempty" gorm:"constraint:OnDelete:CASCADE;"` // Пользователь, вызвавший уведомление.
+	Type      string     `json:"type" gorm:"not null;size:32"`                        // Тип уведомления.
+	ArticleID uint       `json:"article_id" gorm:"not null;index"`                    // Идентификатор статьи.
+	Article   *Article   `json:"-" gorm:"constraint:OnDelete:CASCADE;"`               // Статья.
+	CommentID *uint      `json:"comment_id" gorm:"index"`                             // Идентификатор комментария.
+	Comment   *Comment   `json:"-" gorm:"constraint:OnDelete:CASCADE;"`               // Комментарий.
+	ReadAt    *time.Time `json:"read_at" gorm:"index:idx_notification_user"`          // Дата прочтения (nil — не прочитано).
+	CreatedAt time.Time  `json:"created_at"`                                          // Дата создания уведомления.
+}
+
+// NotificationPreference представляет настройку пользователя для одного типа уведомлений.
+// Отсутствие настройки означает, что уведомления этого типа включены.
+type NotificationPreference struct {
+	UserID  uint   `json:"user_id" gorm:"primaryKey"`             // Идентификатор пользователя.
+	User    *User  `json:"-" gorm:"constraint:OnDelete:CASCADE;"` // Пользователь.
+	Type    string `json:"type" gorm:"primaryKey;size:32"`        // Тип уведомлений.
+	Enabled bool   `json:"enabled" gorm:"not null"`               // Включены ли уведомления этого типа.
+}
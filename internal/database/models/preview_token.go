@@ -0,0 +1,37 @@
+package models
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// PreviewToken представляет выданную внешнему рецензенту ссылку предпросмотра черновика статьи.
+// Сам подписанный токен не хранится: запись ищется по идентификатору из его claims.
+type PreviewToken struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`                  // Уникальный идентификатор токена.
+	ArticleID    uint       `json:"article_id" gorm:"not null;index"`      // Идентификатор статьи.
+	Article      *Article   `json:"-" gorm:"constraint:OnDelete:CASCADE;"` // Статья, к которой выдан доступ.
+	CreatedByID  uint       `json:"created_by_id" gorm:"not null;index"`   // Идентификатор пользователя, выдавшего токен.
+	Note         string     `json:"note" gorm:"size:255"`                  // Пометка для кого выдан токен.
+	ExpiresAt    time.Time  `json:"expires_at" gorm:"not null;index"`      // Время истечения токена.
+	RevokedAt    *time.Time `json:"revoked_at"`                            // Время отзыва токена.
+	TrackViews   bool       `json:"track_views" gorm:"default:false"`      // Записывать ли просмотры.
+	ViewCount    int        `json:"view_count" gorm:"not null;default:0"`  // Количество просмотров.
+	LastViewedAt *time.Time `json:"last_viewed_at"`                        // Время последнего просмотра.
+	LastViewIP   string     `json:"last_view_ip" gorm:"size:45"`           // IP-адрес последнего просмотра.
+	CreatedAt    time.Time  `json:"created_at"`                            // Дата создания токена.
+}
+
+// Active сообщает, можно ли использовать токен в момент now.
+func (t *PreviewToken) Active(now time.Time) bool {
+	return t.RevokedAt == nil && now.Before(t.ExpiresAt)
+}
+
+// BeforeCreate вызывается перед сохранением новой записи.
+// Санитизирует пометку токена.
+func (t *PreviewToken) BeforeCreate(tx *gorm.DB) (err error) {
+	t.Note = utils.Sanitize(t.Note)
+	return nil
+}
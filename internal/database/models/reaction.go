@@ -0,0 +1,64 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Reaction представляет реакцию пользователя (например, "like") на статью или комментарий.
+// Задан ровно один из ArticleID и CommentID; пользователь может оставить не более одной реакции
+// каждого типа на один объект.
+type Reaction struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`                                                                           // Уникальный идентификатор реакции.
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_reaction_article;uniqueIndex:idx_reaction_comment"`      // Идентификатор пользователя.
+	ArticleID *uint     `json:"article_id,omitempty" gorm:"uniqueIndex:idx_reaction_article;index"`                             // Идентификатор статьи.
+	Article   *Article  `json:"-" gorm:"constraint:OnDelete:CASCADE;"`                                                          // Статья.
+	CommentID *uint     `json:"comment_id,omitempty" gorm:"uniqueIndex:idx_reaction_comment;index"`                             // Идентификатор комментария.
+	Comment   *Comment  `json:"-" gorm:"constraint:OnDelete:CASCADE;"`                                                          // Комментарий.
+	Type      string    `json:"type" gorm:"not null;size:32;uniqueIndex:idx_reaction_article;uniqueIndex:idx_reaction_comment"` // Тип реакции.
+	CreatedAt time.Time `json:"created_at"`                                                                                     // Дата создания реакции.
+}
+
+// ReactionCounts — количество реакций каждого типа, хранящееся вместе со статьёй или комментарием.
+type ReactionCounts map[string]int
+
+// Value сериализует счётчики в JSON для записи в БД.
+func (c ReactionCounts) Value() (driver.Value, error) {
+	if c == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan десериализует счётчики из JSON, прочитанного из БД.
+func (c *ReactionCounts) Scan(value interface{}) error {
+	if value == nil {
+		*c = nil
+		return nil
+	}
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported type for reaction counts: %T", value)
+	}
+	return json.Unmarshal(data, c)
+}
+
+// Total возвращает общее количество реакций всех типов.
+func (c ReactionCounts) Total() int {
+	total := 0
+	for _, count := range c {
+		total += count
+	}
+	return total
+}
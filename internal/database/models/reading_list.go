@@ -0,0 +1,57 @@
+package models
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// Bookmark представляет статью, сохранённую пользователем в закладки.
+type Bookmark struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`                                             // Уникальный идентификатор закладки.
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_bookmark_user_article"`    // Идентификатор пользователя.
+	ArticleID uint      `json:"article_id" gorm:"not null;uniqueIndex:idx_bookmark_user_article"` // Идентификатор статьи.
+	Article   *Article  `json:"article,omitempty" gorm:"constraint:OnDelete:CASCADE;"`            // Статья.
+	CreatedAt time.Time `json:"created_at"`                                                       // Дата добавления в закладки.
+}
+
+// ReadingList представляет именованный список статей для чтения.
+// Публичный список доступен всем по ссылке с ShareID.
+type ReadingList struct {
+	ID          uint              `json:"id" gorm:"primaryKey"`                      // Уникальный идентификатор списка.
+	UserID      uint              `json:"user_id" gorm:"not null;index"`             // Идентификатор владельца списка.
+	Name        string            `json:"name" gorm:"not null;size:255"`             // Название списка.
+	Description string            `json:"description" gorm:"type:text"`              // Описание списка.
+	Public      bool              `json:"public" gorm:"not null;default:false"`      // Доступен ли список по публичной ссылке.
+	ShareID     string            `json:"share_id" gorm:"unique;not null;size:32"`   // Идентификатор списка в публичной ссылке.
+	Items       []ReadingListItem `json:"items" gorm:"constraint:OnDelete:CASCADE;"` // Статьи списка.
+	CreatedAt   time.Time         `json:"created_at"`                                // Дата создания записи.
+	UpdatedAt   time.Time         `json:"updated_at"`                                // Дата последнего обновления записи.
+}
+
+// ReadingListItem представляет статью в списке для чтения и её порядковый номер.
+type ReadingListItem struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`                                                  // Уникальный идентификатор записи.
+	ReadingListID uint      `json:"reading_list_id" gorm:"not null;uniqueIndex:idx_reading_list_article"`  // Идентификатор списка.
+	ArticleID     uint      `json:"article_id" gorm:"not null;uniqueIndex:idx_reading_list_article;index"` // Идентификатор статьи.
+	Article       *Article  `json:"article,omitempty" gorm:"constraint:OnDelete:CASCADE;"`                 // Статья.
+	Position      int       `json:"position" gorm:"not null"`                                              // Порядковый номер статьи в списке, начиная с 1.
+	CreatedAt     time.Time `json:"created_at"`                                                            // Дата добавления статьи в список.
+}
+
+// BeforeCreate вызывается перед сохранением новой записи.
+// Предназначена для санитизации строковых полей и защиты от XSS-атак.
+func (l *ReadingList) BeforeCreate(tx *gorm.DB) (err error) {
+	l.Name = utils.Sanitize(l.Name)
+	l.Description = utils.Sanitize(l.Description)
+	return nil
+}
+
+// BeforeUpdate вызывается перед обновлением записи.
+// Используется для очистки данных от потенциально опасного HTML/JS.
+func (l *ReadingList) BeforeUpdate(tx *gorm.DB) (err error) {
+	l.Name = utils.Sanitize(l.Name)
+	l.Description = utils.Sanitize(l.Description)
+	return nil
+}
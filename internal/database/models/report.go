@@ -0,0 +1,94 @@
+package models
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// Типы объектов, на которые можно пожаловаться.
+const (
+	ReportTargetArticle = "article"
+	ReportTargetComment = "comment"
+)
+
+// Статусы жалобы.
+const (
+	ReportStatusOpen      = "open"      // Ожидает рассмотрения модератором.
+	ReportStatusDismissed = "dismissed" // Отклонена модератором как необоснованная.
+	ReportStatusResolved  = "resolved"  // Рассмотрена, к контенту или автору применены меры.
+)
+
+// Решения модератора по жалобам.
+const (
+	ReportActionDismiss = "dismiss" // Отклонить жалобы и вернуть контент.
+	ReportActionHide    = "hide"    // Скрыть контент.
+	ReportActionWarn    = "warn"    // Скрыть контент и вынести автору предупреждение.
+	ReportActionBan     = "ban"     // Скрыть контент и заблокировать автора.
+)
+
+// Report представляет жалобу пользователя на статью или комментарий.
+// Задан ровно один из ArticleID и CommentID; у пользователя может быть не более одной
+// открытой жалобы на один объект.
+type Report struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`                                                                                                                        // Уникальный идентификатор жалобы.
+	ReporterID uint       `json:"reporter_id" gorm:"not null;index;uniqueIndex:idx_report_article,where:status = 'open';uniqueIndex:idx_report_comment,where:status = 'open'"` // Идентификатор пожаловавшегося пользователя.
+	Reporter   *User      `json:"-" gorm:"constraint:OnDelete:CASCADE;"`                                                                                                       // Пожаловавшийся пользователь.
+	ArticleID  *uint      `json:"article_id,omitempty" gorm:"uniqueIndex:idx_report_article;index"`                                                                            // Идентификатор статьи.
+	Article    *Article   `json:"-" gorm:"constraint:OnDelete:CASCADE;"`                                                                                                       // Статья.
+	CommentID  *uint      `json:"comment_id,omitempty" gorm:"uniqueIndex:idx_report_comment;index"`                                                                            // Идентификатор комментария.
+	Comment    *Comment   `json:"-" gorm:"constraint:OnDelete:CASCADE;"`                                                                                                       // Комментарий.
+	Reason     string     `json:"reason" gorm:"not null;size:32"`                                                                                                              // Категория жалобы.
+	Text       string     `json:"text" gorm:"size:1000"`                                                                                                                       // Пояснение пользователя.
+	Status     string     `json:"status" gorm:"not null;size:16;default:open;index"`                                                                                           // Статус жалобы.
+	Action     string     `json:"action" gorm:"size:16"`                                                                                                                       // Решение модератора.
+	ResolvedBy *uint      `json:"resolved_by"`                                                                                                                                 // Идентификатор модератора, рассмотревшего жалобу.
+	ResolvedAt *time.Time `json:"resolved_at"`                                                                                                                                 // Дата рассмотрения.
+	CreatedAt  time.Time  `json:"created_at"`                                                                                                                                  // Дата создания жалобы.
+}
+
+// BeforeCreate вызывается перед сохранением новой записи.
+// Санитизирует пояснение пользователя.
+func (r *Report) BeforeCreate(tx *gorm.DB) (err error) {
+	r.Text = utils.Sanitize(r.Text)
+	return nil
+}
+
+// TargetType возвращает тип объекта жалобы.
+func (r *Report) TargetType() string {
+	if r.CommentID != nil {
+		return ReportTargetComment
+	}
+	return ReportTargetArticle
+}
+
+// TargetID возвращает идентификатор объекта жалобы.
+func (r *Report) TargetID() uint {
+	if r.CommentID != nil {
+		return *r.CommentID
+	}
+	if r.ArticleID != nil {
+		return *r.ArticleID
+	}
+	return 0
+}
+
+// UserWarning представляет предупреждение, вынесенное пользователю модератором по жалобам.
+type UserWarning struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`                  // Уникальный идентификатор предупреждения.
+	UserID      uint      `json:"user_id" gorm:"not null;index"`         // Идентификатор пользователя.
+	User        *User     `json:"-" gorm:"constraint:OnDelete:CASCADE;"` // Пользователь.
+	ModeratorID uint      `json:"moderator_id" gorm:"not null"`          // Идентификатор модератора.
+	TargetType  string    `json:"target_type" gorm:"not null;size:16"`   // Тип объекта, за который вынесено предупреждение.
+	TargetID    uint      `json:"target_id" gorm:"not null"`             // Идентификатор объекта.
+	Note        string    `json:"note" gorm:"size:1000"`                 // Комментарий модератора.
+	CreatedAt   time.Time `json:"created_at"`                            // Дата предупреждения.
+}
+
+// BeforeCreate вызывается перед сохранением новой записи.
+// Санитизирует комментарий модератора.
+func (w *UserWarning) BeforeCreate(tx *gorm.DB) (err error) {
+	w.Note = utils.Sanitize(w.Note)
+	return nil
+}
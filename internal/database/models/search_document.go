@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// SearchDocument представляет запись поискового индекса PostgreSQL.
+type SearchDocument struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`                                                // Уникальный идентификатор записи.
+	DocType   string    `json:"doc_type" gorm:"not null;size:32;uniqueIndex:idx_search_doc_entity"`  // Тип документа ("article" или "comment").
+	EntityID  uint      `json:"entity_id" gorm:"not null;uniqueIndex:idx_search_doc_entity"`         // Идентификатор проиндексированной сущности.
+	ArticleID uint      `json:"article_id" gorm:"not null;index"`                                    // Идентификатор статьи, к которой относится документ.
+	Title     string    `json:"title" gorm:"size:255"`                                               // Заголовок документа.
+	Body      string    `json:"body" gorm:"type:text"`                                               // Текст документа.
+	Published bool      `json:"published" gorm:"default:false;index"`                                // Доступен ли документ в выдаче.
+	TSV       string    `json:"-" gorm:"column:tsv;type:tsvector;index:idx_search_doc_tsv,type:gin"` // Поисковый вектор.
+	UpdatedAt time.Time `json:"updated_at"`                                                          // Дата последнего обновления записи.
+}
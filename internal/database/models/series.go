@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// Series представляет серию связанных статей, например многочастное руководство.
+type Series struct {
+	ID          uint         `json:"id" gorm:"primaryKey"`                      // Уникальный идентификатор серии.
+	AuthorID    uint         `json:"author_id" gorm:"not null;index"`           // Идентификатор создателя серии.
+	Title       string       `json:"title" gorm:"not null;size:255"`            // Название серии.
+	Slug        string       `json:"slug" gorm:"unique;not null;size:128"`      // Человекочитаемый идентификатор для URL.
+	Description string       `json:"description" gorm:"type:text"`              // Описание серии.
+	Parts       []SeriesPart `json:"parts" gorm:"constraint:OnDelete:CASCADE;"` // Части серии.
+	CreatedAt   time.Time    `json:"created_at"`                                // Дата создания записи.
+	UpdatedAt   time.Time    `json:"updated_at"`                                // Дата последнего обновления записи.
+}
+
+// SeriesPart представляет статью в составе серии и её порядковый номер.
+// Статья может входить не более чем в одну серию.
+type SeriesPart struct {
+	ID        uint     `json:"id" gorm:"primaryKey"`                   // Уникальный идентификатор записи.
+	SeriesID  uint     `json:"series_id" gorm:"not null;index"`        // Идентификатор серии.
+	Series    *Series  `json:"series,omitempty"`                       // Серия.
+	ArticleID uint     `json:"article_id" gorm:"not null;uniqueIndex"` // Идентификатор статьи.
+	Article   *Article `json:"article,omitempty"`                      // Статья.
+	Position  int      `json:"position" gorm:"not null"`               // Порядковый номер части, начиная с 1.
+}
+
+// BeforeCreate вызывается перед сохранением новой записи.
+// Предназначена для санитизации строковых полей и защиты от XSS-атак.
+func (s *Series) BeforeCreate(tx *gorm.DB) (err error) {
+	s.Title = utils.Sanitize(s.Title)
+	s.Description = utils.Sanitize(s.Description)
+	return nil
+}
+
+// BeforeUpdate вызывается перед обновлением записи.
+// Используется для очистки данных от потенциально опасного HTML/JS.
+func (s *Series) BeforeUpdate(tx *gorm.DB) (err error) {
+	s.Title = utils.Sanitize(s.Title)
+	s.Description = utils.Sanitize(s.Description)
+	return nil
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// Метки обучающих примеров спам-фильтра.
+const (
+	SpamLabelSpam = "spam" // Модератор отметил комментарий как спам.
+	SpamLabelHam  = "ham"  // Модератор одобрил комментарий.
+)
+
+// SpamToken хранит, в скольких обучающих комментариях каждой метки встретился токен.
+type SpamToken struct {
+	Token     string `json:"token" gorm:"primaryKey;size:64"`      // Токен текста.
+	SpamCount int    `json:"spam_count" gorm:"not null;default:0"` // Количество спам-комментариев с токеном.
+	HamCount  int    `json:"ham_count" gorm:"not null;default:0"`  // Количество обычных комментариев с токеном.
+}
+
+// SpamDocument представляет комментарий, на котором обучен спам-фильтр.
+// Токены сохраняются, чтобы при смене решения модератора вычесть их из прежней метки,
+// даже если комментарий был отредактирован или удалён.
+type SpamDocument struct {
+	CommentID uint      `json:"comment_id" gorm:"primaryKey;autoIncrement:false"` // Идентификатор комментария.
+	Label     string    `json:"label" gorm:"not null;size:8;index"`               // Метка: spam или ham.
+	Tokens    string    `json:"tokens" gorm:"not null;type:text"`                 // Токены комментария через пробел.
+	UpdatedAt time.Time `json:"updated_at"`                                       // Дата последнего обучения.
+}
@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// Tag представляет метку (тег) для статей.
+type Tag struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`                                  // Уникальный идентификатор тега.
+	Name      string    `json:"name" gorm:"unique;not null;size:64"`                   // Название тега.
+	Slug      string    `json:"slug" gorm:"unique;not null;size:64"`                   // Человекочитаемый идентификатор для URL.
+	Articles  []Article `json:"-" gorm:"many2many:article_tags;" swaggerignore:"true"` // Статьи с этим тегом.
+	CreatedAt time.Time `json:"created_at"`                                            // Дата создания записи.
+	UpdatedAt time.Time `json:"updated_at"`                                            // Дата последнего обновления записи.
+}
+
+// BeforeCreate вызывается перед сохранением новой записи.
+// Предназначена для санитизации строковых полей и защиты от XSS-атак.
+func (t *Tag) BeforeCreate(tx *gorm.DB) (err error) {
+	t.Name = utils.Sanitize(t.Name)
+	return nil
+}
+
+// BeforeUpdate вызывается перед обновлением записи.
+// Используется для очистки данных от потенциально опасного HTML/JS.
+func (t *Tag) BeforeUpdate(tx *gorm.DB) (err error) {
+	t.Name = utils.Sanitize(t.Name)
+	return nil
+}
@@ -17,7 +17,8 @@ type User struct {
 	PasswordHash  string         `json:"-" gorm:"not null"`                       // Хэшированный пароль (скрыт из JSON).
 	CreatedAt     time.Time      `json:"created_at"`                              // Дата создания записи.
 	UpdatedAt     time.Time      `json:"updated_at"`                              // Дата последнего обновления записи.
-	DeletedAt     *time.Time     `json:"deleted_at,omitempty" gorm:"index"`       // Дата удаления записи (если применимо).
+	DeletedAt     gorm.DeletedAt `json:"deleted_at,omitempty" gorm:"index"`       // Дата мягкого удаления записи.
+	BannedAt      *time.Time     `json:"banned_at,omitempty"`                     // Дата блокировки пользователя модератором.
 	Articles      []Article      `json:"articles" gorm:"foreignKey:AuthorID"`     // Связь с контентом
 	RefreshTokens []RefreshToken `gorm:"foreignKey:UserID"`                       // Связь с токенами
 }
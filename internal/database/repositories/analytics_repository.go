@@ -0,0 +1,153 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ArticleViewTotal представляет статью с суммарным количеством просмотров за период.
+type ArticleViewTotal struct {
+	ArticleID uint
+	Title     string
+	Views     int64
+}
+
+// ReferrerTotal представляет источник перехода с суммарным количеством просмотров за период.
+type ReferrerTotal struct {
+	Referrer string
+	Views    int64
+}
+
+// AnalyticsRepository предоставляет методы для работы со статистикой просмотров в БД.
+type AnalyticsRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewAnalyticsRepository создаёт новый экземпляр AnalyticsRepository.
+func NewAnalyticsRepository(db *gorm.DB, logger logger.Logger) *AnalyticsRepository {
+	return &AnalyticsRepository{DB: db, Logger: logger}
+}
+
+// AddViews прибавляет просмотры к дневным агрегатам статей и источников перехода.
+// Агрегаты удалённых статей пропускаются.
+func (r *AnalyticsRepository) AddViews(views []models.ArticleDailyViews, referrers []models.ArticleDailyReferrer) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		views, referrers, err := r.existingArticles(tx, views, referrers)
+		if err != nil {
+			return err
+		}
+		if len(views) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "article_id"}, {Name: "day"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{"views": gorm.Expr("article_daily_views.views + excluded.views")}),
+			}).Create(&views).Error; err != nil {
+				return err
+			}
+		}
+		if len(referrers) > 0 {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "article_id"}, {Name: "day"}, {Name: "referrer"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{"views": gorm.Expr("article_daily_referrers.views + excluded.views")}),
+			}).Create(&referrers).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		r.Logger.WithField("rows", len(views)+len(referrers)).WithError(err).Error("Failed to save article views to database")
+		return err
+	}
+	return nil
+}
+
+// existingArticles отбрасывает агрегаты статей, которых уже нет в БД,
+// чтобы вставка пакета не нарушала внешний ключ.
+func (r *AnalyticsRepository) existingArticles(tx *gorm.DB, views []models.ArticleDailyViews, referrers []models.ArticleDailyReferrer) ([]models.ArticleDailyViews, []models.ArticleDailyReferrer, error) {
+	ids := make([]uint, 0, len(views)+len(referrers))
+	for _, v := range views {
+		ids = append(ids, v.ArticleID)
+	}
+	for _, ref := range referrers {
+		ids = append(ids, ref.ArticleID)
+	}
+	var existing []uint
+	if err := tx.Unscoped().Model(&models.Article{}).Where("id IN ?", ids).Pluck("id", &existing).Error; err != nil {
+		return nil, nil, err
+	}
+	found := make(map[uint]bool, len(existing))
+	for _, id := range existing {
+		found[id] = true
+	}
+	keptViews := views[:0]
+	for _, v := range views {
+		if found[v.ArticleID] {
+			keptViews = append(keptViews, v)
+		}
+	}
+	keptReferrers := referrers[:0]
+	for _, ref := range referrers {
+		if found[ref.ArticleID] {
+			keptReferrers = append(keptReferrers, ref)
+		}
+	}
+	return keptViews, keptReferrers, nil
+}
+
+// GetDailyViews возвращает дневные агрегаты просмотров статьи за период [from, to] по возрастанию даты.
+func (r *AnalyticsRepository) GetDailyViews(articleID uint, from, to time.Time) ([]*models.ArticleDailyViews, error) {
+	var views []*models.ArticleDailyViews
+	result := r.DB.Where("article_id = ? AND day BETWEEN ? AND ?", articleID, from, to).Order("day").Find(&views)
+	if result.Error != nil {
+		r.Logger.WithField("article_id", articleID).WithError(result.Error).Error("Failed to fetch daily article views from database")
+		return nil, result.Error
+	}
+	return views, nil
+}
+
+// GetTopArticles возвращает статьи с наибольшим количеством просмотров за период [from, to].
+// Если authorID не равен 0, учитываются только статьи этого автора.
+func (r *AnalyticsRepository) GetTopArticles(authorID uint, from, to time.Time, limit int) ([]*ArticleViewTotal, error) {
+	var totals []*ArticleViewTotal
+	query := r.DB.Model(&models.ArticleDailyViews{}).
+		Select("articles.id AS article_id, articles.title, SUM(article_daily_views.views) AS views").
+		Joins("JOIN articles ON articles.id = article_daily_views.article_id AND articles.deleted_at IS NULL").
+		Where("article_daily_views.day BETWEEN ? AND ?", from, to)
+	if authorID != 0 {
+		query = query.Where("articles.author_id = ?", authorID)
+	}
+	result := query.Group("articles.id").Order("views DESC").Order("articles.id").Limit(limit).Scan(&totals)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to fetch top articles from database")
+		return nil, result.Error
+	}
+	return totals, nil
+}
+
+// GetReferrers возвращает источники перехода по убыванию количества просмотров за период [from, to].
+// Если articleID не равен 0, учитываются просмотры только этой статьи;
+// если authorID не равен 0 — только статей этого автора.
+func (r *AnalyticsRepository) GetReferrers(articleID, authorID uint, from, to time.Time, limit int) ([]*ReferrerTotal, error) {
+	var totals []*ReferrerTotal
+	query := r.DB.Model(&models.ArticleDailyReferrer{}).
+		Select("article_daily_referrers.referrer, SUM(article_daily_referrers.views) AS views").
+		Joins("JOIN articles ON articles.id = article_daily_referrers.article_id AND articles.deleted_at IS NULL").
+		Where("article_daily_referrers.day BETWEEN ? AND ?", from, to)
+	if articleID != 0 {
+		query = query.Where("article_daily_referrers.article_id = ?", articleID)
+	}
+	if authorID != 0 {
+		query = query.Where("articles.author_id = ?", authorID)
+	}
+	result := query.Group("article_daily_referrers.referrer").Order("views DESC").Order("article_daily_referrers.referrer").Limit(limit).Scan(&totals)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to fetch referrers from database")
+		return nil, result.Error
+	}
+	return totals, nil
+}
@@ -1,11 +1,57 @@
 package repositories
 
 import (
+	"time"
+
 	"github.com/AsterOzlob/content_managment_api/internal/database/models"
 	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
 	"gorm.io/gorm"
 )
 
+// ArticleFilter описывает условия отбора статей.
+type ArticleFilter struct {
+	TagSlug       string         // Slug тега, которым должна быть отмечена статья.
+	CategoryIDs   []uint         // Рубрики, к одной из которых должна относиться статья.
+	AuthorID      uint           // Автор статьи (0 — любой).
+	PublishedOnly bool           // Только опубликованные статьи.
+	DraftsOf      uint           // Автор, черновики которого включаются в выборку при PublishedOnly (0 — ничьи).
+	Limit         int            // Максимальное количество статей (0 — без ограничения).
+	IDs           []uint         // Идентификаторы статей, среди которых ведётся отбор (nil — любые).
+	FollowedBy    uint           // Подписчик: только статьи авторов и тегов, на которые он подписан (0 — без ограничения).
+	Before        *ArticleCursor // Только статьи, следующие за курсором в порядке ArticleSortPublished (nil — с начала).
+
+	MinWords       int    // Минимальное количество слов (0 — без ограничения).
+	MaxWords       int    // Максимальное количество слов (0 — без ограничения).
+	MinReadingTime int    // Минимальное время чтения в минутах (0 — без ограничения).
+	MaxReadingTime int    // Максимальное время чтения в минутах (0 — без ограничения).
+	Sort           string // Порядок сортировки: ArticleSortNewest (по умолчанию), ArticleSortOldest, ArticleSortShortest, ArticleSortLongest, ArticleSortPublished.
+}
+
+// ArticleCursor указывает позицию статьи в списке, упорядоченном от недавно опубликованных к давним.
+type ArticleCursor struct {
+	PublishedAt time.Time // Дата публикации последней полученной статьи.
+	ID          uint      // Идентификатор последней полученной статьи.
+}
+
+// Порядки сортировки списка статей.
+const (
+	ArticleSortNewest   = "newest"
+	ArticleSortOldest   = "oldest"
+	ArticleSortShortest = "shortest"
+	ArticleSortLongest  = "longest"
+	// ArticleSortPublished упорядочивает статьи по дате первой публикации, начиная с новых.
+	ArticleSortPublished = "published"
+)
+
+// preloadSeries подгружает серию статьи с кратким списком её частей для навигации.
+func preloadSeries(db *gorm.DB) *gorm.DB {
+	return db.Preload("SeriesPart.Series.Parts", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position")
+	}).Preload("SeriesPart.Series.Parts.Article", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id", "title", "published", "hidden")
+	})
+}
+
 // ArticleRepository предоставляет методы для работы со статьями в БД.
 type ArticleRepository struct {
 	DB     *gorm.DB      // DB - экземпляр подключения к базе данных через GORM.
@@ -30,10 +76,68 @@ func (r *ArticleRepository) Create(article *models.Article) error {
 	return nil
 }
 
-// GetAll возвращает список всех статей.
-func (r *ArticleRepository) GetAll() ([]*models.Article, error) {
+// GetAll возвращает список статей, удовлетворяющих фильтру, начиная с новых.
+func (r *ArticleRepository) GetAll(filter ArticleFilter) ([]*models.Article, error) {
 	var articles []*models.Article
-	result := r.DB.Preload("Media").Preload("Comments").Find(&articles)
+	query := r.DB.Preload("Media").Preload("Comments", "status = ? AND hidden = ?", models.CommentStatusApproved, false).Preload("Tags").Preload("Category").Preload("Translations").Preload("OGImage").Scopes(preloadSeries)
+	if filter.TagSlug != "" {
+		query = query.Where("articles.id IN (?)", r.DB.Table("article_tags").
+			Select("article_tags.article_id").
+			Joins("JOIN tags ON tags.id = article_tags.tag_id").
+			Where("tags.slug = ?", filter.TagSlug))
+	}
+	if filter.IDs != nil {
+		query = query.Where("articles.id IN ?", filter.IDs)
+	}
+	if filter.CategoryIDs != nil {
+		query = query.Where("articles.category_id IN ?", filter.CategoryIDs)
+	}
+	if filter.FollowedBy != 0 {
+		// Лента строится при чтении: подписки не копируются в ленты подписчиков при публикации
+		query = query.Where("articles.author_id IN (?) OR articles.id IN (?)",
+			r.DB.Model(&models.AuthorFollow{}).Select("author_id").Where("follower_id = ?", filter.FollowedBy),
+			r.DB.Table("article_tags").
+				Select("article_tags.article_id").
+				Joins("JOIN tag_follows ON tag_follows.tag_id = article_tags.tag_id").
+				Where("tag_follows.user_id = ?", filter.FollowedBy))
+	}
+	if filter.Before != nil {
+		query = query.Where("(articles.published_at, articles.id) < (?, ?)", filter.Before.PublishedAt, filter.Before.ID)
+	}
+	if filter.AuthorID != 0 {
+		query = query.Where("articles.author_id = ?", filter.AuthorID)
+	}
+	if filter.PublishedOnly && filter.DraftsOf != 0 {
+		query = query.Where("(articles.published = ? AND articles.hidden = ?) OR articles.author_id = ?", true, false, filter.DraftsOf)
+	} else if filter.PublishedOnly {
+		query = query.Where("articles.published = ? AND articles.hidden = ?", true, false)
+	}
+	if filter.MinWords > 0 {
+		query = query.Where("articles.word_count >= ?", filter.MinWords)
+	}
+	if filter.MaxWords > 0 {
+		query = query.Where("articles.word_count <= ?", filter.MaxWords)
+	}
+	if filter.MinReadingTime > 0 {
+		query = query.Where("articles.reading_time >= ?", filter.MinReadingTime)
+	}
+	if filter.MaxReadingTime > 0 {
+		query = query.Where("articles.reading_time <= ?", filter.MaxReadingTime)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	switch filter.Sort {
+	case ArticleSortOldest:
+		query = query.Order("articles.created_at ASC").Order("articles.id ASC")
+	case ArticleSortShortest:
+		query = query.Order("articles.word_count ASC")
+	case ArticleSortLongest:
+		query = query.Order("articles.word_count DESC")
+	case ArticleSortPublished:
+		query = query.Order("articles.published_at DESC").Order("articles.id DESC")
+	}
+	result := query.Order("articles.created_at DESC").Order("articles.id DESC").Find(&articles)
 	if result.Error != nil {
 		r.Logger.WithError(result.Error).Error("Failed to fetch all articles from database")
 		return nil, result.Error
@@ -41,10 +145,26 @@ func (r *ArticleRepository) GetAll() ([]*models.Article, error) {
 	return articles, nil
 }
 
+// GetPublishedForSitemap возвращает все опубликованные, не скрытые и разрешённые к индексации статьи
+// с медиафайлами, тегами и рубрикой, без текста и комментариев.
+func (r *ArticleRepository) GetPublishedForSitemap() ([]*models.Article, error) {
+	var articles []*models.Article
+	result := r.DB.Select("id", "author_id", "category_id", "created_at", "updated_at").
+		Where("published = ? AND hidden = ? AND no_index = ?", true, false, false).
+		Preload("Media").Preload("Tags").Preload("Category").
+		Order("id").
+		Find(&articles)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to fetch published articles for sitemap from database")
+		return nil, result.Error
+	}
+	return articles, nil
+}
+
 // GetByID возвращает статью по ID.
 func (r *ArticleRepository) GetByID(id uint) (*models.Article, error) {
 	var article models.Article
-	result := r.DB.Preload("Media").Preload("Comments").First(&article, id)
+	result := r.DB.Preload("Media").Preload("Comments", "status = ? AND hidden = ?", models.CommentStatusApproved, false).Preload("Tags").Preload("Category").Preload("Translations").Preload("OGImage").Scopes(preloadSeries).First(&article, id)
 	if result.Error != nil {
 		r.Logger.WithField("article_id", id).WithError(result.Error).Error("Failed to fetch article by ID from database")
 		return nil, result.Error
@@ -52,20 +172,35 @@ func (r *ArticleRepository) GetByID(id uint) (*models.Article, error) {
 	return &article, nil
 }
 
-// Update обновляет статью в БД.
+// Update обновляет статью в БД и заменяет набор её тегов.
 func (r *ArticleRepository) Update(article *models.Article) error {
-	result := r.DB.Save(article)
-	if result.Error != nil {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Tags", "Category", "Comments", "Media", "Translations", "OGImage", "SeriesPart", "ReactionCounts", "Hidden").Save(article).Error; err != nil {
+			return err
+		}
+		return tx.Model(article).Association("Tags").Replace(article.Tags)
+	})
+	if err != nil {
 		r.Logger.WithFields(map[string]interface{}{
 			"article_id": article.ID,
 			"title":      article.Title,
-		}).WithError(result.Error).Error("Failed to update article in database")
+		}).WithError(err).Error("Failed to update article in database")
+		return err
+	}
+	return nil
+}
+
+// SetHidden скрывает статью или возвращает её в выдачу.
+func (r *ArticleRepository) SetHidden(id uint, hidden bool) error {
+	result := r.DB.Model(&models.Article{}).Where("id = ?", id).UpdateColumn("hidden", hidden)
+	if result.Error != nil {
+		r.Logger.WithField("article_id", id).WithError(result.Error).Error("Failed to update article visibility in database")
 		return result.Error
 	}
 	return nil
 }
 
-// Delete удаляет статью из БД.
+// Delete мягко удаляет статью: она перемещается в корзину до окончательной очистки.
 func (r *ArticleRepository) Delete(id uint) error {
 	result := r.DB.Delete(&models.Article{}, id)
 	if result.Error != nil {
@@ -74,3 +209,75 @@ func (r *ArticleRepository) Delete(id uint) error {
 	}
 	return nil
 }
+
+// GetDeleted возвращает статьи из корзины, начиная с недавно удалённых.
+// Если authorID не равен 0, возвращаются только статьи этого автора.
+func (r *ArticleRepository) GetDeleted(authorID uint) ([]*models.Article, error) {
+	var articles []*models.Article
+	query := r.DB.Unscoped().Where("deleted_at IS NOT NULL")
+	if authorID != 0 {
+		query = query.Where("author_id = ?", authorID)
+	}
+	result := query.Order("deleted_at DESC").Find(&articles)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to fetch deleted articles from database")
+		return nil, result.Error
+	}
+	return articles, nil
+}
+
+// GetDeletedByID возвращает статью из корзины по ID.
+func (r *ArticleRepository) GetDeletedByID(id uint) (*models.Article, error) {
+	var article models.Article
+	result := r.DB.Unscoped().Where("deleted_at IS NOT NULL").First(&article, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &article, nil
+}
+
+// Restore возвращает статью из корзины.
+func (r *ArticleRepository) Restore(id uint) error {
+	result := r.DB.Unscoped().Model(&models.Article{}).Where("id = ?", id).Update("deleted_at", nil)
+	if result.Error != nil {
+		r.Logger.WithField("article_id", id).WithError(result.Error).Error("Failed to restore article in database")
+		return result.Error
+	}
+	return nil
+}
+
+// PurgeDeletedBefore окончательно удаляет статьи, находящиеся в корзине с момента до cutoff,
+// вместе с их комментариями, медиафайлами и привязками к тегам.
+// Возвращает количество удалённых статей и пути файлов удалённых медиафайлов.
+func (r *ArticleRepository) PurgeDeletedBefore(cutoff time.Time) (int, []string, error) {
+	var ids []uint
+	var paths []string
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Model(&models.Article{}).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+			Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err := tx.Unscoped().Model(&models.Media{}).Where("article_id IN ?", ids).Pluck("file_path", &paths).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("article_id IN ?", ids).Delete(&models.Media{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("article_id IN ?", ids).Delete(&models.Comment{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Exec("DELETE FROM article_tags WHERE article_id IN ?", ids).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&models.Article{}, ids).Error
+	})
+	if err != nil {
+		r.Logger.WithError(err).Error("Failed to purge deleted articles from database")
+		return 0, nil, err
+	}
+	return len(ids), paths, nil
+}
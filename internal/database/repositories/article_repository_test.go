@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+)
+
+func TestArticlePurgeDeletedBefore(t *testing.T) {
+	db, log := openTestDB(t)
+	repo := NewArticleRepository(db, log)
+	expired := createTestArticle(t, db)
+	recent := createTestArticle(t, db)
+	cutoff := time.Now().Add(-24 * time.Hour)
+
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	tag := &models.Tag{Name: "purge-" + suffix, Slug: "purge-" + suffix}
+	if err := db.Create(tag).Error; err != nil {
+		t.Fatalf("failed to create tag: %v", err)
+	}
+	t.Cleanup(func() { db.Delete(tag) })
+	if err := db.Model(expired).Association("Tags").Append(tag); err != nil {
+		t.Fatalf("failed to tag article: %v", err)
+	}
+	media := &models.Media{ArticleID: &expired.ID, AuthorID: expired.AuthorID, FilePath: "uploads/purge-" + suffix + ".png", FileType: "image/png", FileSize: 1}
+	if err := db.Create(media).Error; err != nil {
+		t.Fatalf("failed to create media: %v", err)
+	}
+	comment := &models.Comment{ArticleID: expired.ID, AuthorID: expired.AuthorID, Text: "comment"}
+	if err := db.Create(comment).Error; err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+	moveToTrash(t, db, &models.Article{}, expired.ID, cutoff.Add(-time.Hour))
+	moveToTrash(t, db, &models.Article{}, recent.ID, cutoff.Add(time.Hour))
+
+	purged, paths, err := repo.PurgeDeletedBefore(cutoff)
+	if err != nil {
+		t.Fatalf("failed to purge articles: %v", err)
+	}
+	if purged < 1 || !slices.Contains(paths, media.FilePath) {
+		t.Errorf("PurgeDeletedBefore() = %d, %v; want the expired article and its media file", purged, paths)
+	}
+	for model, query := range map[interface{}]string{
+		&models.Article{}: "id = ?",
+		&models.Media{}:   "article_id = ?",
+		&models.Comment{}: "article_id = ?",
+	} {
+		var count int64
+		db.Unscoped().Model(model).Where(query, expired.ID).Count(&count)
+		if count != 0 {
+			t.Errorf("%T rows of the purged article remained", model)
+		}
+	}
+	var links int64
+	db.Table("article_tags").Where("article_id = ?", expired.ID).Count(&links)
+	if links != 0 {
+		t.Error("tag links of the purged article remained")
+	}
+
+	if _, err := repo.GetDeletedByID(recent.ID); err != nil {
+		t.Fatalf("article deleted after the cutoff left the trash: %v", err)
+	}
+	if err := repo.Restore(recent.ID); err != nil {
+		t.Fatalf("failed to restore article: %v", err)
+	}
+	if _, err := repo.GetByID(recent.ID); err != nil {
+		t.Errorf("restored article is not visible: %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+)
+
+// ArticleTranslationRepository предоставляет методы для работы с переводами статей в БД.
+type ArticleTranslationRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewArticleTranslationRepository создаёт новый экземпляр ArticleTranslationRepository.
+func NewArticleTranslationRepository(db *gorm.DB, logger logger.Logger) *ArticleTranslationRepository {
+	return &ArticleTranslationRepository{DB: db, Logger: logger}
+}
+
+// Save создаёт новый перевод или обновляет существующий.
+func (r *ArticleTranslationRepository) Save(translation *models.ArticleTranslation) error {
+	result := r.DB.Save(translation)
+	if result.Error != nil {
+		r.Logger.WithFields(map[string]interface{}{
+			"article_id": translation.ArticleID,
+			"locale":     translation.Locale,
+		}).WithError(result.Error).Error("Failed to save article translation in database")
+		return result.Error
+	}
+	return nil
+}
+
+// GetByArticleID возвращает все переводы статьи.
+func (r *ArticleTranslationRepository) GetByArticleID(articleID uint) ([]*models.ArticleTranslation, error) {
+	var translations []*models.ArticleTranslation
+	result := r.DB.Where("article_id = ?", articleID).Order("locale").Find(&translations)
+	if result.Error != nil {
+		r.Logger.WithField("article_id", articleID).WithError(result.Error).Error("Failed to fetch article translations from database")
+		return nil, result.Error
+	}
+	return translations, nil
+}
+
+// GetByArticleAndLocale возвращает перевод статьи на указанную локаль.
+func (r *ArticleTranslationRepository) GetByArticleAndLocale(articleID uint, locale string) (*models.ArticleTranslation, error) {
+	var translation models.ArticleTranslation
+	result := r.DB.Where("article_id = ? AND locale = ?", articleID, locale).First(&translation)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &translation, nil
+}
+
+// GetArticlesMissingLocale возвращает статьи, у которых нет перевода на указанную локаль
+// и исходный текст написан на другой локали.
+func (r *ArticleTranslationRepository) GetArticlesMissingLocale(locale string) ([]*models.Article, error) {
+	var articles []*models.Article
+	result := r.DB.Where("locale <> ?", locale).
+		Where("NOT EXISTS (?)", r.DB.Model(&models.ArticleTranslation{}).
+			Select("1").
+			Where("article_translations.article_id = articles.id AND article_translations.locale = ?", locale)).
+		Order("id").
+		Find(&articles)
+	if result.Error != nil {
+		r.Logger.WithField("locale", locale).WithError(result.Error).Error("Failed to fetch articles missing translation from database")
+		return nil, result.Error
+	}
+	return articles, nil
+}
+
+// Delete удаляет перевод по ID.
+func (r *ArticleTranslationRepository) Delete(id uint) error {
+	result := r.DB.Delete(&models.ArticleTranslation{}, id)
+	if result.Error != nil {
+		r.Logger.WithField("translation_id", id).WithError(result.Error).Error("Failed to delete article translation from database")
+		return result.Error
+	}
+	return nil
+}
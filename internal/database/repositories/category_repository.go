@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+)
+
+// CategoryRepository предоставляет методы для работы с рубриками в базе данных.
+type CategoryRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewCategoryRepository создаёт новый экземпляр CategoryRepository.
+func NewCategoryRepository(db *gorm.DB, logger logger.Logger) *CategoryRepository {
+	return &CategoryRepository{DB: db, Logger: logger}
+}
+
+// Create создаёт новую рубрику в базе данных.
+func (r *CategoryRepository) Create(category *models.Category) error {
+	result := r.DB.Create(category)
+	if result.Error != nil {
+		r.Logger.WithField("name", category.Name).WithError(result.Error).Error("Failed to create category in database")
+		return result.Error
+	}
+	return nil
+}
+
+// GetAll возвращает плоский список всех рубрик.
+func (r *CategoryRepository) GetAll() ([]*models.Category, error) {
+	var categories []*models.Category
+	result := r.DB.Order("name").Find(&categories)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to fetch all categories from database")
+		return nil, result.Error
+	}
+	return categories, nil
+}
+
+// GetByID возвращает рубрику по её ID.
+func (r *CategoryRepository) GetByID(id uint) (*models.Category, error) {
+	var category models.Category
+	result := r.DB.First(&category, id)
+	if result.Error != nil {
+		r.Logger.WithField("category_id", id).WithError(result.Error).Error("Failed to fetch category by ID from database")
+		return nil, result.Error
+	}
+	return &category, nil
+}
+
+// GetBySlug возвращает рубрику по её slug.
+func (r *CategoryRepository) GetBySlug(slug string) (*models.Category, error) {
+	var category models.Category
+	result := r.DB.Where("slug = ?", slug).First(&category)
+	if result.Error != nil {
+		r.Logger.WithField("slug", slug).WithError(result.Error).Warn("Failed to fetch category by slug from database")
+		return nil, result.Error
+	}
+	return &category, nil
+}
+
+// GetDescendantIDs возвращает идентификаторы рубрики и всех её потомков.
+func (r *CategoryRepository) GetDescendantIDs(id uint) ([]uint, error) {
+	var ids []uint
+	result := r.DB.Raw(`
+		WITH RECURSIVE tree AS (
+			SELECT id FROM categories WHERE id = ?
+			UNION ALL
+			SELECT c.id FROM categories c JOIN tree t ON c.parent_id = t.id
+		)
+		SELECT id FROM tree`, id).Scan(&ids)
+	if result.Error != nil {
+		r.Logger.WithField("category_id", id).WithError(result.Error).Error("Failed to fetch category descendants from database")
+		return nil, result.Error
+	}
+	return ids, nil
+}
+
+// CountChildren возвращает количество прямых потомков рубрики.
+func (r *CategoryRepository) CountChildren(id uint) (int64, error) {
+	var count int64
+	result := r.DB.Model(&models.Category{}).Where("parent_id = ?", id).Count(&count)
+	if result.Error != nil {
+		r.Logger.WithField("category_id", id).WithError(result.Error).Error("Failed to count category children in database")
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+// Update обновляет рубрику в базе данных.
+func (r *CategoryRepository) Update(category *models.Category) error {
+	result := r.DB.Omit("Children").Save(category)
+	if result.Error != nil {
+		r.Logger.WithField("category_id", category.ID).WithError(result.Error).Error("Failed to update category in database")
+		return result.Error
+	}
+	return nil
+}
+
+// Delete удаляет рубрику по ID.
+func (r *CategoryRepository) Delete(id uint) error {
+	result := r.DB.Delete(&models.Category{}, id)
+	if result.Error != nil {
+		r.Logger.WithField("category_id", id).WithError(result.Error).Error("Failed to delete category from database")
+		return result.Error
+	}
+	return nil
+}
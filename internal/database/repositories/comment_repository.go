@@ -1,11 +1,60 @@
 package repositories
 
 import (
+	"cmp"
+	"slices"
+	"time"
+
 	"github.com/AsterOzlob/content_managment_api/internal/database/models"
 	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
 	"gorm.io/gorm"
 )
 
+// CommentVisibility описывает, какие комментарии видны читателю помимо одобренных и не скрытых.
+type CommentVisibility struct {
+	All      bool // Видны комментарии в любом статусе (модераторы и администраторы).
+	AuthorID uint // Автор, собственные комментарии которого видны в любом статусе (0 — ничьи).
+}
+
+// scope ограничивает выборку комментариями, видимыми читателю.
+func (v CommentVisibility) scope(db *gorm.DB) *gorm.DB {
+	if v.All {
+		return db
+	}
+	condition, args := v.condition("")
+	return db.Where(condition, args...)
+}
+
+// condition возвращает SQL-условие видимости комментария для столбцов с префиксом prefix.
+func (v CommentVisibility) condition(prefix string) (string, []interface{}) {
+	if v.All {
+		return "TRUE", nil
+	}
+	if v.AuthorID != 0 {
+		return "(" + prefix + "status = ? AND " + prefix + "hidden = ?) OR " + prefix + "author_id = ?",
+			[]interface{}{models.CommentStatusApproved, false, v.AuthorID}
+	}
+	return prefix + "status = ? AND " + prefix + "hidden = ?", []interface{}{models.CommentStatusApproved, false}
+}
+
+// Порядки сортировки комментариев.
+const (
+	CommentSortNewest = "newest"
+	CommentSortOldest = "oldest"
+	CommentSortTop    = "top"
+)
+
+// CommentThreadQuery описывает выборку страницы веток комментариев.
+type CommentThreadQuery struct {
+	ArticleID  uint              // Статья, к которой относятся комментарии.
+	ParentID   *uint             // Комментарий, ответы на который составляют страницу (nil — комментарии верхнего уровня).
+	Visibility CommentVisibility // Видимость комментариев для читателя.
+	Sort       string            // Порядок сортировки на каждом уровне: CommentSortNewest (по умолчанию), CommentSortOldest, CommentSortTop.
+	Depth      int               // Количество уровней ответов, загружаемых под каждым комментарием страницы.
+	Limit      int               // Количество комментариев на странице.
+	Offset     int               // Смещение страницы.
+}
+
 // CommentRepository предоставляет методы для работы с комментариями в базе данных.
 type CommentRepository struct {
 	DB     *gorm.DB
@@ -17,7 +66,7 @@ func NewCommentRepository(db *gorm.DB, logger logger.Logger) *CommentRepository
 	return &CommentRepository{DB: db, Logger: logger}
 }
 
-// Create создает новый комментарий в базе данных.
+// Create создает новый комментарий в базе данных вместе с упоминаниями пользователей.
 func (r *CommentRepository) Create(comment *models.Comment) error {
 	result := r.DB.Create(comment)
 	if result.Error != nil {
@@ -29,22 +78,218 @@ func (r *CommentRepository) Create(comment *models.Comment) error {
 	return nil
 }
 
-// GetByArticleID возвращает все комментарии к статье, включая вложенные.
-func (r *CommentRepository) GetByArticleID(articleID uint) ([]*models.Comment, error) {
+// GetAll возвращает список всех одобренных, не скрытых и не удалённых с сохранением ветки комментариев,
+// кроме комментариев к статьям в корзине.
+func (r *CommentRepository) GetAll() ([]*models.Comment, error) {
 	var comments []*models.Comment
-	result := r.DB.Preload("Replies").Where("article_id = ? AND parent_id IS NULL", articleID).Find(&comments)
+	result := r.DB.Where("article_id IN (?)", r.DB.Model(&models.Article{}).Select("id")).
+		Where("status = ? AND hidden = ? AND tombstone = ?", models.CommentStatusApproved, false, false).
+		Find(&comments)
 	if result.Error != nil {
-		r.Logger.WithField("article_id", articleID).WithError(result.Error).
-			Error("Failed to fetch comments by article ID from database")
+		r.Logger.WithError(result.Error).Error("Failed to fetch all comments from database")
 		return nil, result.Error
 	}
 	return comments, nil
 }
 
+// GetThreads возвращает страницу комментариев верхнего уровня (или ответов на комментарий query.ParentID)
+// с ответами на query.Depth уровней вглубь и общее количество комментариев на этом уровне.
+// Ответы загружаются одним рекурсивным запросом; у каждого комментария заполняется ReplyCount,
+// поэтому у комментариев последнего уровня видно, есть ли у них незагруженные ответы.
+func (r *CommentRepository) GetThreads(query CommentThreadQuery) ([]*models.Comment, int64, error) {
+	var roots []*models.Comment
+	var total int64
+	level := r.DB.Model(&models.Comment{}).Scopes(query.Visibility.scope).Where("article_id = ?", query.ArticleID)
+	if query.ParentID != nil {
+		level = level.Where("parent_id = ?", *query.ParentID)
+	} else {
+		level = level.Where("parent_id IS NULL")
+	}
+	if err := level.Count(&total).Error; err != nil {
+		r.Logger.WithField("article_id", query.ArticleID).WithError(err).
+			Error("Failed to count comment threads in database")
+		return nil, 0, err
+	}
+	result := level.Scopes(commentOrder(query.Sort)).Limit(query.Limit).Offset(query.Offset).Find(&roots)
+	if result.Error != nil {
+		r.Logger.WithField("article_id", query.ArticleID).WithError(result.Error).
+			Error("Failed to fetch comment threads from database")
+		return nil, 0, result.Error
+	}
+	if len(roots) == 0 {
+		return roots, total, nil
+	}
+
+	rootIDs := make([]uint, 0, len(roots))
+	for _, root := range roots {
+		rootIDs = append(rootIDs, root.ID)
+	}
+	replies, err := r.getDescendants(rootIDs, query.Visibility, query.Depth)
+	if err != nil {
+		return nil, 0, err
+	}
+	loaded := append(append([]*models.Comment{}, roots...), replies...)
+	if err := r.fillReplyCounts(loaded, query.Visibility); err != nil {
+		return nil, 0, err
+	}
+	if err := r.fillMentions(loaded); err != nil {
+		return nil, 0, err
+	}
+	for _, root := range roots {
+		root.Replies = buildCommentTree(root.ID, replies, query.Sort)
+	}
+	return roots, total, nil
+}
+
+// getDescendants возвращает видимые читателю ответы на комментарии rootIDs не глубже depth уровней.
+// Ответы на невидимые комментарии не возвращаются.
+func (r *CommentRepository) getDescendants(rootIDs []uint, visibility CommentVisibility, depth int) ([]*models.Comment, error) {
+	var comments []*models.Comment
+	if depth < 1 {
+		return comments, nil
+	}
+	condition, args := visibility.condition("c.")
+	sql := `WITH RECURSIVE thread AS (
+		SELECT c.*, 1 AS depth FROM comments c
+		WHERE c.parent_id IN ? AND c.deleted_at IS NULL AND (` + condition + `)
+		UNION ALL
+		SELECT c.*, thread.depth + 1 FROM comments c
+		JOIN thread ON c.parent_id = thread.id
+		WHERE thread.depth < ? AND c.deleted_at IS NULL AND (` + condition + `)
+	)
+	SELECT * FROM thread`
+	values := append([]interface{}{rootIDs}, args...)
+	values = append(values, depth)
+	values = append(values, args...)
+	result := r.DB.Raw(sql, values...).Scan(&comments)
+	if result.Error != nil {
+		r.Logger.WithField("comment_ids", rootIDs).WithError(result.Error).
+			Error("Failed to fetch comment replies from database")
+		return nil, result.Error
+	}
+	return comments, nil
+}
+
+// fillReplyCounts заполняет количество видимых читателю прямых ответов на комментарии.
+func (r *CommentRepository) fillReplyCounts(comments []*models.Comment, visibility CommentVisibility) error {
+	ids := make([]uint, 0, len(comments))
+	for _, comment := range comments {
+		ids = append(ids, comment.ID)
+	}
+	var rows []struct {
+		ParentID uint
+		Count    int
+	}
+	result := r.DB.Model(&models.Comment{}).
+		Select("parent_id, COUNT(*) AS count").
+		Scopes(visibility.scope).
+		Where("parent_id IN ?", ids).
+		Group("parent_id").
+		Scan(&rows)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to count comment replies in database")
+		return result.Error
+	}
+	counts := make(map[uint]int, len(rows))
+	for _, row := range rows {
+		counts[row.ParentID] = row.Count
+	}
+	for _, comment := range comments {
+		comment.ReplyCount = counts[comment.ID]
+	}
+	return nil
+}
+
+// fillMentions заполняет упоминания пользователей в комментариях.
+func (r *CommentRepository) fillMentions(comments []*models.Comment) error {
+	ids := make([]uint, 0, len(comments))
+	for _, comment := range comments {
+		ids = append(ids, comment.ID)
+	}
+	var mentions []models.CommentMention
+	result := r.DB.Preload("User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id", "username")
+	}).Where("comment_id IN ?", ids).Find(&mentions)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to fetch comment mentions from database")
+		return result.Error
+	}
+	byComment := make(map[uint][]models.CommentMention, len(comments))
+	for _, mention := range mentions {
+		byComment[mention.CommentID] = append(byComment[mention.CommentID], mention)
+	}
+	for _, comment := range comments {
+		comment.Mentions = byComment[comment.ID]
+	}
+	return nil
+}
+
+// commentOrder упорядочивает комментарии в порядке sort.
+func commentOrder(sort string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		switch sort {
+		case CommentSortOldest:
+			return db.Order("created_at ASC").Order("id ASC")
+		case CommentSortTop:
+			db = db.Order("(SELECT COALESCE(SUM(value::int), 0) FROM jsonb_each_text(reaction_counts)) DESC")
+		}
+		return db.Order("created_at DESC").Order("id DESC")
+	}
+}
+
+// buildCommentTree собирает ответы на комментарий parentID в дерево,
+// упорядочивая каждый уровень так же, как commentOrder.
+func buildCommentTree(parentID uint, replies []*models.Comment, sort string) []models.Comment {
+	children := make(map[uint][]*models.Comment)
+	for _, reply := range replies {
+		if reply.ParentID != nil {
+			children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+		}
+	}
+	var build func(id uint) []models.Comment
+	build = func(id uint) []models.Comment {
+		level := children[id]
+		slices.SortFunc(level, func(a, b *models.Comment) int {
+			return compareComments(a, b, sort)
+		})
+		tree := make([]models.Comment, 0, len(level))
+		for _, comment := range level {
+			comment.Replies = build(comment.ID)
+			tree = append(tree, *comment)
+		}
+		return tree
+	}
+	return build(parentID)
+}
+
+// compareComments сравнивает комментарии в порядке sort.
+func compareComments(a, b *models.Comment, sort string) int {
+	if sort == CommentSortTop {
+		if diff := b.ReactionCounts.Total() - a.ReactionCounts.Total(); diff != 0 {
+			return diff
+		}
+	}
+	order := b.CreatedAt.Compare(a.CreatedAt)
+	if order == 0 {
+		order = cmp.Compare(b.ID, a.ID)
+	}
+	if sort == CommentSortOldest {
+		return -order
+	}
+	return order
+}
+
+// preloadMentions подгружает упоминания комментария с именами упомянутых пользователей.
+func preloadMentions(db *gorm.DB) *gorm.DB {
+	return db.Preload("Mentions.User", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id", "username")
+	})
+}
+
 // GetByID возвращает комментарий по ID.
 func (r *CommentRepository) GetByID(id uint) (*models.Comment, error) {
 	var comment models.Comment
-	result := r.DB.First(&comment, id)
+	result := r.DB.Scopes(preloadMentions).First(&comment, id)
 	if result.Error != nil {
 		r.Logger.WithField("comment_id", id).WithError(result.Error).
 			Error("Failed to fetch comment by ID from database")
@@ -53,18 +298,267 @@ func (r *CommentRepository) GetByID(id uint) (*models.Comment, error) {
 	return &comment, nil
 }
 
-// Update редактирует содержимое комментария.
-func (r *CommentRepository) Update(comment *models.Comment) error {
-	result := r.DB.Save(comment)
+// GetByIDs возвращает комментарии с указанными ID.
+func (r *CommentRepository) GetByIDs(ids []uint) ([]*models.Comment, error) {
+	var comments []*models.Comment
+	result := r.DB.Where("id IN ?", ids).Find(&comments)
 	if result.Error != nil {
-		r.Logger.WithField("comment_id", comment.ID).WithError(result.Error).
-			Error("Failed to update comment in database")
+		r.Logger.WithError(result.Error).Error("Failed to fetch comments by IDs from database")
+		return nil, result.Error
+	}
+	return comments, nil
+}
+
+// GetByStatus возвращает комментарии в указанном статусе, начиная с самых старых,
+// и общее количество таких комментариев. Комментарии к статьям в корзине не возвращаются.
+func (r *CommentRepository) GetByStatus(status string, limit, offset int) ([]*models.Comment, int64, error) {
+	var comments []*models.Comment
+	var total int64
+	query := r.DB.Model(&models.Comment{}).
+		Joins("JOIN articles ON articles.id = comments.article_id AND articles.deleted_at IS NULL").
+		Where("comments.status = ?", status)
+	if err := query.Count(&total).Error; err != nil {
+		r.Logger.WithField("status", status).WithError(err).Error("Failed to count comments by status in database")
+		return nil, 0, err
+	}
+	result := query.Order("comments.created_at ASC").Order("comments.id ASC").Limit(limit).Offset(offset).Find(&comments)
+	if result.Error != nil {
+		r.Logger.WithField("status", status).WithError(result.Error).Error("Failed to fetch comments by status from database")
+		return nil, 0, result.Error
+	}
+	return comments, total, nil
+}
+
+// CountApprovedByAuthor возвращает количество одобренных комментариев автора.
+func (r *CommentRepository) CountApprovedByAuthor(authorID uint) (int64, error) {
+	var count int64
+	result := r.DB.Model(&models.Comment{}).
+		Where("author_id = ? AND status = ?", authorID, models.CommentStatusApproved).
+		Count(&count)
+	if result.Error != nil {
+		r.Logger.WithField("author_id", authorID).WithError(result.Error).
+			Error("Failed to count approved comments of author in database")
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+// CountByAuthorSince возвращает количество комментариев автора, созданных после since.
+func (r *CommentRepository) CountByAuthorSince(authorID uint, since time.Time) (int64, error) {
+	var count int64
+	result := r.DB.Model(&models.Comment{}).Where("author_id = ? AND created_at >= ?", authorID, since).Count(&count)
+	if result.Error != nil {
+		r.Logger.WithField("author_id", authorID).WithError(result.Error).
+			Error("Failed to count recent comments of author in database")
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+// CountByTextSince возвращает количество комментариев с точно таким же текстом, созданных после since.
+func (r *CommentRepository) CountByTextSince(text string, since time.Time) (int64, error) {
+	var count int64
+	result := r.DB.Model(&models.Comment{}).Where("text = ? AND created_at >= ?", text, since).Count(&count)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to count duplicate comments in database")
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+// UpdateStatus устанавливает статус модерации указанным комментариям.
+// Возвращает количество изменённых комментариев.
+func (r *CommentRepository) UpdateStatus(ids []uint, status string) (int64, error) {
+	result := r.DB.Model(&models.Comment{}).Where("id IN ?", ids).Update("status", status)
+	if result.Error != nil {
+		r.Logger.WithField("status", status).WithError(result.Error).
+			Error("Failed to update comment status in database")
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// SetHidden скрывает комментарий или возвращает его в выдачу.
+func (r *CommentRepository) SetHidden(id uint, hidden bool) error {
+	result := r.DB.Model(&models.Comment{}).Where("id = ?", id).UpdateColumn("hidden", hidden)
+	if result.Error != nil {
+		r.Logger.WithField("comment_id", id).WithError(result.Error).
+			Error("Failed to update comment visibility in database")
 		return result.Error
 	}
 	return nil
 }
 
-// Delete удаляет комментарий по ID.
+// SetLocked закрывает ветку под комментарием для новых ответов или открывает её.
+func (r *CommentRepository) SetLocked(id uint, locked bool) error {
+	result := r.DB.Model(&models.Comment{}).Where("id = ?", id).UpdateColumn("locked", locked)
+	if result.Error != nil {
+		r.Logger.WithField("comment_id", id).WithError(result.Error).
+			Error("Failed to update comment thread lock in database")
+		return result.Error
+	}
+	return nil
+}
+
+// IsThreadLocked сообщает, закрыт ли для ответов сам комментарий или один из его предков.
+func (r *CommentRepository) IsThreadLocked(id uint) (bool, error) {
+	var locked bool
+	result := r.DB.Raw(`WITH RECURSIVE ancestors AS (
+		SELECT id, parent_id, locked FROM comments WHERE id = ?
+		UNION ALL
+		SELECT c.id, c.parent_id, c.locked FROM comments c
+		JOIN ancestors ON c.id = ancestors.parent_id
+	)
+	SELECT EXISTS (SELECT 1 FROM ancestors WHERE locked)`, id).Scan(&locked)
+	if result.Error != nil {
+		r.Logger.WithField("comment_id", id).WithError(result.Error).
+			Error("Failed to check comment thread lock in database")
+		return false, result.Error
+	}
+	return locked, nil
+}
+
+// CountReplies возвращает количество прямых ответов на комментарий в любом статусе, кроме ответов в корзине.
+func (r *CommentRepository) CountReplies(id uint) (int64, error) {
+	var count int64
+	result := r.DB.Model(&models.Comment{}).Where("parent_id = ?", id).Count(&count)
+	if result.Error != nil {
+		r.Logger.WithField("comment_id", id).WithError(result.Error).
+			Error("Failed to count comment replies in database")
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+// Tombstone стирает автора, текст, упоминания и историю правок комментария, сохраняя его место в ветке ответов.
+func (r *CommentRepository) Tombstone(id uint) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		return tombstone(tx, []uint{id})
+	})
+	if err != nil {
+		r.Logger.WithField("comment_id", id).WithError(err).
+			Error("Failed to replace comment with a tombstone in database")
+		return err
+	}
+	return nil
+}
+
+// tombstone заменяет комментарии ids надгробиями в транзакции tx. Упоминания и прежние версии
+// текста удаляются: иначе стёртый текст оставался бы доступен через историю правок.
+func tombstone(tx *gorm.DB, ids []uint) error {
+	if err := tx.Where("comment_id IN ?", ids).Delete(&models.CommentMention{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Where("comment_id IN ?", ids).Delete(&models.CommentRevision{}).Error; err != nil {
+		return err
+	}
+	return tx.Unscoped().Model(&models.Comment{}).Where("id IN ?", ids).UpdateColumns(tombstoneColumns()).Error
+}
+
+// tombstoneColumns возвращает значения колонок, заменяющих комментарий надгробием.
+// Комментарий из корзины при этом возвращается в ветку.
+func tombstoneColumns() map[string]interface{} {
+	return map[string]interface{}{
+		"tombstone":    true,
+		"author_id":    0,
+		"text":         models.CommentTombstoneText,
+		"spam_score":   0,
+		"spam_reasons": "",
+		"edited_at":    nil,
+		"deleted_at":   nil,
+		"updated_at":   time.Now(),
+	}
+}
+
+// RemoveSubtree окончательно удаляет комментарий со всеми вложенными ответами, включая ответы в корзине,
+// и сохраняет запись журнала удаления. Количество удалённых комментариев записывается в removal.RemovedCount.
+// Возвращает идентификаторы удалённых комментариев.
+func (r *CommentRepository) RemoveSubtree(removal *models.CommentRemoval) ([]uint, error) {
+	var ids []uint
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw(`WITH RECURSIVE subtree AS (
+			SELECT id FROM comments WHERE id = ?
+			UNION ALL
+			SELECT c.id FROM comments c JOIN subtree ON c.parent_id = subtree.id
+		)
+		SELECT id FROM subtree`, removal.CommentID).Scan(&ids).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Delete(&models.Comment{}, ids).Error; err != nil {
+			return err
+		}
+		removal.RemovedCount = len(ids)
+		return tx.Create(removal).Error
+	})
+	if err != nil {
+		r.Logger.WithField("comment_id", removal.CommentID).WithError(err).
+			Error("Failed to remove comment subtree from database")
+		return nil, err
+	}
+	return ids, nil
+}
+
+// GetRemovals возвращает журнал удалений веток комментариев, начиная с недавних, и общее количество записей.
+func (r *CommentRepository) GetRemovals(limit, offset int) ([]*models.CommentRemoval, int64, error) {
+	var removals []*models.CommentRemoval
+	var total int64
+	query := r.DB.Model(&models.CommentRemoval{})
+	if err := query.Count(&total).Error; err != nil {
+		r.Logger.WithError(err).Error("Failed to count comment removals in database")
+		return nil, 0, err
+	}
+	result := query.Order("created_at DESC").Order("id DESC").Limit(limit).Offset(offset).Find(&removals)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to fetch comment removals from database")
+		return nil, 0, result.Error
+	}
+	return removals, total, nil
+}
+
+// Update редактирует содержимое комментария, сохраняет его прежний текст в истории правок
+// и заменяет упоминания пользователей на comment.Mentions.
+// Вместе с текстом сохраняются статус модерации и оценка спам-фильтра;
+// счётчики реакций, признак скрытия и блокировка ветки не перезаписываются.
+func (r *CommentRepository) Update(comment *models.Comment, revision *models.CommentRevision) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(revision).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("comment_id = ?", comment.ID).Delete(&models.CommentMention{}).Error; err != nil {
+			return err
+		}
+		if len(comment.Mentions) > 0 {
+			for i := range comment.Mentions {
+				comment.Mentions[i].CommentID = comment.ID
+			}
+			if err := tx.Omit("User").Create(&comment.Mentions).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Omit("ReactionCounts", "Hidden", "Locked", "Mentions").Save(comment).Error
+	})
+	if err != nil {
+		r.Logger.WithField("comment_id", comment.ID).WithError(err).
+			Error("Failed to update comment in database")
+		return err
+	}
+	return nil
+}
+
+// GetRevisions возвращает историю правок комментария, начиная с последней.
+func (r *CommentRepository) GetRevisions(commentID uint) ([]*models.CommentRevision, error) {
+	var revisions []*models.CommentRevision
+	result := r.DB.Where("comment_id = ?", commentID).Order("created_at DESC").Order("id DESC").Find(&revisions)
+	if result.Error != nil {
+		r.Logger.WithField("comment_id", commentID).WithError(result.Error).
+			Error("Failed to fetch comment revisions from database")
+		return nil, result.Error
+	}
+	return revisions, nil
+}
+
+// Delete мягко удаляет комментарий: он перемещается в корзину до окончательной очистки.
 func (r *CommentRepository) Delete(id uint) error {
 	result := r.DB.Delete(&models.Comment{}, id)
 	if result.Error != nil {
@@ -74,3 +568,82 @@ func (r *CommentRepository) Delete(id uint) error {
 	}
 	return nil
 }
+
+// GetAllByArticleID возвращает все комментарии статьи без учёта вложенности.
+func (r *CommentRepository) GetAllByArticleID(articleID uint) ([]*models.Comment, error) {
+	var comments []*models.Comment
+	result := r.DB.Where("article_id = ?", articleID).Find(&comments)
+	if result.Error != nil {
+		r.Logger.WithField("article_id", articleID).WithError(result.Error).
+			Error("Failed to fetch all comments by article ID from database")
+		return nil, result.Error
+	}
+	return comments, nil
+}
+
+// GetDeleted возвращает комментарии из корзины, начиная с недавно удалённых.
+// Если authorID не равен 0, возвращаются только комментарии этого автора.
+func (r *CommentRepository) GetDeleted(authorID uint) ([]*models.Comment, error) {
+	var comments []*models.Comment
+	query := r.DB.Unscoped().Where("deleted_at IS NOT NULL")
+	if authorID != 0 {
+		query = query.Where("author_id = ?", authorID)
+	}
+	result := query.Order("deleted_at DESC").Find(&comments)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to fetch deleted comments from database")
+		return nil, result.Error
+	}
+	return comments, nil
+}
+
+// GetDeletedByID возвращает комментарий из корзины по ID.
+func (r *CommentRepository) GetDeletedByID(id uint) (*models.Comment, error) {
+	var comment models.Comment
+	result := r.DB.Unscoped().Where("deleted_at IS NOT NULL").First(&comment, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &comment, nil
+}
+
+// Restore возвращает комментарий из корзины.
+func (r *CommentRepository) Restore(id uint) error {
+	result := r.DB.Unscoped().Model(&models.Comment{}).Where("id = ?", id).Update("deleted_at", nil)
+	if result.Error != nil {
+		r.Logger.WithField("comment_id", id).WithError(result.Error).
+			Error("Failed to restore comment in database")
+		return result.Error
+	}
+	return nil
+}
+
+// PurgeDeletedBefore окончательно удаляет комментарии, находящиеся в корзине с момента до cutoff.
+// Комментарий, на который к этому времени есть ответы вне корзины (например, восстановленные
+// после удаления родителя), не удаляется, а заменяется надгробием, чтобы ответы остались в ветке.
+// Возвращает количество удалённых комментариев.
+func (r *CommentRepository) PurgeDeletedBefore(cutoff time.Time) (int64, error) {
+	var purged int64
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var parentIDs []uint
+		if err := tx.Unscoped().Model(&models.Comment{}).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+			Where("EXISTS (SELECT 1 FROM comments AS replies WHERE replies.parent_id = comments.id AND replies.deleted_at IS NULL)").
+			Pluck("id", &parentIDs).Error; err != nil {
+			return err
+		}
+		if len(parentIDs) > 0 {
+			if err := tombstone(tx, parentIDs); err != nil {
+				return err
+			}
+		}
+		result := tx.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).Delete(&models.Comment{})
+		purged = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		r.Logger.WithError(err).Error("Failed to purge deleted comments from database")
+		return 0, err
+	}
+	return purged, nil
+}
@@ -0,0 +1,299 @@
+package repositories
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/migrations"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// openTestDB подключается к PostgreSQL из TEST_DATABASE_DSN и применяет миграции.
+// Без TEST_DATABASE_DSN тест пропускается.
+func openTestDB(t *testing.T) (*gorm.DB, logger.Logger) {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_DSN is not set")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to connect to test database: %v", err)
+	}
+	log := logger.NewLogger(filepath.Join(t.TempDir(), "test.log"))
+	if err := migrations.MigrateModels(db, log); err != nil {
+		t.Fatalf("failed to migrate test database: %v", err)
+	}
+	return db, log
+}
+
+// createTestArticle создаёт автора со статьёй и удаляет их после теста вместе с комментариями.
+func createTestArticle(t *testing.T, db *gorm.DB) *models.Article {
+	t.Helper()
+	suffix := strconv.FormatInt(time.Now().UnixNano(), 36)
+	role := &models.Role{Name: "test-" + suffix}
+	if err := db.Create(role).Error; err != nil {
+		t.Fatalf("failed to create role: %v", err)
+	}
+	user := &models.User{RoleID: role.ID, Username: "test-" + suffix, Email: suffix + "@example.com", PasswordHash: "-"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+	article := &models.Article{AuthorID: user.ID, Title: "Test", Text: "Test", Published: true}
+	if err := db.Create(article).Error; err != nil {
+		t.Fatalf("failed to create article: %v", err)
+	}
+	t.Cleanup(func() {
+		db.Unscoped().Where("article_id = ?", article.ID).Delete(&models.Comment{})
+		db.Unscoped().Delete(article)
+		db.Unscoped().Delete(user)
+		db.Delete(role)
+	})
+	return article
+}
+
+func TestPurgeDeletedBeforeKeepsRepliesOfTombstonedParent(t *testing.T) {
+	db, log := openTestDB(t)
+	repo := NewCommentRepository(db, log)
+	article := createTestArticle(t, db)
+
+	parent := &models.Comment{ArticleID: article.ID, AuthorID: article.AuthorID, Text: "parent"}
+	if err := repo.Create(parent); err != nil {
+		t.Fatalf("failed to create parent: %v", err)
+	}
+	reply := &models.Comment{ArticleID: article.ID, AuthorID: article.AuthorID, ParentID: &parent.ID, Text: "reply"}
+	if err := repo.Create(reply); err != nil {
+		t.Fatalf("failed to create reply: %v", err)
+	}
+	if err := repo.Tombstone(parent.ID); err != nil {
+		t.Fatalf("failed to tombstone parent: %v", err)
+	}
+	// Надгробие попадает в корзину, например после восстановления ответа из корзины
+	if err := db.Model(&models.Comment{}).Where("id = ?", parent.ID).
+		UpdateColumn("deleted_at", time.Now().Add(-time.Hour)).Error; err != nil {
+		t.Fatalf("failed to move parent to trash: %v", err)
+	}
+
+	if _, err := repo.PurgeDeletedBefore(time.Now()); err != nil {
+		t.Fatalf("failed to purge comments: %v", err)
+	}
+
+	survived, err := repo.GetByID(reply.ID)
+	if err != nil {
+		t.Fatalf("reply was removed together with its parent: %v", err)
+	}
+	if survived.ParentID == nil || *survived.ParentID != parent.ID {
+		t.Fatalf("reply parent = %v, want %d", survived.ParentID, parent.ID)
+	}
+	kept, err := repo.GetByID(parent.ID)
+	if err != nil {
+		t.Fatalf("parent with replies was purged: %v", err)
+	}
+	if !kept.Tombstone {
+		t.Fatal("parent with replies was not kept as a tombstone")
+	}
+
+	// Окончательное удаление родителя в обход корзины также не затрагивает ответы
+	if err := db.Unscoped().Delete(&models.Comment{}, parent.ID).Error; err != nil {
+		t.Fatalf("failed to delete parent: %v", err)
+	}
+	orphan, err := repo.GetByID(reply.ID)
+	if err != nil {
+		t.Fatalf("reply was removed by the parent foreign key: %v", err)
+	}
+	if orphan.ParentID != nil {
+		t.Fatalf("reply parent = %d, want nil", *orphan.ParentID)
+	}
+}
+
+func TestTombstoneDeletesRevisions(t *testing.T) {
+	db, log := openTestDB(t)
+	repo := NewCommentRepository(db, log)
+	article := createTestArticle(t, db)
+
+	comment := &models.Comment{ArticleID: article.ID, AuthorID: article.AuthorID, Text: "my phone is 555-0100"}
+	if err := repo.Create(comment); err != nil {
+		t.Fatalf("failed to create comment: %v", err)
+	}
+	editedAt := time.Now()
+	revision := &models.CommentRevision{CommentID: comment.ID, EditorID: article.AuthorID, Text: comment.Text}
+	comment.Text = "call me"
+	comment.EditedAt = &editedAt
+	if err := repo.Update(comment, revision); err != nil {
+		t.Fatalf("failed to edit comment: %v", err)
+	}
+
+	if err := repo.Tombstone(comment.ID); err != nil {
+		t.Fatalf("failed to tombstone comment: %v", err)
+	}
+	revisions, err := repo.GetRevisions(comment.ID)
+	if err != nil {
+		t.Fatalf("failed to fetch revisions: %v", err)
+	}
+	if len(revisions) != 0 {
+		t.Errorf("tombstone kept %d revisions, first text %q", len(revisions), revisions[0].Text)
+	}
+	erased, err := repo.GetByID(comment.ID)
+	if err != nil {
+		t.Fatalf("failed to fetch tombstone: %v", err)
+	}
+	if erased.Text != models.CommentTombstoneText || erased.EditedAt != nil {
+		t.Errorf("tombstone text = %q, edited at %v; want %q and no edit mark", erased.Text, erased.EditedAt, models.CommentTombstoneText)
+	}
+}
+
+// moveToTrash помещает запись модели model в корзину с датой удаления deletedAt.
+func moveToTrash(t *testing.T, db *gorm.DB, model interface{}, id uint, deletedAt time.Time) {
+	t.Helper()
+	if err := db.Unscoped().Model(model).Where("id = ?", id).UpdateColumn("deleted_at", deletedAt).Error; err != nil {
+		t.Fatalf("failed to move %T %d to trash: %v", model, id, err)
+	}
+}
+
+func TestCommentPurgeDeletedBeforeRespectsCutoff(t *testing.T) {
+	db, log := openTestDB(t)
+	repo := NewCommentRepository(db, log)
+	article := createTestArticle(t, db)
+	cutoff := time.Now().Add(-24 * time.Hour)
+
+	create := func(text string, parentID *uint) *models.Comment {
+		t.Helper()
+		comment := &models.Comment{ArticleID: article.ID, AuthorID: article.AuthorID, ParentID: parentID, Text: text}
+		if err := repo.Create(comment); err != nil {
+			t.Fatalf("failed to create comment: %v", err)
+		}
+		return comment
+	}
+	expired := create("expired", nil)
+	recent := create("recent", nil)
+	parent := create("parent", nil)
+	trashedReply := create("reply", &parent.ID)
+	moveToTrash(t, db, &models.Comment{}, expired.ID, cutoff.Add(-time.Hour))
+	moveToTrash(t, db, &models.Comment{}, recent.ID, cutoff.Add(time.Hour))
+	moveToTrash(t, db, &models.Comment{}, parent.ID, cutoff.Add(-2*time.Hour))
+	moveToTrash(t, db, &models.Comment{}, trashedReply.ID, cutoff.Add(-2*time.Hour))
+
+	if _, err := repo.PurgeDeletedBefore(cutoff); err != nil {
+		t.Fatalf("failed to purge comments: %v", err)
+	}
+
+	// Родитель, все ответы которого тоже в корзине, удаляется вместе с ними, а не становится надгробием
+	for _, id := range []uint{expired.ID, parent.ID, trashedReply.ID} {
+		var count int64
+		db.Unscoped().Model(&models.Comment{}).Where("id = ?", id).Count(&count)
+		if count != 0 {
+			t.Errorf("comment %d deleted before the cutoff was not purged", id)
+		}
+	}
+	if _, err := repo.GetDeletedByID(recent.ID); err != nil {
+		t.Fatalf("comment deleted after the cutoff left the trash: %v", err)
+	}
+
+	if err := repo.Restore(recent.ID); err != nil {
+		t.Fatalf("failed to restore comment: %v", err)
+	}
+	restored, err := repo.GetByID(recent.ID)
+	if err != nil || restored.Text != "recent" {
+		t.Fatalf("restored comment = %+v, %v", restored, err)
+	}
+	if _, err := repo.GetDeletedByID(recent.ID); err == nil {
+		t.Error("restored comment is still in the trash")
+	}
+}
+
+// treeComment создаёт комментарий для сборки дерева; parentID 0 означает комментарий без родителя.
+func treeComment(id, parentID uint, minute int) *models.Comment {
+	comment := &models.Comment{ID: id, CreatedAt: time.Date(2024, 1, 1, 12, minute, 0, 0, time.UTC)}
+	if parentID != 0 {
+		comment.ParentID = &parentID
+	}
+	return comment
+}
+
+// treeIDs записывает дерево комментариев как идентификаторы с вложенными ответами в скобках.
+func treeIDs(tree []models.Comment) string {
+	parts := make([]string, 0, len(tree))
+	for _, comment := range tree {
+		part := strconv.FormatUint(uint64(comment.ID), 10)
+		if len(comment.Replies) > 0 {
+			part += "(" + treeIDs(comment.Replies) + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestBuildCommentTreeOrdersEachLevel(t *testing.T) {
+	replies := func() []*models.Comment {
+		liked := treeComment(3, 1, 1)
+		liked.ReactionCounts = models.ReactionCounts{"like": 2}
+		return []*models.Comment{
+			treeComment(2, 1, 5),
+			liked,
+			treeComment(4, 2, 7),
+			treeComment(5, 2, 6),
+			treeComment(6, 5, 8),
+			treeComment(7, 1, 5), // создан одновременно с 2: порядок определяет ID
+		}
+	}
+	tests := map[string]string{
+		CommentSortNewest: "7 2(4 5(6)) 3",
+		CommentSortOldest: "3 2(5(6) 4) 7",
+		CommentSortTop:    "3 7 2(4 5(6))",
+	}
+	for sort, want := range tests {
+		if got := treeIDs(buildCommentTree(1, replies(), sort)); got != want {
+			t.Errorf("buildCommentTree(%s) = %s, want %s", sort, got, want)
+		}
+	}
+}
+
+func TestBuildCommentTreeSplitsRepliesBetweenRoots(t *testing.T) {
+	replies := []*models.Comment{
+		treeComment(10, 1, 1),
+		treeComment(20, 2, 2),
+		treeComment(11, 10, 3),
+	}
+	if got := treeIDs(buildCommentTree(1, replies, CommentSortOldest)); got != "10(11)" {
+		t.Errorf("tree of root 1 = %s, want 10(11)", got)
+	}
+	if got := treeIDs(buildCommentTree(2, replies, CommentSortOldest)); got != "20" {
+		t.Errorf("tree of root 2 = %s, want 20", got)
+	}
+	if got := buildCommentTree(3, replies, CommentSortOldest); len(got) != 0 {
+		t.Errorf("tree of a root without replies = %s, want empty", treeIDs(got))
+	}
+}
+
+func TestBuildCommentTreeOrphans(t *testing.T) {
+	tombstone := treeComment(2, 1, 1)
+	tombstone.Tombstone = true
+	tombstone.AuthorID = 0
+	tombstone.Text = models.CommentTombstoneText
+
+	replies := []*models.Comment{
+		tombstone,
+		treeComment(3, 2, 2), // ответ на надгробие остаётся в ветке
+		treeComment(4, 0, 3), // родитель удалён окончательно, parent_id стал NULL
+		treeComment(5, 9, 4), // родитель не вернулся из запроса (в корзине или скрыт)
+		treeComment(6, 5, 5), // ответ на такого сироту
+	}
+	tree := buildCommentTree(1, replies, CommentSortOldest)
+	if got := treeIDs(tree); got != "2(3)" {
+		t.Fatalf("buildCommentTree() = %s, want 2(3)", got)
+	}
+	if !tree[0].Tombstone || tree[0].Text != models.CommentTombstoneText {
+		t.Errorf("tombstone parent = %+v, want it kept as a tombstone", tree[0])
+	}
+	// Комментарий без родителя не должен попасть ни в одну ветку, даже в ветку комментария с ID 0
+	if got := buildCommentTree(0, replies, CommentSortOldest); len(got) != 0 {
+		t.Errorf("replies with NULL parent_id were attached to root 0: %s", treeIDs(got))
+	}
+}
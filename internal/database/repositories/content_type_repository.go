@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+)
+
+// ContentTypeRepository предоставляет методы для работы с типами контента в базе данных.
+type ContentTypeRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewContentTypeRepository создаёт новый экземпляр ContentTypeRepository.
+func NewContentTypeRepository(db *gorm.DB, logger logger.Logger) *ContentTypeRepository {
+	return &ContentTypeRepository{DB: db, Logger: logger}
+}
+
+// Create создаёт новый тип контента в базе данных.
+func (r *ContentTypeRepository) Create(contentType *models.ContentType) error {
+	result := r.DB.Create(contentType)
+	if result.Error != nil {
+		r.Logger.WithField("slug", contentType.Slug).WithError(result.Error).Error("Failed to create content type in database")
+		return result.Error
+	}
+	return nil
+}
+
+// GetAll возвращает список всех типов контента.
+func (r *ContentTypeRepository) GetAll() ([]*models.ContentType, error) {
+	var contentTypes []*models.ContentType
+	result := r.DB.Order("name").Find(&contentTypes)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to fetch all content types from database")
+		return nil, result.Error
+	}
+	return contentTypes, nil
+}
+
+// GetBySlug возвращает тип контента по его slug.
+func (r *ContentTypeRepository) GetBySlug(slug string) (*models.ContentType, error) {
+	var contentType models.ContentType
+	result := r.DB.Where("slug = ?", slug).First(&contentType)
+	if result.Error != nil {
+		r.Logger.WithField("slug", slug).WithError(result.Error).Warn("Failed to fetch content type by slug from database")
+		return nil, result.Error
+	}
+	return &contentType, nil
+}
+
+// CountEntries возвращает количество записей типа контента.
+func (r *ContentTypeRepository) CountEntries(id uint) (int64, error) {
+	var count int64
+	result := r.DB.Model(&models.Entry{}).Where("content_type_id = ?", id).Count(&count)
+	if result.Error != nil {
+		r.Logger.WithField("content_type_id", id).WithError(result.Error).Error("Failed to count content type entries in database")
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+// Update обновляет тип контента в базе данных.
+func (r *ContentTypeRepository) Update(contentType *models.ContentType) error {
+	result := r.DB.Save(contentType)
+	if result.Error != nil {
+		r.Logger.WithField("content_type_id", contentType.ID).WithError(result.Error).Error("Failed to update content type in database")
+		return result.Error
+	}
+	return nil
+}
+
+// Delete удаляет тип контента по ID.
+func (r *ContentTypeRepository) Delete(id uint) error {
+	result := r.DB.Delete(&models.ContentType{}, id)
+	if result.Error != nil {
+		r.Logger.WithField("content_type_id", id).WithError(result.Error).Error("Failed to delete content type from database")
+		return result.Error
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+)
+
+// EntryRepository предоставляет методы для работы с записями пользовательских типов контента.
+type EntryRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewEntryRepository создаёт новый экземпляр EntryRepository.
+func NewEntryRepository(db *gorm.DB, logger logger.Logger) *EntryRepository {
+	return &EntryRepository{DB: db, Logger: logger}
+}
+
+// Create создаёт новую запись в базе данных.
+func (r *EntryRepository) Create(entry *models.Entry) error {
+	result := r.DB.Omit("ContentType").Create(entry)
+	if result.Error != nil {
+		r.Logger.WithFields(map[string]interface{}{
+			"content_type_id": entry.ContentTypeID,
+			"author_id":       entry.AuthorID,
+		}).WithError(result.Error).Error("Failed to create entry in database")
+		return result.Error
+	}
+	return nil
+}
+
+// GetAllByContentType возвращает страницу записей указанного типа, начиная с новых.
+// При publishedOnly возвращаются только опубликованные записи и черновики автора draftsOf (0 — ничьи).
+func (r *EntryRepository) GetAllByContentType(contentTypeID uint, publishedOnly bool, draftsOf uint, limit, offset int) ([]*models.Entry, error) {
+	var entries []*models.Entry
+	query := r.DB.Where("content_type_id = ?", contentTypeID)
+	if publishedOnly && draftsOf != 0 {
+		query = query.Where("published = ? OR author_id = ?", true, draftsOf)
+	} else if publishedOnly {
+		query = query.Where("published = ?", true)
+	}
+	result := query.Order("created_at DESC").Limit(limit).Offset(offset).
+		Find(&entries)
+	if result.Error != nil {
+		r.Logger.WithField("content_type_id", contentTypeID).WithError(result.Error).Error("Failed to fetch entries from database")
+		return nil, result.Error
+	}
+	return entries, nil
+}
+
+// GetByID возвращает запись указанного типа по её ID.
+func (r *EntryRepository) GetByID(contentTypeID, id uint) (*models.Entry, error) {
+	var entry models.Entry
+	result := r.DB.Where("content_type_id = ?", contentTypeID).First(&entry, id)
+	if result.Error != nil {
+		r.Logger.WithField("entry_id", id).WithError(result.Error).Error("Failed to fetch entry by ID from database")
+		return nil, result.Error
+	}
+	return &entry, nil
+}
+
+// Update обновляет запись в базе данных.
+func (r *EntryRepository) Update(entry *models.Entry) error {
+	result := r.DB.Omit("ContentType").Save(entry)
+	if result.Error != nil {
+		r.Logger.WithField("entry_id", entry.ID).WithError(result.Error).Error("Failed to update entry in database")
+		return result.Error
+	}
+	return nil
+}
+
+// Delete удаляет запись по ID.
+func (r *EntryRepository) Delete(id uint) error {
+	result := r.DB.Delete(&models.Entry{}, id)
+	if result.Error != nil {
+		r.Logger.WithField("entry_id", id).WithError(result.Error).Error("Failed to delete entry from database")
+		return result.Error
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package repositories
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FollowCount представляет количество подписок или подписчиков пользователя.
+type FollowCount struct {
+	UserID uint
+	Count  int64
+}
+
+// FollowRepository предоставляет методы для работы с подписками на авторов и теги в БД.
+type FollowRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewFollowRepository создаёт новый экземпляр FollowRepository.
+func NewFollowRepository(db *gorm.DB, logger logger.Logger) *FollowRepository {
+	return &FollowRepository{DB: db, Logger: logger}
+}
+
+// FollowAuthor подписывает пользователя на автора. Повторная подписка ничего не меняет.
+func (r *FollowRepository) FollowAuthor(followerID, authorID uint) error {
+	follow := models.AuthorFollow{FollowerID: followerID, AuthorID: authorID}
+	result := r.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&follow)
+	if result.Error != nil {
+		r.Logger.WithField("author_id", authorID).WithError(result.Error).Error("Failed to create author follow in database")
+		return result.Error
+	}
+	return nil
+}
+
+// UnfollowAuthor отписывает пользователя от автора.
+func (r *FollowRepository) UnfollowAuthor(followerID, authorID uint) error {
+	result := r.DB.Where("follower_id = ? AND author_id = ?", followerID, authorID).Delete(&models.AuthorFollow{})
+	if result.Error != nil {
+		r.Logger.WithField("author_id", authorID).WithError(result.Error).Error("Failed to delete author follow from database")
+		return result.Error
+	}
+	return nil
+}
+
+// FollowTag подписывает пользователя на тег. Повторная подписка ничего не меняет.
+func (r *FollowRepository) FollowTag(userID, tagID uint) error {
+	follow := models.TagFollow{UserID: userID, TagID: tagID}
+	result := r.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&follow)
+	if result.Error != nil {
+		r.Logger.WithField("tag_id", tagID).WithError(result.Error).Error("Failed to create tag follow in database")
+		return result.Error
+	}
+	return nil
+}
+
+// UnfollowTag отписывает пользователя от тега.
+func (r *FollowRepository) UnfollowTag(userID, tagID uint) error {
+	result := r.DB.Where("user_id = ? AND tag_id = ?", userID, tagID).Delete(&models.TagFollow{})
+	if result.Error != nil {
+		r.Logger.WithField("tag_id", tagID).WithError(result.Error).Error("Failed to delete tag follow from database")
+		return result.Error
+	}
+	return nil
+}
+
+// GetFollowedAuthors возвращает подписки пользователя на авторов вместе с авторами, начиная с последних.
+func (r *FollowRepository) GetFollowedAuthors(followerID uint) ([]*models.AuthorFollow, error) {
+	var follows []*models.AuthorFollow
+	result := r.DB.Preload("Author").Where("follower_id = ?", followerID).Order("created_at DESC").Find(&follows)
+	if result.Error != nil {
+		r.Logger.WithField("user_id", followerID).WithError(result.Error).Error("Failed to fetch followed authors from database")
+		return nil, result.Error
+	}
+	return follows, nil
+}
+
+// GetFollowedTags возвращает подписки пользователя на теги вместе с тегами, начиная с последних.
+func (r *FollowRepository) GetFollowedTags(userID uint) ([]*models.TagFollow, error) {
+	var follows []*models.TagFollow
+	result := r.DB.Preload("Tag").Where("user_id = ?", userID).Order("created_at DESC").Find(&follows)
+	if result.Error != nil {
+		r.Logger.WithField("user_id", userID).WithError(result.Error).Error("Failed to fetch followed tags from database")
+		return nil, result.Error
+	}
+	return follows, nil
+}
+
+// CountFollowers возвращает количество подписчиков каждого из пользователей userIDs.
+// Удалённые подписчики не учитываются; пользователи без подписчиков в результат не попадают.
+func (r *FollowRepository) CountFollowers(userIDs []uint) ([]*FollowCount, error) {
+	var counts []*FollowCount
+	result := r.DB.Model(&models.AuthorFollow{}).
+		Select("author_follows.author_id AS user_id, COUNT(*) AS count").
+		Joins("JOIN users ON users.id = author_follows.follower_id AND users.deleted_at IS NULL").
+		Where("author_follows.author_id IN ?", userIDs).
+		Group("author_follows.author_id").Scan(&counts)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to count followers in database")
+		return nil, result.Error
+	}
+	return counts, nil
+}
+
+// CountFollowing возвращает количество авторов, на которых подписан каждый из пользователей userIDs.
+// Удалённые авторы не учитываются; пользователи без подписок в результат не попадают.
+func (r *FollowRepository) CountFollowing(userIDs []uint) ([]*FollowCount, error) {
+	var counts []*FollowCount
+	result := r.DB.Model(&models.AuthorFollow{}).
+		Select("author_follows.follower_id AS user_id, COUNT(*) AS count").
+		Joins("JOIN users ON users.id = author_follows.author_id AND users.deleted_at IS NULL").
+		Where("author_follows.follower_id IN ?", userIDs).
+		Group("author_follows.follower_id").Scan(&counts)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to count following in database")
+		return nil, result.Error
+	}
+	return counts, nil
+}
@@ -0,0 +1,11 @@
+package repositories
+
+import "strings"
+
+// likeEscaper экранирует спецсимволы шаблонов LIKE/ILIKE.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike экранирует пользовательский ввод для использования в LIKE/ILIKE.
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
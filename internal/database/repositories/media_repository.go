@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"time"
+
 	"github.com/AsterOzlob/content_managment_api/internal/database/models"
 	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
 	"gorm.io/gorm"
@@ -51,7 +53,19 @@ func (r *MediaRepository) GetByID(id uint) (*models.Media, error) {
 	return &media, nil
 }
 
-// Delete удаляет медиафайл по его ID.
+// GetByIDs возвращает медиафайлы с указанными ID.
+func (r *MediaRepository) GetByIDs(ids []uint) ([]*models.Media, error) {
+	var media []*models.Media
+	result := r.DB.Where("id IN ?", ids).Find(&media)
+	if result.Error != nil {
+		r.Logger.WithField("media_ids", ids).WithError(result.Error).
+			Error("Failed to fetch media by IDs from database")
+		return nil, result.Error
+	}
+	return media, nil
+}
+
+// Delete мягко удаляет медиафайл: он перемещается в корзину до окончательной очистки.
 func (r *MediaRepository) Delete(id uint) error {
 	result := r.DB.Delete(&models.Media{}, id)
 	if result.Error != nil {
@@ -73,3 +87,58 @@ func (r *MediaRepository) GetAllByArticleID(articleID uint) ([]*models.Media, er
 	}
 	return media, nil
 }
+
+// GetDeleted возвращает медиафайлы из корзины, начиная с недавно удалённых.
+// Если authorID не равен 0, возвращаются только файлы этого автора.
+func (r *MediaRepository) GetDeleted(authorID uint) ([]*models.Media, error) {
+	var media []*models.Media
+	query := r.DB.Unscoped().Where("deleted_at IS NOT NULL")
+	if authorID != 0 {
+		query = query.Where("author_id = ?", authorID)
+	}
+	result := query.Order("deleted_at DESC").Find(&media)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to fetch deleted media from database")
+		return nil, result.Error
+	}
+	return media, nil
+}
+
+// GetDeletedByID возвращает медиафайл из корзины по ID.
+func (r *MediaRepository) GetDeletedByID(id uint) (*models.Media, error) {
+	var media models.Media
+	result := r.DB.Unscoped().Where("deleted_at IS NOT NULL").First(&media, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &media, nil
+}
+
+// Restore возвращает медиафайл из корзины.
+func (r *MediaRepository) Restore(id uint) error {
+	result := r.DB.Unscoped().Model(&models.Media{}).Where("id = ?", id).Update("deleted_at", nil)
+	if result.Error != nil {
+		r.Logger.WithField("media_id", id).WithError(result.Error).
+			Error("Failed to restore media in database")
+		return result.Error
+	}
+	return nil
+}
+
+// PurgeDeletedBefore окончательно удаляет медиафайлы, находящиеся в корзине с момента до cutoff.
+// Возвращает пути файлов удалённых записей.
+func (r *MediaRepository) PurgeDeletedBefore(cutoff time.Time) ([]string, error) {
+	var paths []string
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		query := tx.Unscoped().Model(&models.Media{}).Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff)
+		if err := query.Pluck("file_path", &paths).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).Delete(&models.Media{}).Error
+	})
+	if err != nil {
+		r.Logger.WithError(err).Error("Failed to purge deleted media from database")
+		return nil, err
+	}
+	return paths, nil
+}
@@ -0,0 +1,147 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// NotificationRepository предоставляет методы для работы с уведомлениями и их настройками в БД.
+type NotificationRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewNotificationRepository создаёт новый экземпляр NotificationRepository.
+func NewNotificationRepository(db *gorm.DB, logger logger.Logger) *NotificationRepository {
+	return &NotificationRepository{DB: db, Logger: logger}
+}
+
+// CreateBatch сохраняет уведомления.
+func (r *NotificationRepository) CreateBatch(notifications []*models.Notification) error {
+	if len(notifications) == 0 {
+		return nil
+	}
+	result := r.DB.Create(&notifications)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to create notifications in database")
+		return result.Error
+	}
+	return nil
+}
+
+// GetByUser возвращает уведомления пользователя, начиная с новых, и их общее количество.
+// Если unreadOnly равен true, возвращаются только непрочитанные уведомления.
+func (r *NotificationRepository) GetByUser(userID uint, unreadOnly bool, limit, offset int) ([]*models.Notification, int64, error) {
+	var notifications []*models.Notification
+	var total int64
+	query := r.DB.Model(&models.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+	if err := query.Count(&total).Error; err != nil {
+		r.Logger.WithField("user_id", userID).WithError(err).Error("Failed to count notifications in database")
+		return nil, 0, err
+	}
+	result := query.Preload("Actor", func(db *gorm.DB) *gorm.DB {
+		return db.Select("id", "username")
+	}).Order("created_at DESC").Order("id DESC").Limit(limit).Offset(offset).Find(&notifications)
+	if result.Error != nil {
+		r.Logger.WithField("user_id", userID).WithError(result.Error).Error("Failed to fetch notifications from database")
+		return nil, 0, result.Error
+	}
+	return notifications, total, nil
+}
+
+// CountUnread возвращает количество непрочитанных уведомлений пользователя.
+func (r *NotificationRepository) CountUnread(userID uint) (int64, error) {
+	var count int64
+	result := r.DB.Model(&models.Notification{}).Where("user_id = ? AND read_at IS NULL", userID).Count(&count)
+	if result.Error != nil {
+		r.Logger.WithField("user_id", userID).WithError(result.Error).Error("Failed to count unread notifications in database")
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+// MarkRead отмечает непрочитанные уведомления пользователя прочитанными.
+// Если ids пуст, прочитанными отмечаются все уведомления пользователя.
+// Возвращает количество отмеченных уведомлений.
+func (r *NotificationRepository) MarkRead(userID uint, ids []uint) (int64, error) {
+	query := r.DB.Model(&models.Notification{}).Where("user_id = ? AND read_at IS NULL", userID)
+	if len(ids) > 0 {
+		query = query.Where("id IN ?", ids)
+	}
+	result := query.Update("read_at", time.Now())
+	if result.Error != nil {
+		r.Logger.WithField("user_id", userID).WithError(result.Error).Error("Failed to mark notifications as read in database")
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+// GetPreferences возвращает сохранённые настройки уведомлений пользователя по типам.
+func (r *NotificationRepository) GetPreferences(userID uint) (map[string]bool, error) {
+	var preferences []models.NotificationPreference
+	result := r.DB.Where("user_id = ?", userID).Find(&preferences)
+	if result.Error != nil {
+		r.Logger.WithField("user_id", userID).WithError(result.Error).Error("Failed to fetch notification preferences from database")
+		return nil, result.Error
+	}
+	enabled := make(map[string]bool, len(preferences))
+	for _, preference := range preferences {
+		enabled[preference.Type] = preference.Enabled
+	}
+	return enabled, nil
+}
+
+// SetPreferences сохраняет настройки уведомлений пользователя для указанных типов.
+func (r *NotificationRepository) SetPreferences(userID uint, enabled map[string]bool) error {
+	if len(enabled) == 0 {
+		return nil
+	}
+	preferences := make([]models.NotificationPreference, 0, len(enabled))
+	for notificationType, on := range enabled {
+		preferences = append(preferences, models.NotificationPreference{UserID: userID, Type: notificationType, Enabled: on})
+	}
+	result := r.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled"}),
+	}).Create(&preferences)
+	if result.Error != nil {
+		r.Logger.WithField("user_id", userID).WithError(result.Error).Error("Failed to save notification preferences in database")
+		return result.Error
+	}
+	return nil
+}
+
+// GetDisabledUsers возвращает пользователей из userIDs, отключивших уведомления типа notificationType.
+func (r *NotificationRepository) GetDisabledUsers(notificationType string, userIDs []uint) ([]uint, error) {
+	var disabled []uint
+	result := r.DB.Model(&models.NotificationPreference{}).
+		Where("type = ? AND enabled = ? AND user_id IN ?", notificationType, false, userIDs).
+		Pluck("user_id", &disabled)
+	if result.Error != nil {
+		r.Logger.WithField("type", notificationType).WithError(result.Error).
+			Error("Failed to fetch disabled notification preferences from database")
+		return nil, result.Error
+	}
+	return disabled, nil
+}
+
+// GetNotifiedUsers возвращает пользователей из userIDs, уже получивших уведомление о комментарии commentID.
+func (r *NotificationRepository) GetNotifiedUsers(commentID uint, userIDs []uint) ([]uint, error) {
+	var notified []uint
+	result := r.DB.Model(&models.Notification{}).
+		Where("comment_id = ? AND user_id IN ?", commentID, userIDs).
+		Distinct().Pluck("user_id", &notified)
+	if result.Error != nil {
+		r.Logger.WithField("comment_id", commentID).WithError(result.Error).
+			Error("Failed to fetch notified users from database")
+		return nil, result.Error
+	}
+	return notified, nil
+}
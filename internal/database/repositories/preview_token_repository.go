@@ -0,0 +1,77 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+)
+
+// PreviewTokenRepository предоставляет методы для работы с токенами предпросмотра в БД.
+type PreviewTokenRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewPreviewTokenRepository создаёт новый экземпляр PreviewTokenRepository.
+func NewPreviewTokenRepository(db *gorm.DB, logger logger.Logger) *PreviewTokenRepository {
+	return &PreviewTokenRepository{DB: db, Logger: logger}
+}
+
+// Create создаёт новый токен предпросмотра.
+func (r *PreviewTokenRepository) Create(token *models.PreviewToken) error {
+	result := r.DB.Create(token)
+	if result.Error != nil {
+		r.Logger.WithField("article_id", token.ArticleID).WithError(result.Error).Error("Failed to create preview token in database")
+		return result.Error
+	}
+	return nil
+}
+
+// GetByID возвращает токен предпросмотра по ID.
+func (r *PreviewTokenRepository) GetByID(id uint) (*models.PreviewToken, error) {
+	var token models.PreviewToken
+	result := r.DB.First(&token, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &token, nil
+}
+
+// GetByArticleID возвращает все токены предпросмотра статьи, начиная с новых.
+func (r *PreviewTokenRepository) GetByArticleID(articleID uint) ([]*models.PreviewToken, error) {
+	var tokens []*models.PreviewToken
+	result := r.DB.Where("article_id = ?", articleID).Order("created_at DESC").Find(&tokens)
+	if result.Error != nil {
+		r.Logger.WithField("article_id", articleID).WithError(result.Error).Error("Failed to fetch preview tokens from database")
+		return nil, result.Error
+	}
+	return tokens, nil
+}
+
+// Revoke помечает токен отозванным, если он ещё не был отозван.
+func (r *PreviewTokenRepository) Revoke(id uint, at time.Time) error {
+	result := r.DB.Model(&models.PreviewToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		r.Logger.WithField("token_id", id).WithError(result.Error).Error("Failed to revoke preview token in database")
+		return result.Error
+	}
+	return nil
+}
+
+// RecordView атомарно увеличивает счётчик просмотров и запоминает время и IP-адрес просмотра.
+func (r *PreviewTokenRepository) RecordView(id uint, ip string, at time.Time) error {
+	result := r.DB.Model(&models.PreviewToken{}).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+		"view_count":     gorm.Expr("view_count + 1"),
+		"last_viewed_at": at,
+		"last_view_ip":   ip,
+	})
+	if result.Error != nil {
+		r.Logger.WithField("token_id", id).WithError(result.Error).Error("Failed to record preview token view in database")
+		return result.Error
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReactionRepository предоставляет методы для работы с реакциями в БД.
+type ReactionRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewReactionRepository создаёт новый экземпляр ReactionRepository.
+func NewReactionRepository(db *gorm.DB, logger logger.Logger) *ReactionRepository {
+	return &ReactionRepository{DB: db, Logger: logger}
+}
+
+// ToggleArticleReaction ставит реакцию пользователя на статью или снимает её, если она уже есть.
+// Возвращает true, если реакция поставлена, и обновлённые счётчики реакций статьи.
+func (r *ReactionRepository) ToggleArticleReaction(articleID, userID uint, reactionType string) (bool, models.ReactionCounts, error) {
+	reaction := models.Reaction{UserID: userID, ArticleID: &articleID, Type: reactionType}
+	return r.toggle("articles", "article_id", articleID, reaction)
+}
+
+// ToggleCommentReaction ставит реакцию пользователя на комментарий или снимает её, если она уже есть.
+// Возвращает true, если реакция поставлена, и обновлённые счётчики реакций комментария.
+func (r *ReactionRepository) ToggleCommentReaction(commentID, userID uint, reactionType string) (bool, models.ReactionCounts, error) {
+	reaction := models.Reaction{UserID: userID, CommentID: &commentID, Type: reactionType}
+	return r.toggle("comments", "comment_id", commentID, reaction)
+}
+
+// toggle в одной транзакции удаляет или создаёт реакцию и изменяет денормализованный
+// счётчик в таблице table, чтобы счётчики не расходились с реакциями.
+func (r *ReactionRepository) toggle(table, column string, targetID uint, reaction models.Reaction) (bool, models.ReactionCounts, error) {
+	var reacted bool
+	var counts models.ReactionCounts
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where(column+" = ? AND user_id = ? AND type = ?", targetID, reaction.UserID, reaction.Type).Delete(&models.Reaction{})
+		if result.Error != nil {
+			return result.Error
+		}
+		delta := -1
+		if result.RowsAffected == 0 {
+			reacted = true
+			result = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&reaction)
+			if result.Error != nil {
+				return result.Error
+			}
+			// Реакцию уже поставил параллельный запрос, счётчик им же и увеличен
+			delta = int(result.RowsAffected)
+		}
+		if delta != 0 {
+			if err := tx.Table(table).Where("id = ?", targetID).
+				UpdateColumn("reaction_counts", reactionCounterExpr(reaction.Type, delta)).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Table(table).Select("reaction_counts").Where("id = ?", targetID).Row().Scan(&counts)
+	})
+	if err != nil {
+		r.Logger.WithFields(map[string]interface{}{
+			column:    targetID,
+			"user_id": reaction.UserID,
+			"type":    reaction.Type,
+		}).WithError(err).Error("Failed to toggle reaction in database")
+		return false, nil, err
+	}
+	return reacted, counts, nil
+}
+
+// reactionCounterExpr возвращает выражение, изменяющее счётчик реакции типа reactionType на delta.
+// Счётчики, ставшие нулевыми, удаляются из JSON.
+func reactionCounterExpr(reactionType string, delta int) clause.Expr {
+	return gorm.Expr(`CASE WHEN COALESCE((reaction_counts->>?::text)::int, 0) + ? > 0
+		THEN jsonb_set(COALESCE(reaction_counts, '{}'::jsonb), ARRAY[?::text], to_jsonb(COALESCE((reaction_counts->>?::text)::int, 0) + ?))
+		ELSE COALESCE(reaction_counts, '{}'::jsonb) - ?::text END`,
+		reactionType, delta, reactionType, reactionType, delta, reactionType)
+}
@@ -0,0 +1,203 @@
+package repositories
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReadingListRepository предоставляет методы для работы с закладками и списками для чтения в БД.
+type ReadingListRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewReadingListRepository создаёт новый экземпляр ReadingListRepository.
+func NewReadingListRepository(db *gorm.DB, logger logger.Logger) *ReadingListRepository {
+	return &ReadingListRepository{DB: db, Logger: logger}
+}
+
+// savedArticleColumns — поля статьи, необходимые для краткого представления в закладках и списках.
+var savedArticleColumns = []string{"id", "author_id", "title", "plain_text", "published", "hidden", "reading_time", "created_at"}
+
+// preloadItems подгружает статьи списка по порядку вместе с краткими данными статей.
+// Статьи в корзине не подгружаются.
+func preloadItems(db *gorm.DB) *gorm.DB {
+	return db.Preload("Items", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position")
+	}).Preload("Items.Article", func(db *gorm.DB) *gorm.DB {
+		return db.Select(savedArticleColumns)
+	})
+}
+
+// AddBookmark добавляет статью в закладки пользователя. Повторное добавление ничего не меняет.
+func (r *ReadingListRepository) AddBookmark(userID, articleID uint) error {
+	bookmark := models.Bookmark{UserID: userID, ArticleID: articleID}
+	result := r.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&bookmark)
+	if result.Error != nil {
+		r.Logger.WithField("article_id", articleID).WithError(result.Error).Error("Failed to create bookmark in database")
+		return result.Error
+	}
+	return nil
+}
+
+// RemoveBookmark удаляет статью из закладок пользователя.
+func (r *ReadingListRepository) RemoveBookmark(userID, articleID uint) error {
+	result := r.DB.Where("user_id = ? AND article_id = ?", userID, articleID).Delete(&models.Bookmark{})
+	if result.Error != nil {
+		r.Logger.WithField("article_id", articleID).WithError(result.Error).Error("Failed to delete bookmark from database")
+		return result.Error
+	}
+	return nil
+}
+
+// GetBookmarks возвращает закладки пользователя, начиная с последних, с краткими данными статей.
+func (r *ReadingListRepository) GetBookmarks(userID uint) ([]*models.Bookmark, error) {
+	var bookmarks []*models.Bookmark
+	result := r.DB.Preload("Article", func(db *gorm.DB) *gorm.DB {
+		return db.Select(savedArticleColumns)
+	}).Where("user_id = ?", userID).Order("created_at DESC").Order("id DESC").Find(&bookmarks)
+	if result.Error != nil {
+		r.Logger.WithField("user_id", userID).WithError(result.Error).Error("Failed to fetch bookmarks from database")
+		return nil, result.Error
+	}
+	return bookmarks, nil
+}
+
+// GetBookmarkedIDs возвращает идентификаторы статей из articleIDs, сохранённых пользователем в закладки.
+func (r *ReadingListRepository) GetBookmarkedIDs(userID uint, articleIDs []uint) ([]uint, error) {
+	var ids []uint
+	result := r.DB.Model(&models.Bookmark{}).Where("user_id = ? AND article_id IN ?", userID, articleIDs).Pluck("article_id", &ids)
+	if result.Error != nil {
+		r.Logger.WithField("user_id", userID).WithError(result.Error).Error("Failed to fetch bookmarked article IDs from database")
+		return nil, result.Error
+	}
+	return ids, nil
+}
+
+// Create создаёт новый список для чтения.
+func (r *ReadingListRepository) Create(list *models.ReadingList) error {
+	result := r.DB.Omit("Items").Create(list)
+	if result.Error != nil {
+		r.Logger.WithField("user_id", list.UserID).WithError(result.Error).Error("Failed to create reading list in database")
+		return result.Error
+	}
+	return nil
+}
+
+// GetByUser возвращает списки для чтения пользователя вместе со статьями.
+func (r *ReadingListRepository) GetByUser(userID uint) ([]*models.ReadingList, error) {
+	var lists []*models.ReadingList
+	result := r.DB.Scopes(preloadItems).Where("user_id = ?", userID).Order("created_at").Order("id").Find(&lists)
+	if result.Error != nil {
+		r.Logger.WithField("user_id", userID).WithError(result.Error).Error("Failed to fetch reading lists from database")
+		return nil, result.Error
+	}
+	return lists, nil
+}
+
+// GetByID возвращает список для чтения по ID вместе со статьями.
+func (r *ReadingListRepository) GetByID(id uint) (*models.ReadingList, error) {
+	var list models.ReadingList
+	result := r.DB.Scopes(preloadItems).First(&list, id)
+	if result.Error != nil {
+		r.Logger.WithField("reading_list_id", id).WithError(result.Error).Error("Failed to fetch reading list by ID from database")
+		return nil, result.Error
+	}
+	return &list, nil
+}
+
+// GetByShareID возвращает список для чтения по идентификатору публичной ссылки.
+func (r *ReadingListRepository) GetByShareID(shareID string) (*models.ReadingList, error) {
+	var list models.ReadingList
+	result := r.DB.Scopes(preloadItems).Where("share_id = ?", shareID).First(&list)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &list, nil
+}
+
+// Update обновляет название, описание и видимость списка.
+func (r *ReadingListRepository) Update(list *models.ReadingList) error {
+	result := r.DB.Omit("Items").Save(list)
+	if result.Error != nil {
+		r.Logger.WithField("reading_list_id", list.ID).WithError(result.Error).Error("Failed to update reading list in database")
+		return result.Error
+	}
+	return nil
+}
+
+// Delete удаляет список для чтения; статьи списка не удаляются.
+func (r *ReadingListRepository) Delete(id uint) error {
+	result := r.DB.Delete(&models.ReadingList{}, id)
+	if result.Error != nil {
+		r.Logger.WithField("reading_list_id", id).WithError(result.Error).Error("Failed to delete reading list from database")
+		return result.Error
+	}
+	return nil
+}
+
+// AddItem добавляет статью в конец списка.
+func (r *ReadingListRepository) AddItem(listID, articleID uint) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var last int
+		if err := tx.Model(&models.ReadingListItem{}).Where("reading_list_id = ?", listID).
+			Select("COALESCE(MAX(position), 0)").Scan(&last).Error; err != nil {
+			return err
+		}
+		item := models.ReadingListItem{ReadingListID: listID, ArticleID: articleID, Position: last + 1}
+		return tx.Create(&item).Error
+	})
+	if err != nil {
+		r.Logger.WithFields(map[string]interface{}{
+			"reading_list_id": listID,
+			"article_id":      articleID,
+		}).WithError(err).Error("Failed to add article to reading list in database")
+		return err
+	}
+	return nil
+}
+
+// RemoveItem удаляет статью из списка и сдвигает следующие за ней статьи.
+func (r *ReadingListRepository) RemoveItem(listID, articleID uint) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var item models.ReadingListItem
+		if err := tx.Where("reading_list_id = ? AND article_id = ?", listID, articleID).First(&item).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&item).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.ReadingListItem{}).
+			Where("reading_list_id = ? AND position > ?", listID, item.Position).
+			UpdateColumn("position", gorm.Expr("position - 1")).Error
+	})
+	if err != nil {
+		r.Logger.WithFields(map[string]interface{}{
+			"reading_list_id": listID,
+			"article_id":      articleID,
+		}).WithError(err).Error("Failed to remove article from reading list in database")
+		return err
+	}
+	return nil
+}
+
+// ReorderItems задаёт порядок статей списка: статьи получают порядковые номера по порядку в articleIDs.
+func (r *ReadingListRepository) ReorderItems(listID uint, articleIDs []uint) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		for i, articleID := range articleIDs {
+			if err := tx.Model(&models.ReadingListItem{}).
+				Where("reading_list_id = ? AND article_id = ?", listID, articleID).
+				UpdateColumn("position", i+1).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		r.Logger.WithField("reading_list_id", listID).WithError(err).Error("Failed to reorder reading list in database")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package repositories
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReportTarget описывает статью или комментарий с открытыми жалобами.
+type ReportTarget struct {
+	ArticleID       *uint     // Идентификатор статьи (для жалоб на статью).
+	CommentID       *uint     // Идентификатор комментария (для жалоб на комментарий).
+	ReportCount     int64     // Количество открытых жалоб.
+	FirstReportedAt time.Time // Дата первой открытой жалобы.
+	LastReportedAt  time.Time // Дата последней открытой жалобы.
+}
+
+// ReportDecision описывает решение модератора по открытым жалобам на объект.
+type ReportDecision struct {
+	TargetType  string              // Тип объекта: article или comment.
+	TargetID    uint                // Идентификатор объекта.
+	Status      string              // Статус, в который переводятся жалобы.
+	Action      string              // Решение модератора.
+	ModeratorID uint                // ID модератора.
+	Hidden      bool                // Скрыт ли объект после решения.
+	Warning     *models.UserWarning // Предупреждение автору (nil, если не выносится).
+	BanUserID   uint                // ID блокируемого автора (0, если автор не блокируется).
+}
+
+// ReportRepository предоставляет методы для работы с жалобами в БД.
+type ReportRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewReportRepository создаёт новый экземпляр ReportRepository.
+func NewReportRepository(db *gorm.DB, logger logger.Logger) *ReportRepository {
+	return &ReportRepository{DB: db, Logger: logger}
+}
+
+// Create сохраняет жалобу. Если у пользователя уже есть открытая жалоба на тот же объект,
+// новая не создаётся и возвращается существующая с признаком false.
+func (r *ReportRepository) Create(report *models.Report) (*models.Report, bool, error) {
+	result := r.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(report)
+	if result.Error != nil {
+		r.Logger.WithField("reporter_id", report.ReporterID).WithError(result.Error).Error("Failed to create report in database")
+		return nil, false, result.Error
+	}
+	if result.RowsAffected > 0 {
+		return report, true, nil
+	}
+	var existing models.Report
+	if err := r.DB.Scopes(reportTargetScope(report.TargetType(), report.TargetID())).
+		Where("reporter_id = ? AND status = ?", report.ReporterID, models.ReportStatusOpen).
+		First(&existing).Error; err != nil {
+		r.Logger.WithField("reporter_id", report.ReporterID).WithError(err).Error("Failed to fetch existing report from database")
+		return nil, false, err
+	}
+	return &existing, false, nil
+}
+
+// CountOpen возвращает количество открытых жалоб на объект.
+func (r *ReportRepository) CountOpen(targetType string, targetID uint) (int64, error) {
+	var count int64
+	result := r.DB.Model(&models.Report{}).Scopes(reportTargetScope(targetType, targetID)).
+		Where("status = ?", models.ReportStatusOpen).
+		Count(&count)
+	if result.Error != nil {
+		r.Logger.WithFields(map[string]interface{}{
+			"target_type": targetType,
+			"target_id":   targetID,
+		}).WithError(result.Error).Error("Failed to count open reports in database")
+		return 0, result.Error
+	}
+	return count, nil
+}
+
+// GetOpenTargets возвращает объекты с открытыми жалобами, начиная с объектов с наибольшим
+// количеством жалоб, и общее количество таких объектов. targetType ограничивает выборку
+// статьями или комментариями (пустая строка — любые).
+func (r *ReportRepository) GetOpenTargets(targetType string, limit, offset int) ([]*ReportTarget, int64, error) {
+	query := r.DB.Model(&models.Report{}).
+		Select("article_id, comment_id, COUNT(*) AS report_count, MIN(created_at) AS first_reported_at, MAX(created_at) AS last_reported_at").
+		Where("status = ?", models.ReportStatusOpen).
+		Group("article_id, comment_id")
+	switch targetType {
+	case models.ReportTargetArticle:
+		query = query.Where("article_id IS NOT NULL")
+	case models.ReportTargetComment:
+		query = query.Where("comment_id IS NOT NULL")
+	}
+
+	var total int64
+	if err := r.DB.Table("(?) AS targets", query).Count(&total).Error; err != nil {
+		r.Logger.WithError(err).Error("Failed to count reported targets in database")
+		return nil, 0, err
+	}
+	var targets []*ReportTarget
+	if err := query.Order("report_count DESC").Order("first_reported_at ASC").
+		Limit(limit).Offset(offset).
+		Scan(&targets).Error; err != nil {
+		r.Logger.WithError(err).Error("Failed to fetch reported targets from database")
+		return nil, 0, err
+	}
+	return targets, total, nil
+}
+
+// GetOpenByTargets возвращает открытые жалобы на указанные статьи и комментарии, начиная со старых.
+func (r *ReportRepository) GetOpenByTargets(articleIDs, commentIDs []uint) ([]*models.Report, error) {
+	var reports []*models.Report
+	if len(articleIDs) == 0 && len(commentIDs) == 0 {
+		return reports, nil
+	}
+	result := r.DB.Where("status = ?", models.ReportStatusOpen).
+		Where(r.DB.Where("article_id IN ?", articleIDs).Or("comment_id IN ?", commentIDs)).
+		Order("created_at ASC").
+		Find(&reports)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to fetch open reports by targets from database")
+		return nil, result.Error
+	}
+	return reports, nil
+}
+
+// HiddenByModerator сообщает, скрыт ли объект решением модератора: по одной из закрытых жалоб
+// на объект принято решение hide, warn или ban. Такой объект не возвращается в выдачу при
+// отклонении новых жалоб, в отличие от скрытого только автоматически по открытым жалобам.
+func (r *ReportRepository) HiddenByModerator(targetType string, targetID uint) (bool, error) {
+	var count int64
+	result := r.DB.Model(&models.Report{}).Scopes(reportTargetScope(targetType, targetID)).
+		Where("status = ? AND action <> ?", models.ReportStatusResolved, models.ReportActionDismiss).
+		Count(&count)
+	if result.Error != nil {
+		r.Logger.WithFields(map[string]interface{}{
+			"target_type": targetType,
+			"target_id":   targetID,
+		}).WithError(result.Error).Error("Failed to count moderator decisions in database")
+		return false, result.Error
+	}
+	return count > 0, nil
+}
+
+// Resolve применяет решение модератора в одной транзакции: выносит автору предупреждение,
+// блокирует его и отзывает его refresh-токены, скрывает объект или возвращает его в выдачу
+// и закрывает все открытые жалобы на объект. Возвращает количество закрытых жалоб.
+func (r *ReportRepository) Resolve(decision ReportDecision) (int64, error) {
+	var resolved int64
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if decision.Warning != nil {
+			if err := tx.Create(decision.Warning).Error; err != nil {
+				return err
+			}
+		}
+		if decision.BanUserID != 0 {
+			if err := tx.Model(&models.User{}).Where("id = ? AND banned_at IS NULL", decision.BanUserID).
+				UpdateColumn("banned_at", time.Now()).Error; err != nil {
+				return err
+			}
+			if err := tx.Where("user_id = ?", decision.BanUserID).Delete(&models.RefreshToken{}).Error; err != nil {
+				return err
+			}
+		}
+		var target interface{} = &models.Article{}
+		if decision.TargetType == models.ReportTargetComment {
+			target = &models.Comment{}
+		}
+		if err := tx.Model(target).Where("id = ?", decision.TargetID).UpdateColumn("hidden", decision.Hidden).Error; err != nil {
+			return err
+		}
+		result := tx.Model(&models.Report{}).Scopes(reportTargetScope(decision.TargetType, decision.TargetID)).
+			Where("status = ?", models.ReportStatusOpen).
+			Updates(map[string]interface{}{
+				"status":      decision.Status,
+				"action":      decision.Action,
+				"resolved_by": decision.ModeratorID,
+				"resolved_at": time.Now(),
+			})
+		resolved = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		r.Logger.WithFields(map[string]interface{}{
+			"target_type": decision.TargetType,
+			"target_id":   decision.TargetID,
+		}).WithError(err).Error("Failed to resolve reports in database")
+		return 0, err
+	}
+	return resolved, nil
+}
+
+// CountWarnings возвращает количество предупреждений каждого из указанных пользователей.
+// Пользователи без предупреждений в результат не попадают.
+func (r *ReportRepository) CountWarnings(userIDs []uint) (map[uint]int64, error) {
+	counts := make(map[uint]int64, len(userIDs))
+	if len(userIDs) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		UserID uint
+		Count  int64
+	}
+	result := r.DB.Model(&models.UserWarning{}).
+		Select("user_id, COUNT(*) AS count").
+		Where("user_id IN ?", userIDs).
+		Group("user_id").
+		Scan(&rows)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to count user warnings in database")
+		return nil, result.Error
+	}
+	for _, row := range rows {
+		counts[row.UserID] = row.Count
+	}
+	return counts, nil
+}
+
+// reportTargetScope ограничивает выборку жалобами на указанный объект.
+func reportTargetScope(targetType string, targetID uint) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if targetType == models.ReportTargetComment {
+			return db.Where("comment_id = ?", targetID)
+		}
+		return db.Where("article_id = ?", targetID)
+	}
+}
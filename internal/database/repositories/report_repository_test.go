@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+)
+
+func TestDismissKeepsContentHiddenByModerator(t *testing.T) {
+	db, log := openTestDB(t)
+	repo := NewReportRepository(db, log)
+	article := createTestArticle(t, db)
+
+	// resolve открывает жалобу на статью и закрывает её решением action так же, как ReportService.Resolve.
+	resolve := func(action string) {
+		t.Helper()
+		report := &models.Report{ReporterID: article.AuthorID, ArticleID: &article.ID, Reason: "spam"}
+		if _, _, err := repo.Create(report); err != nil {
+			t.Fatalf("failed to create report: %v", err)
+		}
+		decision := ReportDecision{
+			TargetType: models.ReportTargetArticle,
+			TargetID:   article.ID,
+			Status:     models.ReportStatusResolved,
+			Action:     action,
+			Hidden:     true,
+		}
+		if action == models.ReportActionDismiss {
+			hidden, err := repo.HiddenByModerator(models.ReportTargetArticle, article.ID)
+			if err != nil {
+				t.Fatalf("HiddenByModerator() error = %v", err)
+			}
+			decision.Status = models.ReportStatusDismissed
+			decision.Hidden = hidden
+		}
+		if _, err := repo.Resolve(decision); err != nil {
+			t.Fatalf("failed to resolve reports: %v", err)
+		}
+	}
+	articleHidden := func() bool {
+		t.Helper()
+		var stored models.Article
+		if err := db.Select("hidden").First(&stored, article.ID).Error; err != nil {
+			t.Fatalf("failed to fetch article: %v", err)
+		}
+		return stored.Hidden
+	}
+
+	// Статья скрыта автоматически по открытым жалобам: отклонение жалоб возвращает её в выдачу
+	if err := db.Model(article).UpdateColumn("hidden", true).Error; err != nil {
+		t.Fatalf("failed to hide article: %v", err)
+	}
+	resolve(models.ReportActionDismiss)
+	if articleHidden() {
+		t.Error("article auto-hidden by dismissed reports stayed hidden")
+	}
+
+	// Статья скрыта модератором: отклонение новых жалоб её не возвращает
+	resolve(models.ReportActionHide)
+	resolve(models.ReportActionDismiss)
+	if !articleHidden() {
+		t.Error("dismissing new reports unhid an article hidden by a moderator")
+	}
+	if hidden, err := repo.HiddenByModerator(models.ReportTargetArticle, article.ID); err != nil || !hidden {
+		t.Errorf("HiddenByModerator() = %v, %v after hide and dismiss, want true", hidden, err)
+	}
+}
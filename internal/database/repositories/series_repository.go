@@ -0,0 +1,120 @@
+package repositories
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+)
+
+// SeriesRepository предоставляет методы для работы с сериями статей в БД.
+type SeriesRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewSeriesRepository создаёт новый экземпляр SeriesRepository.
+func NewSeriesRepository(db *gorm.DB, logger logger.Logger) *SeriesRepository {
+	return &SeriesRepository{DB: db, Logger: logger}
+}
+
+// preloadParts подгружает части серии по порядку вместе со статьями.
+func preloadParts(db *gorm.DB) *gorm.DB {
+	return db.Preload("Parts", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position")
+	}).Preload("Parts.Article")
+}
+
+// Create создаёт новую серию вместе с её частями.
+func (r *SeriesRepository) Create(series *models.Series) error {
+	result := r.DB.Create(series)
+	if result.Error != nil {
+		r.Logger.WithField("slug", series.Slug).WithError(result.Error).Error("Failed to create series in database")
+		return result.Error
+	}
+	return nil
+}
+
+// GetAll возвращает все серии с их частями, начиная с новых.
+func (r *SeriesRepository) GetAll() ([]*models.Series, error) {
+	var series []*models.Series
+	result := r.DB.Scopes(preloadParts).Order("created_at DESC").Find(&series)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to fetch series from database")
+		return nil, result.Error
+	}
+	return series, nil
+}
+
+// GetByID возвращает серию по ID вместе с частями.
+func (r *SeriesRepository) GetByID(id uint) (*models.Series, error) {
+	var series models.Series
+	result := r.DB.Scopes(preloadParts).First(&series, id)
+	if result.Error != nil {
+		r.Logger.WithField("series_id", id).WithError(result.Error).Error("Failed to fetch series by ID from database")
+		return nil, result.Error
+	}
+	return &series, nil
+}
+
+// GetBySlug возвращает серию по slug вместе с частями.
+func (r *SeriesRepository) GetBySlug(slug string) (*models.Series, error) {
+	var series models.Series
+	result := r.DB.Scopes(preloadParts).Where("slug = ?", slug).First(&series)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &series, nil
+}
+
+// GetPartsByArticleIDs возвращает записи о вхождении указанных статей в серии.
+func (r *SeriesRepository) GetPartsByArticleIDs(articleIDs []uint) ([]*models.SeriesPart, error) {
+	var parts []*models.SeriesPart
+	result := r.DB.Where("article_id IN ?", articleIDs).Find(&parts)
+	if result.Error != nil {
+		r.Logger.WithField("article_ids", articleIDs).WithError(result.Error).Error("Failed to fetch series parts from database")
+		return nil, result.Error
+	}
+	return parts, nil
+}
+
+// Update обновляет название, slug и описание серии.
+func (r *SeriesRepository) Update(series *models.Series) error {
+	result := r.DB.Omit("Parts").Save(series)
+	if result.Error != nil {
+		r.Logger.WithField("series_id", series.ID).WithError(result.Error).Error("Failed to update series in database")
+		return result.Error
+	}
+	return nil
+}
+
+// ReplaceParts заменяет состав серии: статьи получают порядковые номера по порядку в articleIDs.
+func (r *SeriesRepository) ReplaceParts(seriesID uint, articleIDs []uint) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("series_id = ?", seriesID).Delete(&models.SeriesPart{}).Error; err != nil {
+			return err
+		}
+		if len(articleIDs) == 0 {
+			return nil
+		}
+		parts := make([]models.SeriesPart, 0, len(articleIDs))
+		for i, articleID := range articleIDs {
+			parts = append(parts, models.SeriesPart{SeriesID: seriesID, ArticleID: articleID, Position: i + 1})
+		}
+		return tx.Create(&parts).Error
+	})
+	if err != nil {
+		r.Logger.WithField("series_id", seriesID).WithError(err).Error("Failed to replace series parts in database")
+		return err
+	}
+	return nil
+}
+
+// Delete удаляет серию; статьи остаются, удаляются только их привязки к серии.
+func (r *SeriesRepository) Delete(id uint) error {
+	result := r.DB.Delete(&models.Series{}, id)
+	if result.Error != nil {
+		r.Logger.WithField("series_id", id).WithError(result.Error).Error("Failed to delete series from database")
+		return result.Error
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"strings"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SpamRepository предоставляет методы для работы с обучающими данными спам-фильтра в БД.
+type SpamRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewSpamRepository создаёт новый экземпляр SpamRepository.
+func NewSpamRepository(db *gorm.DB, logger logger.Logger) *SpamRepository {
+	return &SpamRepository{DB: db, Logger: logger}
+}
+
+// GetTokenCounts возвращает статистику указанных токенов. Токены, которые ещё не встречались
+// в обучающих комментариях, в результат не попадают.
+func (r *SpamRepository) GetTokenCounts(tokens []string) (map[string]models.SpamToken, error) {
+	counts := make(map[string]models.SpamToken, len(tokens))
+	if len(tokens) == 0 {
+		return counts, nil
+	}
+	var rows []models.SpamToken
+	if err := r.DB.Where("token IN ?", tokens).Find(&rows).Error; err != nil {
+		r.Logger.WithError(err).Error("Failed to fetch spam token counts from database")
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.Token] = row
+	}
+	return counts, nil
+}
+
+// CountDocuments возвращает количество обучающих комментариев каждой метки.
+func (r *SpamRepository) CountDocuments() (spam int64, ham int64, err error) {
+	var rows []struct {
+		Label string
+		Count int64
+	}
+	if err := r.DB.Model(&models.SpamDocument{}).Select("label, COUNT(*) AS count").Group("label").Scan(&rows).Error; err != nil {
+		r.Logger.WithError(err).Error("Failed to count spam documents in database")
+		return 0, 0, err
+	}
+	for _, row := range rows {
+		switch row.Label {
+		case models.SpamLabelSpam:
+			spam = row.Count
+		case models.SpamLabelHam:
+			ham = row.Count
+		}
+	}
+	return spam, ham, nil
+}
+
+// Train в одной транзакции сохраняет обучающий комментарий и обновляет статистику его токенов.
+// Если комментарий уже был обучен с другой меткой, его прежние токены вычитаются из неё;
+// повторное обучение с той же меткой ничего не меняет.
+func (r *SpamRepository) Train(doc *models.SpamDocument) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var previous models.SpamDocument
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("comment_id = ?", doc.CommentID).Limit(1).Find(&previous)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected > 0 {
+			if previous.Label == doc.Label {
+				return nil
+			}
+			if err := adjustSpamTokens(tx, strings.Fields(previous.Tokens), previous.Label, -1); err != nil {
+				return err
+			}
+		}
+		if err := adjustSpamTokens(tx, strings.Fields(doc.Tokens), doc.Label, 1); err != nil {
+			return err
+		}
+		return tx.Save(doc).Error
+	})
+	if err != nil {
+		r.Logger.WithFields(map[string]interface{}{
+			"comment_id": doc.CommentID,
+			"label":      doc.Label,
+		}).WithError(err).Error("Failed to train spam filter in database")
+		return err
+	}
+	return nil
+}
+
+// adjustSpamTokens изменяет на delta счётчик метки label у указанных токенов.
+func adjustSpamTokens(tx *gorm.DB, tokens []string, label string, delta int) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	column := "ham_count"
+	if label == models.SpamLabelSpam {
+		column = "spam_count"
+	}
+	if delta < 0 {
+		return tx.Model(&models.SpamToken{}).Where("token IN ?", tokens).
+			UpdateColumn(column, gorm.Expr("GREATEST("+column+" + ?, 0)", delta)).Error
+	}
+	rows := make([]models.SpamToken, 0, len(tokens))
+	for _, token := range tokens {
+		row := models.SpamToken{Token: token}
+		if label == models.SpamLabelSpam {
+			row.SpamCount = delta
+		} else {
+			row.HamCount = delta
+		}
+		rows = append(rows, row)
+	}
+	return tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "token"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			column: gorm.Expr("spam_tokens." + column + " + EXCLUDED." + column),
+		}),
+	}).Create(&rows).Error
+}
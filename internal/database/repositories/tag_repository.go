@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"gorm.io/gorm"
+)
+
+// TagWithCount представляет тег вместе с количеством опубликованных статей.
+type TagWithCount struct {
+	models.Tag
+	ArticleCount int64
+}
+
+// TagRepository предоставляет методы для работы с тегами в базе данных.
+type TagRepository struct {
+	DB     *gorm.DB
+	Logger logger.Logger
+}
+
+// NewTagRepository создаёт новый экземпляр TagRepository.
+func NewTagRepository(db *gorm.DB, logger logger.Logger) *TagRepository {
+	return &TagRepository{DB: db, Logger: logger}
+}
+
+// Create создаёт новый тег в базе данных.
+func (r *TagRepository) Create(tag *models.Tag) error {
+	result := r.DB.Create(tag)
+	if result.Error != nil {
+		r.Logger.WithField("name", tag.Name).WithError(result.Error).Error("Failed to create tag in database")
+		return result.Error
+	}
+	return nil
+}
+
+// GetAllWithCounts возвращает все теги с количеством опубликованных статей по каждому.
+func (r *TagRepository) GetAllWithCounts() ([]*TagWithCount, error) {
+	var tags []*TagWithCount
+	result := r.DB.Model(&models.Tag{}).
+		Select("tags.*, COUNT(articles.id) AS article_count").
+		Joins("LEFT JOIN article_tags ON article_tags.tag_id = tags.id").
+		Joins("LEFT JOIN articles ON articles.id = article_tags.article_id AND articles.published = ? AND articles.hidden = ? AND articles.deleted_at IS NULL", true, false).
+		Group("tags.id").
+		Order("tags.name").
+		Scan(&tags)
+	if result.Error != nil {
+		r.Logger.WithError(result.Error).Error("Failed to fetch tags with counts from database")
+		return nil, result.Error
+	}
+	return tags, nil
+}
+
+// GetByID возвращает тег по его ID.
+func (r *TagRepository) GetByID(id uint) (*models.Tag, error) {
+	var tag models.Tag
+	result := r.DB.First(&tag, id)
+	if result.Error != nil {
+		r.Logger.WithField("tag_id", id).WithError(result.Error).Error("Failed to fetch tag by ID from database")
+		return nil, result.Error
+	}
+	return &tag, nil
+}
+
+// GetBySlug возвращает тег по его slug.
+func (r *TagRepository) GetBySlug(slug string) (*models.Tag, error) {
+	var tag models.Tag
+	result := r.DB.Where("slug = ?", slug).First(&tag)
+	if result.Error != nil {
+		r.Logger.WithField("slug", slug).WithError(result.Error).Warn("Failed to fetch tag by slug from database")
+		return nil, result.Error
+	}
+	return &tag, nil
+}
+
+// GetByName возвращает тег по его названию.
+func (r *TagRepository) GetByName(name string) (*models.Tag, error) {
+	var tag models.Tag
+	result := r.DB.Where("name = ?", name).First(&tag)
+	if result.Error != nil {
+		r.Logger.WithField("name", name).WithError(result.Error).Warn("Failed to fetch tag by name from database")
+		return nil, result.Error
+	}
+	return &tag, nil
+}
+
+// GetByIDs возвращает теги с указанными идентификаторами.
+func (r *TagRepository) GetByIDs(ids []uint) ([]models.Tag, error) {
+	var tags []models.Tag
+	result := r.DB.Where("id IN ?", ids).Find(&tags)
+	if result.Error != nil {
+		r.Logger.WithField("tag_ids", ids).WithError(result.Error).Error("Failed to fetch tags by IDs from database")
+		return nil, result.Error
+	}
+	return tags, nil
+}
+
+// Autocomplete возвращает теги, название которых начинается с указанного префикса.
+func (r *TagRepository) Autocomplete(prefix string, limit int) ([]*models.Tag, error) {
+	var tags []*models.Tag
+	result := r.DB.Where("name ILIKE ?", escapeLike(prefix)+"%").Order("name").Limit(limit).Find(&tags)
+	if result.Error != nil {
+		r.Logger.WithField("prefix", prefix).WithError(result.Error).Error("Failed to autocomplete tags from database")
+		return nil, result.Error
+	}
+	return tags, nil
+}
+
+// Update обновляет тег в базе данных.
+func (r *TagRepository) Update(tag *models.Tag) error {
+	result := r.DB.Save(tag)
+	if result.Error != nil {
+		r.Logger.WithField("tag_id", tag.ID).WithError(result.Error).Error("Failed to update tag in database")
+		return result.Error
+	}
+	return nil
+}
+
+// Delete удаляет тег и его связи со статьями.
+func (r *TagRepository) Delete(id uint) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Tag{ID: id}).Association("Articles").Clear(); err != nil {
+			return err
+		}
+		return tx.Delete(&models.Tag{}, id).Error
+	})
+	if err != nil {
+		r.Logger.WithField("tag_id", id).WithError(err).Error("Failed to delete tag from database")
+		return err
+	}
+	return nil
+}
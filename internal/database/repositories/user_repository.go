@@ -57,6 +57,28 @@ func (r *UserRepository) GetByID(id uint) (*models.User, error) {
 	return &user, nil
 }
 
+// GetByIDs возвращает пользователей с указанными ID.
+func (r *UserRepository) GetByIDs(ids []uint) ([]*models.User, error) {
+	var users []*models.User
+	result := r.DB.Where("id IN ?", ids).Find(&users)
+	if result.Error != nil {
+		r.Logger.WithField("user_ids", ids).WithError(result.Error).Error("Failed to fetch users by IDs from database")
+		return nil, result.Error
+	}
+	return users, nil
+}
+
+// GetByUsernames возвращает пользователей с указанными именами без учёта регистра.
+func (r *UserRepository) GetByUsernames(usernames []string) ([]*models.User, error) {
+	var users []*models.User
+	result := r.DB.Where("LOWER(username) IN ?", usernames).Find(&users)
+	if result.Error != nil {
+		r.Logger.WithField("usernames", usernames).WithError(result.Error).Error("Failed to fetch users by usernames from database")
+		return nil, result.Error
+	}
+	return users, nil
+}
+
 // GetByEmail возвращает пользователя по его email.
 func (r *UserRepository) GetByEmail(email string) (*models.User, error) {
 	var user models.User
@@ -68,6 +90,21 @@ func (r *UserRepository) GetByEmail(email string) (*models.User, error) {
 	return &user, nil
 }
 
+// ExistsWithEmailOrUsername сообщает, занят ли email или имя пользователя.
+// Учитываются и удалённые пользователи: их email и имя остаются в уникальных индексах.
+func (r *UserRepository) ExistsWithEmailOrUsername(email, username string) (bool, error) {
+	var count int64
+	result := r.DB.Unscoped().Model(&models.User{}).Where("email = ? OR username = ?", email, username).Count(&count)
+	if result.Error != nil {
+		r.Logger.WithFields(map[string]interface{}{
+			"username": username,
+			"email":    email,
+		}).WithError(result.Error).Error("Failed to check user existence in database")
+		return false, result.Error
+	}
+	return count > 0, nil
+}
+
 // GetRoleByName возвращает роль по её имени.
 func (r *UserRepository) GetRoleByName(roleName string) (*models.Role, error) {
 	var role models.Role
@@ -105,6 +142,18 @@ func (r *UserRepository) UpdateRole(userID uint, roleName string) error {
 	return nil
 }
 
+// IsBanned сообщает, заблокирован ли пользователь. Для несуществующего пользователя
+// возвращает gorm.ErrRecordNotFound.
+func (r *UserRepository) IsBanned(id uint) (bool, error) {
+	var user models.User
+	result := r.DB.Select("id", "banned_at").First(&user, id)
+	if result.Error != nil {
+		r.Logger.WithField("user_id", id).WithError(result.Error).Warn("Failed to check user ban in database")
+		return false, result.Error
+	}
+	return user.BannedAt != nil, nil
+}
+
 // Delete удаляет пользователя из БД.
 func (r *UserRepository) Delete(id uint) error {
 	result := r.DB.Delete(&models.User{}, id)
@@ -15,6 +15,7 @@ func Seed(db *gorm.DB) error {
 		{Name: "author", Description: "Может писать и управлять своими статьями"},
 		{Name: "moderator", Description: "Может удалять и редактировать комментарии и статьи"},
 		{Name: "user", Description: "Чтение статей и оставление комментариев"},
+		{Name: "editor", Description: "Управляет тегами и рубриками"},
 	}
 
 	for _, role := range roles {
@@ -48,6 +49,11 @@ func Seed(db *gorm.DB) error {
 			Email:    "guest@example.com",
 			RoleID:   4,
 		},
+		{
+			Username: "emma_editor",
+			Email:    "emma@example.com",
+			RoleID:   5,
+		},
 	}
 
 	// Хэширование паролей
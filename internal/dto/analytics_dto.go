@@ -0,0 +1,50 @@
+package dto
+
+// AnalyticsQuery представляет параметры отчёта по просмотрам.
+type AnalyticsQuery struct {
+	From  string `form:"from" binding:"omitempty,datetime=2006-01-02"` // Начало периода (YYYY-MM-DD, по умолчанию — 29 дней до конца периода).
+	To    string `form:"to" binding:"omitempty,datetime=2006-01-02"`   // Конец периода включительно (YYYY-MM-DD, по умолчанию — сегодня по UTC).
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=100"`      // Количество строк отчёта (по умолчанию 10).
+}
+
+// ArticleViewsResponse представляет просмотры статьи по дням.
+type ArticleViewsResponse struct {
+	ArticleID uint            `json:"article_id"` // Идентификатор статьи.
+	From      string          `json:"from"`       // Начало периода.
+	To        string          `json:"to"`         // Конец периода.
+	Total     int             `json:"total"`      // Всего уникальных просмотров за период.
+	Days      []DailyViewsDTO `json:"days"`       // Просмотры по дням.
+}
+
+// DailyViewsDTO представляет количество уникальных просмотров за сутки.
+type DailyViewsDTO struct {
+	Date  string `json:"date"`  // Дата (UTC).
+	Views int    `json:"views"` // Количество уникальных просмотров.
+}
+
+// TopArticlesResponse представляет самые просматриваемые статьи за период.
+type TopArticlesResponse struct {
+	From     string          `json:"from"`     // Начало периода.
+	To       string          `json:"to"`       // Конец периода.
+	Articles []TopArticleDTO `json:"articles"` // Статьи по убыванию просмотров.
+}
+
+// TopArticleDTO представляет статью с количеством просмотров за период.
+type TopArticleDTO struct {
+	ArticleID uint   `json:"article_id"` // Идентификатор статьи.
+	Title     string `json:"title"`      // Заголовок статьи.
+	Views     int64  `json:"views"`      // Количество уникальных просмотров.
+}
+
+// ReferrersResponse представляет источники перехода за период.
+type ReferrersResponse struct {
+	From      string        `json:"from"`      // Начало периода.
+	To        string        `json:"to"`        // Конец периода.
+	Referrers []ReferrerDTO `json:"referrers"` // Источники по убыванию просмотров.
+}
+
+// ReferrerDTO представляет источник перехода с количеством просмотров.
+type ReferrerDTO struct {
+	Referrer string `json:"referrer"` // Домен источника или "direct" для прямых заходов.
+	Views    int64  `json:"views"`    // Количество уникальных просмотров.
+}
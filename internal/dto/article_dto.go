@@ -1,23 +1,108 @@
 package dto
 
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/blocks"
+	"github.com/AsterOzlob/content_managment_api/internal/stats"
+)
+
 // ArticleInput представляет входные данные для создания или обновления контента.
 type ArticleInput struct {
-	Title     string `json:"title" binding:"required"` // Заголовок контента
-	Text      string `json:"text" binding:"required"`  // Текст контента
-	Published bool   `json:"published"`                // Опубликован ли контент
+	Title               string          `json:"title" binding:"required"`                                                         // Заголовок контента
+	Text                string          `json:"text" binding:"required_without=Blocks"`                                           // Текст контента в формате Markdown
+	Blocks              blocks.Document `json:"blocks,omitempty"`                                                                 // Контент в виде блоков (имеет приоритет над text)
+	Published           bool            `json:"published"`                                                                        // Опубликован ли контент
+	Locale              string          `json:"locale,omitempty" binding:"omitempty,max=8"`                                       // Локаль исходного текста (по умолчанию — локаль по умолчанию)
+	CategoryID          *uint           `json:"category_id,omitempty"`                                                            // ID основной рубрики (опционально)
+	TagIDs              []uint          `json:"tag_ids,omitempty"`                                                                // ID тегов (опционально)
+	MetaTitle           string          `json:"meta_title,omitempty" binding:"omitempty,max=70"`                                  // SEO-заголовок (по умолчанию — заголовок статьи)
+	MetaDescription     string          `json:"meta_description,omitempty" binding:"omitempty,max=160"`                           // SEO-описание (по умолчанию — начало текста)
+	CanonicalURL        string          `json:"canonical_url,omitempty" binding:"omitempty,max=2048,http_url"`                    // Канонический URL (по умолчанию — адрес статьи)
+	OGImageID           *uint           `json:"og_image_id,omitempty"`                                                            // ID изображения Open Graph (по умолчанию — первое изображение статьи)
+	NoIndex             bool            `json:"no_index"`                                                                         // Запретить индексацию поисковиками
+	Keywords            []string        `json:"keywords,omitempty" binding:"omitempty,max=20,dive,min=1,max=50,excludesall=0x2C"` // Ключевые слова (по умолчанию — названия тегов)
+	PremoderateComments *bool           `json:"premoderate_comments,omitempty"`                                                   // Премодерация комментариев новых пользователей (по умолчанию — глобальная настройка)
+}
+
+// ArticleListQuery представляет параметры фильтрации списка статей.
+type ArticleListQuery struct {
+	Tag      string `form:"tag"`      // Slug тега.
+	Category string `form:"category"` // Slug рубрики (включая вложенные рубрики).
+	Author   uint   `form:"author"`   // ID автора.
+
+	MinWords       int    `form:"min_words" binding:"omitempty,min=0"`                                // Минимальное количество слов.
+	MaxWords       int    `form:"max_words" binding:"omitempty,min=0,gtefield=MinWords"`              // Максимальное количество слов.
+	MinReadingTime int    `form:"min_reading_time" binding:"omitempty,min=0"`                         // Минимальное время чтения в минутах.
+	MaxReadingTime int    `form:"max_reading_time" binding:"omitempty,min=0,gtefield=MinReadingTime"` // Максимальное время чтения в минутах.
+	Sort           string `form:"sort" binding:"omitempty,oneof=newest oldest shortest longest"`      // Порядок сортировки (по умолчанию newest).
 }
 
 // ArticleResponse представляет ответ с данными контента.
 type ArticleResponse struct {
-	ID        uint         `json:"id"`         // Уникальный идентификатор контента.
-	AuthorID  uint         `json:"author_id"`  // Идентификатор автора.
-	Title     string       `json:"title"`      // Заголовок контента.
-	Text      string       `json:"text"`       // Текст контента.
-	Published bool         `json:"published"`  // Опубликован ли контент.
-	CreatedAt string       `json:"created_at"` // Дата создания.
-	UpdatedAt string       `json:"updated_at"` // Дата обновления.
-	Media     []MediaDTO   `json:"media"`      // Прикрепленные медиафайлы.
-	Comments  []CommentDTO `json:"comments"`   // Комментарии к контенту.
+	ID                  uint                 `json:"id"`                   // Уникальный идентификатор контента.
+	AuthorID            uint                 `json:"author_id"`            // Идентификатор автора.
+	Title               string               `json:"title"`                // Заголовок контента.
+	TextMarkdown        string               `json:"text_markdown"`        // Исходный текст контента в формате Markdown.
+	Blocks              []blocks.Block       `json:"blocks"`               // Структурированный контент в виде блоков.
+	TextHTML            string               `json:"text_html"`            // Текст контента, отрендеренный в безопасный HTML.
+	Published           bool                 `json:"published"`            // Опубликован ли контент.
+	Locale              string               `json:"locale"`               // Локаль возвращённого текста.
+	AvailableLocales    []string             `json:"available_locales"`    // Локали, на которых доступен контент.
+	Category            *CategoryDTO         `json:"category"`             // Основная рубрика.
+	Tags                []TagDTO             `json:"tags"`                 // Теги.
+	CreatedAt           string               `json:"created_at"`           // Дата создания.
+	UpdatedAt           string               `json:"updated_at"`           // Дата обновления.
+	Media               []MediaDTO           `json:"media"`                // Прикрепленные медиафайлы.
+	Comments            []CommentDTO         `json:"comments"`             // Комментарии к контенту.
+	SEO                 ArticleSEO           `json:"seo"`                  // SEO-метаданные с учётом значений по умолчанию.
+	Series              *SeriesNavigationDTO `json:"series"`               // Навигация по серии, если статья входит в серию.
+	Stats               ContentStats         `json:"stats"`                // Статистика текста на возвращённой локали.
+	Reactions           map[string]int       `json:"reactions"`            // Количество реакций по типам.
+	Saved               bool                 `json:"saved"`                // Сохранена ли статья в закладки текущего пользователя.
+	Hidden              bool                 `json:"hidden"`               // Скрыта ли статья модератором по жалобам.
+	PremoderateComments *bool                `json:"premoderate_comments"` // Премодерация комментариев новых пользователей (null — глобальная настройка).
+}
+
+// ContentStats представляет статистику текста, вычисленную при сохранении.
+type ContentStats struct {
+	WordCount   int             `json:"word_count"`   // Количество слов.
+	CharCount   int             `json:"char_count"`   // Количество символов с пробелами.
+	ReadingTime int             `json:"reading_time"` // Оценка времени чтения в минутах с учётом языка.
+	ImageCount  int             `json:"image_count"`  // Количество изображений.
+	Outline     []stats.Heading `json:"outline"`      // Оглавление по заголовкам.
+}
+
+// ArticleSEO представляет SEO- и Open Graph-метаданные статьи.
+// Незаполненные автором поля выводятся из содержимого статьи.
+type ArticleSEO struct {
+	MetaTitle       string   `json:"meta_title"`       // SEO-заголовок.
+	MetaDescription string   `json:"meta_description"` // SEO-описание.
+	CanonicalURL    string   `json:"canonical_url"`    // Канонический URL.
+	OGImageID       *uint    `json:"og_image_id"`      // ID изображения Open Graph.
+	OGImageURL      string   `json:"og_image_url"`     // Абсолютный URL изображения Open Graph.
+	NoIndex         bool     `json:"no_index"`         // Запрещена ли индексация.
+	Keywords        []string `json:"keywords"`         // Ключевые слова.
+}
+
+// PreviewCardResponse представляет данные социальной карточки статьи.
+type PreviewCardResponse struct {
+	Type          string            `json:"type"`           // Тип объекта Open Graph ("article").
+	SiteName      string            `json:"site_name"`      // Название сайта.
+	Title         string            `json:"title"`          // Заголовок карточки.
+	Description   string            `json:"description"`    // Описание карточки.
+	URL           string            `json:"url"`            // Канонический URL статьи.
+	Locale        string            `json:"locale"`         // Локаль текста.
+	Image         *PreviewCardImage `json:"image"`          // Изображение карточки.
+	PublishedTime string            `json:"published_time"` // Дата публикации.
+	ModifiedTime  string            `json:"modified_time"`  // Дата последнего изменения.
+	Tags          []string          `json:"tags"`           // Названия тегов.
+	NoIndex       bool              `json:"no_index"`       // Запрещена ли индексация.
+}
+
+// PreviewCardImage представляет изображение социальной карточки.
+type PreviewCardImage struct {
+	URL  string `json:"url"`  // Абсолютный URL изображения.
+	Type string `json:"type"` // MIME-тип изображения.
+	Alt  string `json:"alt"`  // Альтернативный текст.
 }
 
 // MediaDTO представляет данные медиафайла.
@@ -30,7 +115,8 @@ type MediaDTO struct {
 
 // CommentDTO представляет данные комментария.
 type CommentDTO struct {
-	ID       uint   `json:"id"`        // Уникальный идентификатор комментария.
-	ParentID *uint  `json:"parent_id"` // Идентификатор родительского комментария.
-	Text     string `json:"text"`      // Текст комментария.
+	ID        uint           `json:"id"`        // Уникальный идентификатор комментария.
+	ParentID  *uint          `json:"parent_id"` // Идентификатор родительского комментария.
+	Text      string         `json:"text"`      // Текст комментария.
+	Reactions map[string]int `json:"reactions"` // Количество реакций по типам.
 }
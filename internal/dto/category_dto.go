@@ -0,0 +1,28 @@
+package dto
+
+// CategoryInput представляет входные данные для создания или обновления рубрики.
+type CategoryInput struct {
+	ParentID    *uint  `json:"parent_id,omitempty"`              // ID родительской рубрики (опционально).
+	Name        string `json:"name" binding:"required,max=128"`  // Название рубрики.
+	Slug        string `json:"slug" binding:"omitempty,max=128"` // Slug рубрики (по умолчанию формируется из названия).
+	Description string `json:"description"`                      // Описание рубрики.
+}
+
+// CategoryResponse представляет ответ с данными рубрики.
+type CategoryResponse struct {
+	ID          uint               `json:"id"`                 // Уникальный идентификатор рубрики.
+	ParentID    *uint              `json:"parent_id"`          // ID родительской рубрики.
+	Name        string             `json:"name"`               // Название рубрики.
+	Slug        string             `json:"slug"`               // Slug рубрики.
+	Description string             `json:"description"`        // Описание рубрики.
+	CreatedAt   string             `json:"created_at"`         // Дата создания.
+	UpdatedAt   string             `json:"updated_at"`         // Дата обновления.
+	Children    []CategoryResponse `json:"children,omitempty"` // Дочерние рубрики.
+}
+
+// CategoryDTO представляет краткие данные рубрики в составе статьи.
+type CategoryDTO struct {
+	ID   uint   `json:"id"`   // Уникальный идентификатор рубрики.
+	Name string `json:"name"` // Название рубрики.
+	Slug string `json:"slug"` // Slug рубрики.
+}
@@ -10,12 +10,98 @@ type CommentInput struct {
 
 // CommentResponse представляет ответ с данными комментария.
 type CommentResponse struct {
-	ID        uint              `json:"id"`                // Уникальный идентификатор комментария.
-	ParentID  *uint             `json:"parent_id"`         // ID родительского комментария (если есть).
-	ArticleID uint              `json:"article_id"`        // ID статьи.
-	AuthorID  uint              `json:"author_id"`         // ID автора.
-	Text      string            `json:"text"`              // Текст комментария.
-	CreatedAt time.Time         `json:"created_at"`        // Дата создания комментария.
-	UpdatedAt time.Time         `json:"updated_at"`        // Дата последнего обновления комментария.
-	Replies   []CommentResponse `json:"replies,omitempty"` // Вложенные комментарии.
+	ID         uint              `json:"id"`                // Уникальный идентификатор комментария.
+	ParentID   *uint             `json:"parent_id"`         // ID родительского комментария (если есть).
+	ArticleID  uint              `json:"article_id"`        // ID статьи.
+	AuthorID   uint              `json:"author_id"`         // ID автора.
+	Text       string            `json:"text"`              // Текст комментария.
+	Status     string            `json:"status"`            // Статус модерации: pending, approved, rejected или spam.
+	Hidden     bool              `json:"hidden"`            // Скрыт ли комментарий по жалобам.
+	Locked     bool              `json:"locked"`            // Закрыта ли ветка под комментарием для новых ответов.
+	Deleted    bool              `json:"deleted"`           // Удалён ли комментарий с сохранением ответов (автор и текст стёрты).
+	CreatedAt  time.Time         `json:"created_at"`        // Дата создания комментария.
+	UpdatedAt  time.Time         `json:"updated_at"`        // Дата последнего обновления комментария.
+	Edited     bool              `json:"edited"`            // Редактировался ли текст комментария.
+	EditedAt   *time.Time        `json:"edited_at"`         // Дата последнего редактирования текста.
+	Reactions  map[string]int    `json:"reactions"`         // Количество реакций по типам.
+	Mentions   []MentionDTO      `json:"mentions"`          // Пользователи, упомянутые в тексте через @username.
+	ReplyCount int               `json:"reply_count"`       // Количество прямых ответов; если ответов больше, чем в replies, их можно подгрузить через /comments/{id}/replies.
+	Replies    []CommentResponse `json:"replies,omitempty"` // Вложенные комментарии.
+}
+
+// MentionDTO представляет пользователя, упомянутого в комментарии.
+type MentionDTO struct {
+	UserID   uint   `json:"user_id"`  // ID пользователя.
+	Username string `json:"username"` // Имя пользователя.
+}
+
+// CommentThreadQuery представляет параметры загрузки веток комментариев.
+type CommentThreadQuery struct {
+	Sort string `form:"sort" binding:"omitempty,oneof=newest oldest top"` // Порядок сортировки на каждом уровне (по умолчанию newest).
+}
+
+// CommentThreadResponse представляет страницу веток комментариев.
+type CommentThreadResponse struct {
+	Total    int64             `json:"total"`    // Всего комментариев на запрошенном уровне.
+	Comments []CommentResponse `json:"comments"` // Комментарии с вложенными ответами.
+}
+
+// CommentModerationQuery представляет параметры очереди модерации комментариев.
+type CommentModerationQuery struct {
+	Status string `form:"status" binding:"omitempty,oneof=pending approved rejected spam"` // Статус комментариев (по умолчанию pending).
+}
+
+// CommentModerationQueueResponse представляет страницу очереди модерации.
+type CommentModerationQueueResponse struct {
+	Total    int64                      `json:"total"`    // Всего комментариев в статусе.
+	Comments []ModeratedCommentResponse `json:"comments"` // Комментарии, начиная с самых старых.
+}
+
+// ModeratedCommentResponse представляет комментарий в очереди модерации вместе с оценкой спам-фильтра.
+type ModeratedCommentResponse struct {
+	CommentResponse
+	SpamScore   float64  `json:"spam_score"`   // Оценка спам-фильтра от 0 до 1.
+	SpamReasons []string `json:"spam_reasons"` // Сработавшие признаки спама.
+}
+
+// CommentModerationInput представляет входные данные массовой модерации комментариев.
+type CommentModerationInput struct {
+	IDs []uint `json:"ids" binding:"required,min=1,max=100"` // ID комментариев.
+}
+
+// CommentModerationResponse представляет результат массовой модерации комментариев.
+type CommentModerationResponse struct {
+	Status  string `json:"status"`  // Установленный статус.
+	Updated []uint `json:"updated"` // ID изменённых комментариев.
+}
+
+// CommentRemovalInput представляет входные данные удаления ветки комментариев модератором.
+type CommentRemovalInput struct {
+	Reason string `json:"reason" binding:"required,max=1000"` // Причина удаления для журнала.
+}
+
+// CommentRemovalResponse представляет запись журнала удаления ветки комментариев.
+type CommentRemovalResponse struct {
+	ID           uint      `json:"id"`            // Уникальный идентификатор записи.
+	CommentID    uint      `json:"comment_id"`    // ID удалённого корневого комментария.
+	ArticleID    uint      `json:"article_id"`    // ID статьи.
+	AuthorID     uint      `json:"author_id"`     // ID автора корневого комментария.
+	ModeratorID  uint      `json:"moderator_id"`  // ID модератора.
+	Reason       string    `json:"reason"`        // Причина удаления.
+	RemovedCount int       `json:"removed_count"` // Количество удалённых комментариев.
+	CreatedAt    time.Time `json:"created_at"`    // Дата удаления.
+}
+
+// CommentRemovalListResponse представляет страницу журнала удалений веток комментариев.
+type CommentRemovalListResponse struct {
+	Total    int64                    `json:"total"`    // Всего записей.
+	Removals []CommentRemovalResponse `json:"removals"` // Записи, начиная с недавних.
+}
+
+// CommentRevisionResponse представляет прежнюю версию текста комментария.
+type CommentRevisionResponse struct {
+	ID        uint      `json:"id"`         // Уникальный идентификатор версии.
+	EditorID  uint      `json:"editor_id"`  // ID пользователя, заменившего этот текст.
+	Text      string    `json:"text"`       // Текст до редактирования.
+	CreatedAt time.Time `json:"created_at"` // Дата редактирования.
 }
@@ -0,0 +1,22 @@
+package dto
+
+import "github.com/AsterOzlob/content_managment_api/internal/schema"
+
+// ContentTypeInput представляет входные данные для создания или обновления типа контента.
+type ContentTypeInput struct {
+	Name        string        `json:"name" binding:"required,max=128"`  // Название типа контента.
+	Slug        string        `json:"slug" binding:"omitempty,max=128"` // Slug типа (по умолчанию формируется из названия).
+	Description string        `json:"description"`                      // Описание типа контента.
+	Fields      schema.Fields `json:"fields" binding:"required,min=1"`  // Определения полей.
+}
+
+// ContentTypeResponse представляет ответ с данными типа контента.
+type ContentTypeResponse struct {
+	ID          uint           `json:"id"`          // Уникальный идентификатор типа контента.
+	Name        string         `json:"name"`        // Название типа контента.
+	Slug        string         `json:"slug"`        // Slug типа контента.
+	Description string         `json:"description"` // Описание типа контента.
+	Fields      []schema.Field `json:"fields"`      // Определения полей.
+	CreatedAt   string         `json:"created_at"`  // Дата создания.
+	UpdatedAt   string         `json:"updated_at"`  // Дата обновления.
+}
@@ -0,0 +1,18 @@
+package dto
+
+// EntryInput представляет входные данные для создания или обновления записи типа контента.
+type EntryInput struct {
+	Data      map[string]interface{} `json:"data" binding:"required"` // Значения полей записи.
+	Published bool                   `json:"published"`               // Опубликована ли запись.
+}
+
+// EntryResponse представляет ответ с данными записи типа контента.
+type EntryResponse struct {
+	ID          uint                   `json:"id"`           // Уникальный идентификатор записи.
+	ContentType string                 `json:"content_type"` // Slug типа контента.
+	AuthorID    uint                   `json:"author_id"`    // Идентификатор автора.
+	Data        map[string]interface{} `json:"data"`         // Значения полей записи.
+	Published   bool                   `json:"published"`    // Опубликована ли запись.
+	CreatedAt   string                 `json:"created_at"`   // Дата создания.
+	UpdatedAt   string                 `json:"updated_at"`   // Дата обновления.
+}
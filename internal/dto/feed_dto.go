@@ -0,0 +1,8 @@
+package dto
+
+// FeedQuery представляет параметры выборки статей для ленты.
+type FeedQuery struct {
+	AuthorID uint   `form:"author"`   // ID автора.
+	Tag      string `form:"tag"`      // Slug тега.
+	Category string `form:"category"` // Slug рубрики (включая вложенные рубрики).
+}
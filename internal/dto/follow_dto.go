@@ -0,0 +1,34 @@
+package dto
+
+// FollowStatusResponse представляет состояние подписки на автора или тег.
+type FollowStatusResponse struct {
+	ID        uint `json:"id"`        // Идентификатор автора или тега.
+	Following bool `json:"following"` // Подписан ли текущий пользователь.
+}
+
+// FollowingResponse представляет авторов и теги, на которые подписан пользователь.
+type FollowingResponse struct {
+	Authors []FollowedAuthorDTO `json:"authors"` // Авторы, начиная с последних подписок.
+	Tags    []FollowedTagDTO    `json:"tags"`    // Теги, начиная с последних подписок.
+}
+
+// FollowedAuthorDTO представляет автора, на которого подписан пользователь.
+type FollowedAuthorDTO struct {
+	ID         uint   `json:"id"`          // Идентификатор автора.
+	Username   string `json:"username"`    // Имя автора.
+	FollowedAt string `json:"followed_at"` // Дата подписки.
+}
+
+// FollowedTagDTO представляет тег, на который подписан пользователь.
+type FollowedTagDTO struct {
+	ID         uint   `json:"id"`          // Идентификатор тега.
+	Name       string `json:"name"`        // Название тега.
+	Slug       string `json:"slug"`        // Slug тега.
+	FollowedAt string `json:"followed_at"` // Дата подписки.
+}
+
+// PersonalFeedResponse представляет страницу персональной ленты.
+type PersonalFeedResponse struct {
+	Articles   []*ArticleResponse `json:"articles"`              // Статьи от новых к старым.
+	NextCursor string             `json:"next_cursor,omitempty"` // Курсор следующей страницы; отсутствует на последней странице.
+}
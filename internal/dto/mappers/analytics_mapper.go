@@ -0,0 +1,58 @@
+package mappers
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+)
+
+// analyticsDateLayout — формат дат в отчётах по просмотрам.
+const analyticsDateLayout = "2006-01-02"
+
+// directReferrer — обозначение прямых заходов в отчёте по источникам.
+const directReferrer = "direct"
+
+// MapToArticleViewsResponse преобразует просмотры статьи по дням в DTO.
+func MapToArticleViewsResponse(views *services.ArticleViews) *dto.ArticleViewsResponse {
+	days := make([]dto.DailyViewsDTO, 0, len(views.Days))
+	for _, day := range views.Days {
+		days = append(days, dto.DailyViewsDTO{Date: day.Day.Format(analyticsDateLayout), Views: day.Views})
+	}
+	return &dto.ArticleViewsResponse{
+		ArticleID: views.ArticleID,
+		From:      views.Period.From.Format(analyticsDateLayout),
+		To:        views.Period.To.Format(analyticsDateLayout),
+		Total:     views.Total,
+		Days:      days,
+	}
+}
+
+// MapToTopArticlesResponse преобразует самые просматриваемые статьи в DTO.
+func MapToTopArticlesResponse(period services.Period, top []*repositories.ArticleViewTotal) *dto.TopArticlesResponse {
+	articles := make([]dto.TopArticleDTO, 0, len(top))
+	for _, total := range top {
+		articles = append(articles, dto.TopArticleDTO{ArticleID: total.ArticleID, Title: total.Title, Views: total.Views})
+	}
+	return &dto.TopArticlesResponse{
+		From:     period.From.Format(analyticsDateLayout),
+		To:       period.To.Format(analyticsDateLayout),
+		Articles: articles,
+	}
+}
+
+// MapToReferrersResponse преобразует источники перехода в DTO.
+func MapToReferrersResponse(period services.Period, totals []*repositories.ReferrerTotal) *dto.ReferrersResponse {
+	referrers := make([]dto.ReferrerDTO, 0, len(totals))
+	for _, total := range totals {
+		referrer := total.Referrer
+		if referrer == "" {
+			referrer = directReferrer
+		}
+		referrers = append(referrers, dto.ReferrerDTO{Referrer: referrer, Views: total.Views})
+	}
+	return &dto.ReferrersResponse{
+		From:      period.From.Format(analyticsDateLayout),
+		To:        period.To.Format(analyticsDateLayout),
+		Referrers: referrers,
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/AsterOzlob/content_managment_api/internal/database/models"
 	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/stats"
 )
 
 // MapToContentResponse преобразует модель Article в DTO ContentResponse.
@@ -22,23 +23,120 @@ func MapToArticleResponse(content *models.Article) *dto.ArticleResponse {
 	var commentDTOs []dto.CommentDTO
 	for _, comment := range content.Comments {
 		commentDTOs = append(commentDTOs, dto.CommentDTO{
-			ID:       comment.ID,
-			ParentID: comment.ParentID,
-			Text:     comment.Text,
+			ID:        comment.ID,
+			ParentID:  comment.ParentID,
+			Text:      comment.Text,
+			Reactions: MapToReactionCounts(comment.ReactionCounts),
+		})
+	}
+
+	var categoryDTO *dto.CategoryDTO
+	if content.Category != nil {
+		categoryDTO = &dto.CategoryDTO{
+			ID:   content.Category.ID,
+			Name: content.Category.Name,
+			Slug: content.Category.Slug,
+		}
+	}
+
+	tagDTOs := make([]dto.TagDTO, 0, len(content.Tags))
+	for _, tag := range content.Tags {
+		tagDTOs = append(tagDTOs, dto.TagDTO{
+			ID:   tag.ID,
+			Name: tag.Name,
+			Slug: tag.Slug,
 		})
 	}
 
 	return &dto.ArticleResponse{
-		ID:        content.ID,
-		AuthorID:  content.AuthorID,
-		Title:     content.Title,
-		Text:      content.Text,
-		Published: content.Published,
-		CreatedAt: content.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: content.UpdatedAt.Format(time.RFC3339),
-		Media:     mediaDTOs,
-		Comments:  commentDTOs,
+		ID:                  content.ID,
+		AuthorID:            content.AuthorID,
+		Title:               content.Title,
+		TextMarkdown:        content.Text,
+		Blocks:              content.Blocks,
+		TextHTML:            content.TextHTML,
+		Published:           content.Published,
+		Locale:              content.Locale,
+		AvailableLocales:    availableLocales(content),
+		Category:            categoryDTO,
+		Tags:                tagDTOs,
+		CreatedAt:           content.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:           content.UpdatedAt.Format(time.RFC3339),
+		Media:               mediaDTOs,
+		Comments:            commentDTOs,
+		Series:              seriesNavigation(content),
+		Stats:               MapToContentStats(content.Stats),
+		Reactions:           MapToReactionCounts(content.ReactionCounts),
+		Hidden:              content.Hidden,
+		PremoderateComments: content.PremoderateComments,
+	}
+}
+
+// MapToContentStats преобразует статистику текста в DTO.
+func MapToContentStats(s stats.Stats) dto.ContentStats {
+	outline := s.Outline
+	if outline == nil {
+		outline = stats.Outline{}
+	}
+	return dto.ContentStats{
+		WordCount:   s.WordCount,
+		CharCount:   s.CharCount,
+		ReadingTime: s.ReadingTime,
+		ImageCount:  s.ImageCount,
+		Outline:     outline,
+	}
+}
+
+// MapToLocalizedArticleResponse преобразует статью в DTO с текстом на запрошенной локали.
+// Если опубликованного перевода на запрошенную локаль нет, используется перевод на локаль
+// по умолчанию, а затем исходный текст статьи.
+func MapToLocalizedArticleResponse(content *models.Article, locale, defaultLocale string) *dto.ArticleResponse {
+	response := MapToArticleResponse(content)
+	for _, candidate := range []string{locale, defaultLocale} {
+		if candidate == content.Locale {
+			break
+		}
+		if translation := publishedTranslation(content, candidate); translation != nil {
+			response.Locale = translation.Locale
+			response.Title = translation.Title
+			response.TextMarkdown = translation.Text
+			response.TextHTML = translation.TextHTML
+			response.Blocks = nil
+			response.Stats = MapToContentStats(translation.Stats)
+			break
+		}
+	}
+	return response
+}
+
+// MapToLocalizedArticleListResponse преобразует список статей в список DTO с учётом локали.
+func MapToLocalizedArticleListResponse(articles []*models.Article, locale, defaultLocale string) []*dto.ArticleResponse {
+	dtoArticles := make([]*dto.ArticleResponse, 0, len(articles))
+	for _, article := range articles {
+		dtoArticles = append(dtoArticles, MapToLocalizedArticleResponse(article, locale, defaultLocale))
+	}
+	return dtoArticles
+}
+
+// publishedTranslation возвращает опубликованный перевод статьи на локаль или nil.
+func publishedTranslation(content *models.Article, locale string) *models.ArticleTranslation {
+	for i := range content.Translations {
+		if content.Translations[i].Locale == locale && content.Translations[i].Published {
+			return &content.Translations[i]
+		}
+	}
+	return nil
+}
+
+// availableLocales возвращает исходную локаль статьи и локали её опубликованных переводов.
+func availableLocales(content *models.Article) []string {
+	locales := []string{content.Locale}
+	for _, translation := range content.Translations {
+		if translation.Published {
+			locales = append(locales, translation.Locale)
+		}
 	}
+	return locales
 }
 
 // MapToArticleListResponse преобразует список статей в список DTO-ответов.
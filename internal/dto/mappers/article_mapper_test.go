@@ -0,0 +1,73 @@
+package mappers
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/AsterOzlob/content_managment_api/internal/blocks"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/stats"
+)
+
+func TestMapToLocalizedArticleResponse(t *testing.T) {
+	translation := func(locale string, published bool) models.ArticleTranslation {
+		return models.ArticleTranslation{
+			Locale:    locale,
+			Title:     "title-" + locale,
+			Text:      "text-" + locale,
+			TextHTML:  "<p>text-" + locale + "</p>",
+			Published: published,
+			Stats:     stats.Stats{WordCount: len(locale)},
+		}
+	}
+	// Исходный текст на русском, опубликованы переводы на английский и немецкий, французский — черновик
+	article := &models.Article{
+		Locale: "ru",
+		Title:  "title-ru",
+		Text:   "text-ru",
+		Blocks: blocks.Document{{Type: "paragraph"}},
+		Stats:  stats.Stats{WordCount: 100},
+		Translations: []models.ArticleTranslation{
+			translation("en", true),
+			translation("de", true),
+			translation("fr", false),
+			translation("ru", true), // перевод на исходную локаль не должен заменять оригинал
+		},
+	}
+
+	tests := []struct {
+		name          string
+		locale        string
+		defaultLocale string
+		want          string
+	}{
+		{name: "requested translation", locale: "de", defaultLocale: "en", want: "de"},
+		{name: "requested locale is the original", locale: "ru", defaultLocale: "en", want: "ru"},
+		{name: "unpublished translation falls back to default", locale: "fr", defaultLocale: "en", want: "en"},
+		{name: "missing translation falls back to default", locale: "es", defaultLocale: "de", want: "de"},
+		{name: "default is the original", locale: "es", defaultLocale: "ru", want: "ru"},
+		{name: "default is missing too", locale: "es", defaultLocale: "it", want: "ru"},
+		{name: "default is unpublished", locale: "es", defaultLocale: "fr", want: "ru"},
+		{name: "no locale requested", locale: "", defaultLocale: "en", want: "en"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MapToLocalizedArticleResponse(article, tt.locale, tt.defaultLocale)
+			if got.Locale != tt.want || got.Title != "title-"+tt.want || got.TextMarkdown != "text-"+tt.want {
+				t.Errorf("response locale, title, text = %q, %q, %q; want the %q version", got.Locale, got.Title, got.TextMarkdown, tt.want)
+			}
+			translated := tt.want != article.Locale
+			if translated && (got.Blocks != nil || got.Stats.WordCount != len(tt.want)) {
+				t.Errorf("translated response kept blocks %v or stats %+v of the original", got.Blocks, got.Stats)
+			}
+			if !translated && (len(got.Blocks) != 1 || got.Stats.WordCount != 100) {
+				t.Errorf("original response lost blocks %v or stats %+v", got.Blocks, got.Stats)
+			}
+		})
+	}
+
+	want := []string{"ru", "en", "de", "ru"}
+	if got := MapToLocalizedArticleResponse(article, "de", "en").AvailableLocales; !slices.Equal(got, want) {
+		t.Errorf("AvailableLocales = %v, want %v", got, want)
+	}
+}
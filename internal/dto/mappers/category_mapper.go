@@ -0,0 +1,47 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+)
+
+// MapToCategoryResponse преобразует модель Category в DTO CategoryResponse.
+func MapToCategoryResponse(category *models.Category) *dto.CategoryResponse {
+	return &dto.CategoryResponse{
+		ID:          category.ID,
+		ParentID:    category.ParentID,
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+		CreatedAt:   category.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   category.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// MapToCategoryTreeResponse строит дерево DTO CategoryResponse из плоского списка рубрик.
+func MapToCategoryTreeResponse(categories []*models.Category) []dto.CategoryResponse {
+	children := make(map[uint][]*models.Category)
+	var roots []*models.Category
+	for _, category := range categories {
+		if category.ParentID == nil {
+			roots = append(roots, category)
+			continue
+		}
+		children[*category.ParentID] = append(children[*category.ParentID], category)
+	}
+
+	var build func(nodes []*models.Category) []dto.CategoryResponse
+	build = func(nodes []*models.Category) []dto.CategoryResponse {
+		result := make([]dto.CategoryResponse, 0, len(nodes))
+		for _, node := range nodes {
+			dtoCategory := MapToCategoryResponse(node)
+			dtoCategory.Children = build(children[node.ID])
+			result = append(result, *dtoCategory)
+		}
+		return result
+	}
+
+	return build(roots)
+}
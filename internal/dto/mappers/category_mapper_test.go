@@ -0,0 +1,42 @@
+package mappers
+
+import (
+	"testing"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+)
+
+func TestMapToCategoryTreeResponse(t *testing.T) {
+	parent := func(id uint) *uint { return &id }
+	categories := []*models.Category{
+		{ID: 1, Name: "Наука"},
+		{ID: 2, Name: "Физика", ParentID: parent(1)},
+		{ID: 3, Name: "Квантовая физика", ParentID: parent(2)},
+		{ID: 4, Name: "Химия", ParentID: parent(1)},
+		{ID: 5, Name: "Спорт"},
+		{ID: 6, Name: "Без родителя", ParentID: parent(99)},
+	}
+
+	tree := MapToCategoryTreeResponse(categories)
+
+	if len(tree) != 2 || tree[0].ID != 1 || tree[1].ID != 5 {
+		t.Fatalf("roots = %+v, want categories 1 and 5 in input order", tree)
+	}
+	science := tree[0]
+	if len(science.Children) != 2 || science.Children[0].ID != 2 || science.Children[1].ID != 4 {
+		t.Fatalf("children of 1 = %+v, want categories 2 and 4", science.Children)
+	}
+	physics := science.Children[0]
+	if len(physics.Children) != 1 || physics.Children[0].ID != 3 {
+		t.Errorf("children of 2 = %+v, want category 3", physics.Children)
+	}
+	if len(tree[1].Children) != 0 {
+		t.Errorf("children of 5 = %+v, want none", tree[1].Children)
+	}
+}
+
+func TestMapToCategoryTreeResponseEmpty(t *testing.T) {
+	if tree := MapToCategoryTreeResponse(nil); len(tree) != 0 {
+		t.Errorf("MapToCategoryTreeResponse(nil) = %+v, want empty tree", tree)
+	}
+}
@@ -1,6 +1,8 @@
 package mappers
 
 import (
+	"strings"
+
 	"github.com/AsterOzlob/content_managment_api/internal/database/models"
 	"github.com/AsterOzlob/content_managment_api/internal/dto"
 )
@@ -13,15 +15,37 @@ func MapToCommentResponse(comment *models.Comment) dto.CommentResponse {
 	}
 
 	return dto.CommentResponse{
-		ID:        comment.ID,
-		ParentID:  comment.ParentID,
-		ArticleID: comment.ArticleID,
-		AuthorID:  comment.AuthorID,
-		Text:      comment.Text,
-		CreatedAt: comment.CreatedAt,
-		UpdatedAt: comment.UpdatedAt,
-		Replies:   replies,
+		ID:         comment.ID,
+		ParentID:   comment.ParentID,
+		ArticleID:  comment.ArticleID,
+		AuthorID:   comment.AuthorID,
+		Text:       comment.Text,
+		Status:     comment.Status,
+		Hidden:     comment.Hidden,
+		Locked:     comment.Locked,
+		Deleted:    comment.Tombstone,
+		CreatedAt:  comment.CreatedAt,
+		UpdatedAt:  comment.UpdatedAt,
+		Edited:     comment.EditedAt != nil,
+		EditedAt:   comment.EditedAt,
+		Reactions:  MapToReactionCounts(comment.ReactionCounts),
+		Mentions:   mapMentions(comment.Mentions),
+		ReplyCount: comment.ReplyCount,
+		Replies:    replies,
+	}
+}
+
+// mapMentions преобразует упоминания комментария в список DTO.
+func mapMentions(mentions []models.CommentMention) []dto.MentionDTO {
+	items := make([]dto.MentionDTO, 0, len(mentions))
+	for _, mention := range mentions {
+		item := dto.MentionDTO{UserID: mention.UserID}
+		if mention.User != nil {
+			item.Username = mention.User.Username
+		}
+		items = append(items, item)
 	}
+	return items
 }
 
 // MapToCommentListResponse преобразует список моделей Comment в список DTO CommentResponse.
@@ -34,3 +58,71 @@ func MapToCommentListResponse(comments []*models.Comment) []dto.CommentResponse
 
 	return dtoComments
 }
+
+// MapToCommentThreadResponse преобразует страницу веток комментариев в DTO.
+func MapToCommentThreadResponse(comments []*models.Comment, total int64) dto.CommentThreadResponse {
+	return dto.CommentThreadResponse{Total: total, Comments: MapToCommentListResponse(comments)}
+}
+
+// MapToModerationQueueResponse преобразует страницу очереди модерации в DTO.
+func MapToModerationQueueResponse(comments []*models.Comment, total int64) dto.CommentModerationQueueResponse {
+	items := make([]dto.ModeratedCommentResponse, 0, len(comments))
+	for _, comment := range comments {
+		reasons := []string{}
+		if comment.SpamReasons != "" {
+			reasons = strings.Split(comment.SpamReasons, ",")
+		}
+		items = append(items, dto.ModeratedCommentResponse{
+			CommentResponse: MapToCommentResponse(comment),
+			SpamScore:       comment.SpamScore,
+			SpamReasons:     reasons,
+		})
+	}
+	return dto.CommentModerationQueueResponse{Total: total, Comments: items}
+}
+
+// MapToCommentModerationResponse преобразует результат массовой модерации в DTO.
+func MapToCommentModerationResponse(status string, comments []*models.Comment) dto.CommentModerationResponse {
+	updated := make([]uint, 0, len(comments))
+	for _, comment := range comments {
+		updated = append(updated, comment.ID)
+	}
+	return dto.CommentModerationResponse{Status: status, Updated: updated}
+}
+
+// MapToCommentRemovalResponse преобразует модель CommentRemoval в DTO CommentRemovalResponse.
+func MapToCommentRemovalResponse(removal *models.CommentRemoval) dto.CommentRemovalResponse {
+	return dto.CommentRemovalResponse{
+		ID:           removal.ID,
+		CommentID:    removal.CommentID,
+		ArticleID:    removal.ArticleID,
+		AuthorID:     removal.AuthorID,
+		ModeratorID:  removal.ModeratorID,
+		Reason:       removal.Reason,
+		RemovedCount: removal.RemovedCount,
+		CreatedAt:    removal.CreatedAt,
+	}
+}
+
+// MapToCommentRemovalListResponse преобразует страницу журнала удалений в DTO.
+func MapToCommentRemovalListResponse(removals []*models.CommentRemoval, total int64) dto.CommentRemovalListResponse {
+	items := make([]dto.CommentRemovalResponse, 0, len(removals))
+	for _, removal := range removals {
+		items = append(items, MapToCommentRemovalResponse(removal))
+	}
+	return dto.CommentRemovalListResponse{Total: total, Removals: items}
+}
+
+// MapToCommentRevisionListResponse преобразует историю правок комментария в список DTO.
+func MapToCommentRevisionListResponse(revisions []*models.CommentRevision) []dto.CommentRevisionResponse {
+	items := make([]dto.CommentRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		items = append(items, dto.CommentRevisionResponse{
+			ID:        revision.ID,
+			EditorID:  revision.EditorID,
+			Text:      revision.Text,
+			CreatedAt: revision.CreatedAt,
+		})
+	}
+	return items
+}
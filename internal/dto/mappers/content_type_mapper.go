@@ -0,0 +1,30 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+)
+
+// MapToContentTypeResponse преобразует модель ContentType в DTO ContentTypeResponse.
+func MapToContentTypeResponse(contentType *models.ContentType) *dto.ContentTypeResponse {
+	return &dto.ContentTypeResponse{
+		ID:          contentType.ID,
+		Name:        contentType.Name,
+		Slug:        contentType.Slug,
+		Description: contentType.Description,
+		Fields:      contentType.Fields,
+		CreatedAt:   contentType.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   contentType.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// MapToContentTypeListResponse преобразует список типов контента в список DTO-ответов.
+func MapToContentTypeListResponse(contentTypes []*models.ContentType) []*dto.ContentTypeResponse {
+	result := make([]*dto.ContentTypeResponse, 0, len(contentTypes))
+	for _, contentType := range contentTypes {
+		result = append(result, MapToContentTypeResponse(contentType))
+	}
+	return result
+}
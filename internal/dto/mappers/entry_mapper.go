@@ -0,0 +1,30 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+)
+
+// MapToEntryResponse преобразует модель Entry в DTO EntryResponse.
+func MapToEntryResponse(entry *models.Entry, contentType *models.ContentType) *dto.EntryResponse {
+	return &dto.EntryResponse{
+		ID:          entry.ID,
+		ContentType: contentType.Slug,
+		AuthorID:    entry.AuthorID,
+		Data:        entry.Data,
+		Published:   entry.Published,
+		CreatedAt:   entry.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   entry.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// MapToEntryListResponse преобразует список записей в список DTO-ответов.
+func MapToEntryListResponse(entries []*models.Entry, contentType *models.ContentType) []*dto.EntryResponse {
+	result := make([]*dto.EntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, MapToEntryResponse(entry, contentType))
+	}
+	return result
+}
@@ -0,0 +1,33 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+)
+
+// MapToFollowingResponse преобразует подписки пользователя в DTO FollowingResponse.
+func MapToFollowingResponse(following *services.Following) dto.FollowingResponse {
+	authors := make([]dto.FollowedAuthorDTO, 0, len(following.Authors))
+	for _, follow := range following.Authors {
+		authors = append(authors, dto.FollowedAuthorDTO{
+			ID:         follow.AuthorID,
+			Username:   follow.Author.Username,
+			FollowedAt: follow.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	tags := make([]dto.FollowedTagDTO, 0, len(following.Tags))
+	for _, follow := range following.Tags {
+		if follow.Tag == nil {
+			continue
+		}
+		tags = append(tags, dto.FollowedTagDTO{
+			ID:         follow.TagID,
+			Name:       follow.Tag.Name,
+			Slug:       follow.Tag.Slug,
+			FollowedAt: follow.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return dto.FollowingResponse{Authors: authors, Tags: tags}
+}
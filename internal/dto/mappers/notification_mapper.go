@@ -0,0 +1,32 @@
+package mappers
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+)
+
+// MapToNotificationResponse преобразует модель Notification в DTO NotificationResponse.
+func MapToNotificationResponse(notification *models.Notification) dto.NotificationResponse {
+	response := dto.NotificationResponse{
+		ID:        notification.ID,
+		Type:      notification.Type,
+		ArticleID: notification.ArticleID,
+		CommentID: notification.CommentID,
+		Read:      notification.ReadAt != nil,
+		ReadAt:    notification.ReadAt,
+		CreatedAt: notification.CreatedAt,
+	}
+	if notification.Actor != nil {
+		response.Actor = &dto.MentionDTO{UserID: notification.Actor.ID, Username: notification.Actor.Username}
+	}
+	return response
+}
+
+// MapToNotificationListResponse преобразует страницу уведомлений в DTO.
+func MapToNotificationListResponse(notifications []*models.Notification, total, unread int64) dto.NotificationListResponse {
+	items := make([]dto.NotificationResponse, 0, len(notifications))
+	for _, notification := range notifications {
+		items = append(items, MapToNotificationResponse(notification))
+	}
+	return dto.NotificationListResponse{Total: total, Unread: unread, Notifications: items}
+}
@@ -0,0 +1,51 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+)
+
+// MapToPreviewTokenResponse преобразует модель PreviewToken в DTO PreviewTokenResponse.
+func MapToPreviewTokenResponse(token *models.PreviewToken) *dto.PreviewTokenResponse {
+	response := &dto.PreviewTokenResponse{
+		ID:          token.ID,
+		ArticleID:   token.ArticleID,
+		CreatedByID: token.CreatedByID,
+		Note:        token.Note,
+		Active:      token.Active(time.Now()),
+		ExpiresAt:   token.ExpiresAt.Format(time.RFC3339),
+		TrackViews:  token.TrackViews,
+		ViewCount:   token.ViewCount,
+		LastViewIP:  token.LastViewIP,
+		CreatedAt:   token.CreatedAt.Format(time.RFC3339),
+	}
+	if token.RevokedAt != nil {
+		revokedAt := token.RevokedAt.Format(time.RFC3339)
+		response.RevokedAt = &revokedAt
+	}
+	if token.LastViewedAt != nil {
+		lastViewedAt := token.LastViewedAt.Format(time.RFC3339)
+		response.LastViewedAt = &lastViewedAt
+	}
+	return response
+}
+
+// MapToPreviewTokenListResponse преобразует список токенов в список DTO-ответов.
+func MapToPreviewTokenListResponse(tokens []*models.PreviewToken) []*dto.PreviewTokenResponse {
+	result := make([]*dto.PreviewTokenResponse, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, MapToPreviewTokenResponse(token))
+	}
+	return result
+}
+
+// MapToCreatedPreviewTokenResponse добавляет к данным токена сам токен и ссылку предпросмотра.
+func MapToCreatedPreviewTokenResponse(token *models.PreviewToken, signed, url string) *dto.CreatedPreviewTokenResponse {
+	return &dto.CreatedPreviewTokenResponse{
+		PreviewTokenResponse: *MapToPreviewTokenResponse(token),
+		Token:                signed,
+		URL:                  url,
+	}
+}
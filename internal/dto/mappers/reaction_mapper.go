@@ -0,0 +1,27 @@
+package mappers
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+)
+
+// MapToReactionCounts преобразует счётчики реакций в DTO; отсутствие реакций отдаётся пустым объектом.
+func MapToReactionCounts(counts models.ReactionCounts) map[string]int {
+	reactions := make(map[string]int, len(counts))
+	for reactionType, count := range counts {
+		if count > 0 {
+			reactions[reactionType] = count
+		}
+	}
+	return reactions
+}
+
+// MapToReactionToggleResponse преобразует результат переключения реакции в DTO.
+func MapToReactionToggleResponse(result *services.ReactionResult) dto.ReactionToggleResponse {
+	return dto.ReactionToggleResponse{
+		Type:      result.Type,
+		Reacted:   result.Reacted,
+		Reactions: MapToReactionCounts(result.Counts),
+	}
+}
@@ -0,0 +1,74 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// savedSummaryLength — длина краткого содержания статьи в закладках и списках для чтения.
+const savedSummaryLength = 200
+
+// MapToReadingListResponse преобразует модель ReadingList в DTO ReadingListResponse.
+// Публичная ссылка возвращается только для публичных списков.
+func MapToReadingListResponse(list *models.ReadingList, site *config.SiteConfig) *dto.ReadingListResponse {
+	articles := make([]dto.SavedArticleDTO, 0, len(list.Items))
+	for _, item := range list.Items {
+		if item.Article == nil {
+			continue
+		}
+		article := savedArticle(item.Article, item.CreatedAt)
+		article.Position = len(articles) + 1
+		articles = append(articles, article)
+	}
+	var shareURL string
+	if list.Public {
+		shareURL = site.URL("/reading-lists/shared/" + list.ShareID)
+	}
+	return &dto.ReadingListResponse{
+		ID:          list.ID,
+		UserID:      list.UserID,
+		Name:        list.Name,
+		Description: list.Description,
+		Public:      list.Public,
+		ShareURL:    shareURL,
+		Articles:    articles,
+		CreatedAt:   list.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   list.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// MapToReadingListListResponse преобразует список моделей ReadingList в список DTO.
+func MapToReadingListListResponse(lists []*models.ReadingList, site *config.SiteConfig) []*dto.ReadingListResponse {
+	result := make([]*dto.ReadingListResponse, 0, len(lists))
+	for _, list := range lists {
+		result = append(result, MapToReadingListResponse(list, site))
+	}
+	return result
+}
+
+// MapToBookmarkListResponse преобразует закладки пользователя в список DTO.
+func MapToBookmarkListResponse(bookmarks []*models.Bookmark) []dto.SavedArticleDTO {
+	result := make([]dto.SavedArticleDTO, 0, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		if bookmark.Article != nil {
+			result = append(result, savedArticle(bookmark.Article, bookmark.CreatedAt))
+		}
+	}
+	return result
+}
+
+// savedArticle преобразует сохранённую статью в краткое DTO.
+func savedArticle(article *models.Article, savedAt time.Time) dto.SavedArticleDTO {
+	return dto.SavedArticleDTO{
+		ArticleID:   article.ID,
+		Title:       article.Title,
+		Summary:     utils.Excerpt(article.PlainText, savedSummaryLength),
+		ReadingTime: article.Stats.ReadingTime,
+		Published:   article.Published,
+		SavedAt:     savedAt.Format(time.RFC3339),
+	}
+}
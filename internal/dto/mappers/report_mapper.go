@@ -0,0 +1,64 @@
+package mappers
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+)
+
+// MapToReportResponse преобразует модель Report в DTO ReportResponse.
+func MapToReportResponse(report *models.Report) dto.ReportResponse {
+	return dto.ReportResponse{
+		ID:         report.ID,
+		ReporterID: report.ReporterID,
+		TargetType: report.TargetType(),
+		TargetID:   report.TargetID(),
+		Reason:     report.Reason,
+		Text:       report.Text,
+		Status:     report.Status,
+		Action:     report.Action,
+		ResolvedAt: report.ResolvedAt,
+		CreatedAt:  report.CreatedAt,
+	}
+}
+
+// MapToReportTriageResponse преобразует страницу очереди разбора жалоб в DTO.
+func MapToReportTriageResponse(items []*services.ReportTriageItem, total int64) dto.ReportTriageResponse {
+	targets := make([]dto.ReportTargetDTO, 0, len(items))
+	for _, item := range items {
+		reports := make([]dto.ReportResponse, 0, len(item.Reports))
+		reasons := map[string]int{}
+		for _, report := range item.Reports {
+			reports = append(reports, MapToReportResponse(report))
+			reasons[report.Reason]++
+		}
+		targets = append(targets, dto.ReportTargetDTO{
+			TargetType:      item.TargetType,
+			TargetID:        item.TargetID,
+			ArticleID:       item.ArticleID,
+			AuthorID:        item.AuthorID,
+			Excerpt:         item.Excerpt,
+			Hidden:          item.Hidden,
+			ReportCount:     item.Target.ReportCount,
+			Reasons:         reasons,
+			FirstReportedAt: item.Target.FirstReportedAt,
+			LastReportedAt:  item.Target.LastReportedAt,
+			AuthorWarnings:  item.AuthorWarnings,
+			AuthorBanned:    item.AuthorBanned,
+			Reports:         reports,
+		})
+	}
+	return dto.ReportTriageResponse{Total: total, Targets: targets}
+}
+
+// MapToReportResolutionResponse преобразует результат рассмотрения жалоб в DTO.
+func MapToReportResolutionResponse(resolution *services.ReportResolution) dto.ReportResolutionResponse {
+	return dto.ReportResolutionResponse{
+		TargetType:   resolution.TargetType,
+		TargetID:     resolution.TargetID,
+		Action:       resolution.Action,
+		Resolved:     resolution.Resolved,
+		Hidden:       resolution.Hidden,
+		AuthorBanned: resolution.AuthorBanned,
+	}
+}
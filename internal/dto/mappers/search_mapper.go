@@ -0,0 +1,28 @@
+package mappers
+
+import (
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/search"
+)
+
+// MapToSearchHitResponse преобразует результат поиска в DTO SearchHitResponse.
+func MapToSearchHitResponse(hit search.Hit) dto.SearchHitResponse {
+	return dto.SearchHitResponse{
+		Type:      hit.Type,
+		ID:        hit.EntityID,
+		ArticleID: hit.ArticleID,
+		Title:     hit.Title,
+		Score:     hit.Score,
+	}
+}
+
+// MapToSearchHitListResponse преобразует список результатов поиска в список DTO.
+func MapToSearchHitListResponse(hits []search.Hit) []dto.SearchHitResponse {
+	dtoHits := make([]dto.SearchHitResponse, 0, len(hits))
+
+	for _, hit := range hits {
+		dtoHits = append(dtoHits, MapToSearchHitResponse(hit))
+	}
+
+	return dtoHits
+}
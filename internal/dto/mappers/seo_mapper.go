@@ -0,0 +1,93 @@
+package mappers
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// metaDescriptionLength — длина описания, выводимого из текста статьи.
+const metaDescriptionLength = 160
+
+// MapToArticleSEO вычисляет SEO-метаданные статьи для уже сформированного ответа.
+// Заданные автором значения используются, только если ответ отдан на исходной локали статьи,
+// иначе заголовок и описание выводятся из текста перевода.
+// Все значения возвращаются простым текстом без HTML-экранирования.
+func MapToArticleSEO(response *dto.ArticleResponse, content *models.Article, site *config.SiteConfig) dto.ArticleSEO {
+	seo := dto.ArticleSEO{
+		MetaTitle:       html.UnescapeString(response.Title),
+		MetaDescription: utils.Excerpt(utils.StripHTML(response.TextHTML), metaDescriptionLength),
+		CanonicalURL:    site.URL(fmt.Sprintf("/articles/%d", content.ID)),
+		NoIndex:         content.NoIndex,
+		Keywords:        content.KeywordList(),
+	}
+	if response.Locale == content.Locale {
+		if content.MetaTitle != "" {
+			seo.MetaTitle = content.MetaTitle
+		}
+		if content.MetaDescription != "" {
+			seo.MetaDescription = content.MetaDescription
+		}
+	}
+	if content.CanonicalURL != "" {
+		seo.CanonicalURL = content.CanonicalURL
+	}
+	if len(seo.Keywords) == 0 {
+		for _, tag := range response.Tags {
+			seo.Keywords = append(seo.Keywords, html.UnescapeString(tag.Name))
+		}
+	}
+	if image := ogImage(content); image != nil {
+		seo.OGImageID = &image.ID
+		seo.OGImageURL = site.URL(image.FilePath)
+	}
+	return seo
+}
+
+// MapToPreviewCard формирует данные социальной карточки статьи.
+func MapToPreviewCard(response *dto.ArticleResponse, content *models.Article, site *config.SiteConfig) *dto.PreviewCardResponse {
+	seo := response.SEO
+	card := &dto.PreviewCardResponse{
+		Type:          "article",
+		SiteName:      site.Title,
+		Title:         seo.MetaTitle,
+		Description:   seo.MetaDescription,
+		URL:           seo.CanonicalURL,
+		Locale:        response.Locale,
+		PublishedTime: content.CreatedAt.Format(time.RFC3339),
+		ModifiedTime:  content.UpdatedAt.Format(time.RFC3339),
+		Tags:          make([]string, 0, len(response.Tags)),
+		NoIndex:       seo.NoIndex,
+	}
+	for _, tag := range response.Tags {
+		card.Tags = append(card.Tags, html.UnescapeString(tag.Name))
+	}
+	if image := ogImage(content); image != nil {
+		card.Image = &dto.PreviewCardImage{
+			URL:  seo.OGImageURL,
+			Type: image.FileType,
+			Alt:  seo.MetaTitle,
+		}
+	}
+	return card
+}
+
+// ogImage возвращает изображение Open Graph статьи: выбранное автором
+// или первое прикреплённое изображение.
+func ogImage(content *models.Article) *models.Media {
+	if content.OGImage != nil {
+		return content.OGImage
+	}
+	for i := range content.Media {
+		if strings.HasPrefix(content.Media[i].FileType, "image/") {
+			return &content.Media[i]
+		}
+	}
+	return nil
+}
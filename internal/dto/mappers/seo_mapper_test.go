@@ -0,0 +1,64 @@
+package mappers
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+)
+
+var seoSite = &config.SiteConfig{BaseURL: "https://example.com"}
+
+func TestMapToArticleSEODerivesPlainTextDefaults(t *testing.T) {
+	response := &dto.ArticleResponse{
+		Title:    "Tom &amp; Jerry&#39;s",
+		TextHTML: "<p>Cats &amp; <b>mice</b></p>",
+		Locale:   "ru",
+		Tags:     []dto.TagDTO{{Name: "R&amp;D"}},
+	}
+	got := MapToArticleSEO(response, &models.Article{ID: 7, Locale: "ru"}, seoSite)
+
+	if got.MetaTitle != "Tom & Jerry's" || got.MetaDescription != "Cats & mice" {
+		t.Errorf("title = %q, description = %q; want plain text without entities", got.MetaTitle, got.MetaDescription)
+	}
+	if got.CanonicalURL != "https://example.com/articles/7" {
+		t.Errorf("CanonicalURL = %q", got.CanonicalURL)
+	}
+	if !reflect.DeepEqual(got.Keywords, []string{"R&D"}) {
+		t.Errorf("Keywords = %q, want tag names without entities", got.Keywords)
+	}
+}
+
+func TestMapToArticleSEOUsesAuthorValuesOnSourceLocale(t *testing.T) {
+	content := &models.Article{
+		ID: 7, Locale: "ru", MetaTitle: "Q&A", MetaDescription: "a < b",
+		CanonicalURL: "https://ex.com/a?x=1&y=2", Keywords: " go, ,R&D ",
+	}
+
+	got := MapToArticleSEO(&dto.ArticleResponse{Title: "Title", Locale: "ru"}, content, seoSite)
+	if got.MetaTitle != "Q&A" || got.MetaDescription != "a < b" || got.CanonicalURL != "https://ex.com/a?x=1&y=2" {
+		t.Errorf("MapToArticleSEO() = %+v, want the author values unchanged", got)
+	}
+	if !reflect.DeepEqual(got.Keywords, []string{"go", "R&D"}) {
+		t.Errorf("Keywords = %q", got.Keywords)
+	}
+
+	translated := MapToArticleSEO(&dto.ArticleResponse{Title: "Hello", TextHTML: "<p>Text</p>", Locale: "en"}, content, seoSite)
+	if translated.MetaTitle != "Hello" || translated.MetaDescription != "Text" {
+		t.Errorf("translation: title = %q, description = %q; want values of the translation", translated.MetaTitle, translated.MetaDescription)
+	}
+}
+
+func TestMapToArticleSEOCutsDescriptionOnDecodedText(t *testing.T) {
+	response := &dto.ArticleResponse{TextHTML: "<p>" + strings.Repeat("Tom &amp; Jerry ", 20) + "</p>"}
+	got := MapToArticleSEO(response, &models.Article{}, seoSite)
+	if strings.Contains(got.MetaDescription, "&amp") || strings.HasSuffix(got.MetaDescription, "&…") {
+		t.Errorf("MetaDescription = %q, want the cut made on decoded text", got.MetaDescription)
+	}
+	if n := len([]rune(got.MetaDescription)); n > metaDescriptionLength {
+		t.Errorf("MetaDescription has %d characters, want at most %d", n, metaDescriptionLength)
+	}
+}
@@ -0,0 +1,97 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// seriesSummaryLength — длина краткого содержания части на странице серии.
+const seriesSummaryLength = 200
+
+// MapToSeriesResponse преобразует модель Series в DTO SeriesResponse.
+// Если publishedOnly установлен, в ответ попадают только опубликованные и не скрытые части,
+// а порядковые номера пересчитываются без учёта черновиков.
+func MapToSeriesResponse(series *models.Series, publishedOnly bool) *dto.SeriesResponse {
+	parts := make([]dto.SeriesPartDTO, 0, len(series.Parts))
+	for _, part := range series.Parts {
+		if part.Article == nil || (publishedOnly && !part.Article.IsPublic()) {
+			continue
+		}
+		parts = append(parts, dto.SeriesPartDTO{
+			Position:  len(parts) + 1,
+			ArticleID: part.ArticleID,
+			Title:     part.Article.Title,
+			Summary:   utils.Excerpt(part.Article.PlainText, seriesSummaryLength),
+			Published: part.Article.Published,
+			CreatedAt: part.Article.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return &dto.SeriesResponse{
+		ID:          series.ID,
+		AuthorID:    series.AuthorID,
+		Title:       series.Title,
+		Slug:        series.Slug,
+		Description: series.Description,
+		Parts:       parts,
+		CreatedAt:   series.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   series.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// MapToSeriesListResponse преобразует список серий в список DTO-ответов.
+func MapToSeriesListResponse(series []*models.Series, publishedOnly bool) []*dto.SeriesResponse {
+	result := make([]*dto.SeriesResponse, 0, len(series))
+	for _, s := range series {
+		result = append(result, MapToSeriesResponse(s, publishedOnly))
+	}
+	return result
+}
+
+// seriesNavigation возвращает навигацию по серии для статьи или nil, если статья не входит в серию.
+// Неопубликованные части, кроме самой статьи, в навигации не учитываются.
+func seriesNavigation(content *models.Article) *dto.SeriesNavigationDTO {
+	if content.SeriesPart == nil || content.SeriesPart.Series == nil {
+		return nil
+	}
+	series := content.SeriesPart.Series
+	var visible []models.SeriesPart
+	current := -1
+	for _, part := range series.Parts {
+		if part.ArticleID == content.ID {
+			current = len(visible)
+		} else if part.Article == nil || !part.Article.IsPublic() {
+			continue
+		}
+		visible = append(visible, part)
+	}
+	if current < 0 {
+		return nil
+	}
+	nav := &dto.SeriesNavigationDTO{
+		ID:       series.ID,
+		Title:    series.Title,
+		Slug:     series.Slug,
+		Position: current + 1,
+		Total:    len(visible),
+	}
+	if current > 0 {
+		nav.Previous = navigationPart(visible[current-1], current)
+	}
+	if current+1 < len(visible) {
+		nav.Next = navigationPart(visible[current+1], current+2)
+	}
+	return nav
+}
+
+// navigationPart преобразует соседнюю часть серии в DTO.
+func navigationPart(part models.SeriesPart, position int) *dto.SeriesPartDTO {
+	return &dto.SeriesPartDTO{
+		Position:  position,
+		ArticleID: part.ArticleID,
+		Title:     part.Article.Title,
+		Published: part.Article.Published,
+	}
+}
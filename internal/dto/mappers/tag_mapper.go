@@ -0,0 +1,45 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+)
+
+// MapToTagResponse преобразует модель Tag в DTO TagResponse.
+func MapToTagResponse(tag *models.Tag) *dto.TagResponse {
+	return &dto.TagResponse{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		Slug:      tag.Slug,
+		CreatedAt: tag.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: tag.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// MapToTagListResponse преобразует список моделей Tag в список DTO TagResponse.
+func MapToTagListResponse(tags []*models.Tag) []*dto.TagResponse {
+	dtoTags := make([]*dto.TagResponse, 0, len(tags))
+
+	for _, tag := range tags {
+		dtoTags = append(dtoTags, MapToTagResponse(tag))
+	}
+
+	return dtoTags
+}
+
+// MapToTagWithCountListResponse преобразует теги с количеством статей в список DTO TagResponse.
+func MapToTagWithCountListResponse(tags []*repositories.TagWithCount) []*dto.TagResponse {
+	dtoTags := make([]*dto.TagResponse, 0, len(tags))
+
+	for _, tag := range tags {
+		dtoTag := MapToTagResponse(&tag.Tag)
+		count := tag.ArticleCount
+		dtoTag.ArticleCount = &count
+		dtoTags = append(dtoTags, dtoTag)
+	}
+
+	return dtoTags
+}
@@ -0,0 +1,48 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
+)
+
+// MapToTranslationResponse преобразует модель ArticleTranslation в DTO TranslationResponse.
+func MapToTranslationResponse(translation *models.ArticleTranslation) *dto.TranslationResponse {
+	return &dto.TranslationResponse{
+		ID:           translation.ID,
+		ArticleID:    translation.ArticleID,
+		Locale:       translation.Locale,
+		Title:        translation.Title,
+		TextMarkdown: translation.Text,
+		TextHTML:     translation.TextHTML,
+		Published:    translation.Published,
+		CreatedAt:    translation.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    translation.UpdatedAt.Format(time.RFC3339),
+		Stats:        MapToContentStats(translation.Stats),
+	}
+}
+
+// MapToTranslationListResponse преобразует список переводов в список DTO-ответов.
+func MapToTranslationListResponse(translations []*models.ArticleTranslation) []*dto.TranslationResponse {
+	result := make([]*dto.TranslationResponse, 0, len(translations))
+	for _, translation := range translations {
+		result = append(result, MapToTranslationResponse(translation))
+	}
+	return result
+}
+
+// MapToMissingTranslationListResponse преобразует список статей без переводов в список DTO-ответов.
+func MapToMissingTranslationListResponse(missing []services.MissingTranslations) []dto.MissingTranslationResponse {
+	result := make([]dto.MissingTranslationResponse, 0, len(missing))
+	for _, m := range missing {
+		result = append(result, dto.MissingTranslationResponse{
+			ArticleID:      m.Article.ID,
+			Title:          m.Article.Title,
+			Locale:         m.Article.Locale,
+			MissingLocales: m.Locales,
+		})
+	}
+	return result
+}
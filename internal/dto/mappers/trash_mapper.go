@@ -0,0 +1,62 @@
+package mappers
+
+import (
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"gorm.io/gorm"
+)
+
+// trashCommentTitleLength — длина начала комментария, показываемого в корзине.
+const trashCommentTitleLength = 80
+
+// MapArticlesToTrashResponse преобразует удалённые статьи в записи корзины.
+func MapArticlesToTrashResponse(articles []*models.Article, retention time.Duration) []*dto.TrashItemResponse {
+	result := make([]*dto.TrashItemResponse, 0, len(articles))
+	for _, article := range articles {
+		result = append(result, trashItem("article", article.ID, article.AuthorID, nil, article.Title, article.DeletedAt, retention))
+	}
+	return result
+}
+
+// MapCommentsToTrashResponse преобразует удалённые комментарии в записи корзины.
+func MapCommentsToTrashResponse(comments []*models.Comment, retention time.Duration) []*dto.TrashItemResponse {
+	result := make([]*dto.TrashItemResponse, 0, len(comments))
+	for _, comment := range comments {
+		articleID := comment.ArticleID
+		title := utils.Excerpt(utils.StripHTML(comment.Text), trashCommentTitleLength)
+		result = append(result, trashItem("comment", comment.ID, comment.AuthorID, &articleID, title, comment.DeletedAt, retention))
+	}
+	return result
+}
+
+// MapMediaToTrashResponse преобразует удалённые медиафайлы в записи корзины.
+func MapMediaToTrashResponse(media []*models.Media, retention time.Duration) []*dto.TrashItemResponse {
+	result := make([]*dto.TrashItemResponse, 0, len(media))
+	for _, m := range media {
+		result = append(result, trashItem("media", m.ID, m.AuthorID, m.ArticleID, m.FilePath, m.DeletedAt, retention))
+	}
+	return result
+}
+
+// trashItem формирует запись корзины с датой окончательного удаления.
+func trashItem(
+	itemType string,
+	id, authorID uint,
+	articleID *uint,
+	title string,
+	deletedAt gorm.DeletedAt,
+	retention time.Duration,
+) *dto.TrashItemResponse {
+	return &dto.TrashItemResponse{
+		Type:      itemType,
+		ID:        id,
+		AuthorID:  authorID,
+		ArticleID: articleID,
+		Title:     title,
+		DeletedAt: deletedAt.Time.Format(time.RFC3339),
+		PurgeAt:   deletedAt.Time.Add(retention).Format(time.RFC3339),
+	}
+}
@@ -12,6 +12,7 @@ func MapToUserResponse(user *models.User) *dto.UserResponse {
 		Username:  user.Username,
 		Email:     user.Email,
 		Role:      user.Role.Name,
+		BannedAt:  user.BannedAt,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}
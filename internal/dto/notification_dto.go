@@ -0,0 +1,53 @@
+package dto
+
+import "time"
+
+// NotificationListQuery представляет параметры списка уведомлений.
+type NotificationListQuery struct {
+	Unread bool `form:"unread"` // Только непрочитанные уведомления.
+}
+
+// NotificationResponse представляет уведомление.
+type NotificationResponse struct {
+	ID        uint        `json:"id"`         // Уникальный идентификатор уведомления.
+	Type      string      `json:"type"`       // Тип: mention, reply или article_comment.
+	Actor     *MentionDTO `json:"actor"`      // Пользователь, вызвавший уведомление.
+	ArticleID uint        `json:"article_id"` // ID статьи.
+	CommentID *uint       `json:"comment_id"` // ID комментария.
+	Read      bool        `json:"read"`       // Прочитано ли уведомление.
+	ReadAt    *time.Time  `json:"read_at"`    // Дата прочтения.
+	CreatedAt time.Time   `json:"created_at"` // Дата создания.
+}
+
+// NotificationListResponse представляет страницу уведомлений.
+type NotificationListResponse struct {
+	Total         int64                  `json:"total"`         // Всего уведомлений в выборке.
+	Unread        int64                  `json:"unread"`        // Всего непрочитанных уведомлений.
+	Notifications []NotificationResponse `json:"notifications"` // Уведомления, начиная с новых.
+}
+
+// UnreadNotificationsResponse представляет количество непрочитанных уведомлений.
+type UnreadNotificationsResponse struct {
+	Unread int64 `json:"unread"` // Количество непрочитанных уведомлений.
+}
+
+// MarkNotificationsReadInput представляет входные данные отметки уведомлений прочитанными.
+type MarkNotificationsReadInput struct {
+	IDs []uint `json:"ids" binding:"max=100"` // ID уведомлений (пусто — все уведомления).
+}
+
+// MarkNotificationsReadResponse представляет результат отметки уведомлений прочитанными.
+type MarkNotificationsReadResponse struct {
+	Updated int64 `json:"updated"` // Количество отмеченных уведомлений.
+	Unread  int64 `json:"unread"`  // Количество оставшихся непрочитанных уведомлений.
+}
+
+// NotificationPreferencesInput представляет изменение настроек уведомлений.
+type NotificationPreferencesInput struct {
+	Preferences map[string]bool `json:"preferences" binding:"required"` // Включены ли уведомления по типам; неуказанные типы не меняются.
+}
+
+// NotificationPreferencesResponse представляет настройки уведомлений пользователя.
+type NotificationPreferencesResponse struct {
+	Preferences map[string]bool `json:"preferences"` // Включены ли уведомления по типам.
+}
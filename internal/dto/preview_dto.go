@@ -0,0 +1,32 @@
+package dto
+
+// PreviewTokenInput представляет параметры выдачи ссылки предпросмотра черновика.
+type PreviewTokenInput struct {
+	ExpiresInHours int    `json:"expires_in_hours" binding:"omitempty,min=1"` // Срок действия в часах (по умолчанию — PREVIEW_TOKEN_TTL).
+	TrackViews     bool   `json:"track_views"`                                // Записывать ли количество просмотров и IP-адрес.
+	Note           string `json:"note" binding:"omitempty,max=255"`           // Пометка, для кого выдана ссылка.
+}
+
+// PreviewTokenResponse представляет данные выданной ссылки предпросмотра.
+type PreviewTokenResponse struct {
+	ID           uint    `json:"id"`             // Уникальный идентификатор токена.
+	ArticleID    uint    `json:"article_id"`     // Идентификатор статьи.
+	CreatedByID  uint    `json:"created_by_id"`  // Идентификатор пользователя, выдавшего ссылку.
+	Note         string  `json:"note"`           // Пометка, для кого выдана ссылка.
+	Active       bool    `json:"active"`         // Действует ли ссылка.
+	ExpiresAt    string  `json:"expires_at"`     // Время истечения.
+	RevokedAt    *string `json:"revoked_at"`     // Время отзыва.
+	TrackViews   bool    `json:"track_views"`    // Записываются ли просмотры.
+	ViewCount    int     `json:"view_count"`     // Количество просмотров.
+	LastViewedAt *string `json:"last_viewed_at"` // Время последнего просмотра.
+	LastViewIP   string  `json:"last_view_ip"`   // IP-адрес последнего просмотра.
+	CreatedAt    string  `json:"created_at"`     // Дата создания.
+}
+
+// CreatedPreviewTokenResponse представляет только что выданную ссылку предпросмотра.
+// Токен возвращается один раз и не может быть получен повторно.
+type CreatedPreviewTokenResponse struct {
+	PreviewTokenResponse
+	Token string `json:"token"` // Подписанный токен предпросмотра.
+	URL   string `json:"url"`   // Ссылка предпросмотра для рецензента.
+}
@@ -0,0 +1,13 @@
+package dto
+
+// ReactionToggleResponse представляет результат переключения реакции.
+type ReactionToggleResponse struct {
+	Type      string         `json:"type"`      // Тип реакции.
+	Reacted   bool           `json:"reacted"`   // Поставлена ли реакция после переключения.
+	Reactions map[string]int `json:"reactions"` // Количество реакций по типам.
+}
+
+// ReactionTypesResponse представляет список доступных типов реакций.
+type ReactionTypesResponse struct {
+	Types []string `json:"types"` // Доступные типы реакций.
+}
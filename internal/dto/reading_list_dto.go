@@ -0,0 +1,49 @@
+package dto
+
+// ReadingListInput представляет входные данные для создания или обновления списка для чтения.
+type ReadingListInput struct {
+	Name        string `json:"name" binding:"required,max=255"`          // Название списка.
+	Description string `json:"description" binding:"omitempty,max=2000"` // Описание списка.
+	Public      bool   `json:"public"`                                   // Доступен ли список по публичной ссылке.
+}
+
+// ReadingListItemInput представляет статью, добавляемую в список для чтения.
+type ReadingListItemInput struct {
+	ArticleID uint `json:"article_id" binding:"required"` // ID статьи.
+}
+
+// ReadingListOrderInput представляет новый порядок статей списка.
+// Статьи, не указанные в article_ids, следуют за указанными в прежнем порядке.
+type ReadingListOrderInput struct {
+	ArticleIDs []uint `json:"article_ids" binding:"required"` // ID статей в новом порядке.
+}
+
+// ReadingListResponse представляет ответ с данными списка для чтения.
+type ReadingListResponse struct {
+	ID          uint              `json:"id"`                  // Уникальный идентификатор списка.
+	UserID      uint              `json:"user_id"`             // Идентификатор владельца списка.
+	Name        string            `json:"name"`                // Название списка.
+	Description string            `json:"description"`         // Описание списка.
+	Public      bool              `json:"public"`              // Доступен ли список по публичной ссылке.
+	ShareURL    string            `json:"share_url,omitempty"` // Публичная ссылка на список.
+	Articles    []SavedArticleDTO `json:"articles"`            // Статьи списка по порядку.
+	CreatedAt   string            `json:"created_at"`          // Дата создания.
+	UpdatedAt   string            `json:"updated_at"`          // Дата обновления.
+}
+
+// SavedArticleDTO представляет статью в закладках или списке для чтения.
+type SavedArticleDTO struct {
+	Position    int    `json:"position,omitempty"` // Порядковый номер статьи в списке, начиная с 1.
+	ArticleID   uint   `json:"article_id"`         // Идентификатор статьи.
+	Title       string `json:"title"`              // Заголовок статьи.
+	Summary     string `json:"summary,omitempty"`  // Краткое содержание статьи.
+	ReadingTime int    `json:"reading_time"`       // Оценка времени чтения в минутах.
+	Published   bool   `json:"published"`          // Опубликована ли статья.
+	SavedAt     string `json:"saved_at"`           // Дата добавления статьи.
+}
+
+// BookmarkResponse представляет состояние закладки на статью.
+type BookmarkResponse struct {
+	ArticleID uint `json:"article_id"` // Идентификатор статьи.
+	Saved     bool `json:"saved"`      // Находится ли статья в закладках.
+}
@@ -0,0 +1,72 @@
+package dto
+
+import "time"
+
+// ReportInput представляет входные данные жалобы на статью или комментарий.
+type ReportInput struct {
+	Reason string `json:"reason" binding:"required,max=32"` // Категория жалобы (см. GET /reports/reasons).
+	Text   string `json:"text" binding:"max=1000"`          // Пояснение (опционально).
+}
+
+// ReportResponse представляет данные жалобы.
+type ReportResponse struct {
+	ID         uint       `json:"id"`                    // Уникальный идентификатор жалобы.
+	ReporterID uint       `json:"reporter_id"`           // ID пожаловавшегося пользователя.
+	TargetType string     `json:"target_type"`           // Тип объекта: article или comment.
+	TargetID   uint       `json:"target_id"`             // ID объекта.
+	Reason     string     `json:"reason"`                // Категория жалобы.
+	Text       string     `json:"text"`                  // Пояснение пользователя.
+	Status     string     `json:"status"`                // Статус: open, dismissed или resolved.
+	Action     string     `json:"action,omitempty"`      // Решение модератора.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"` // Дата рассмотрения.
+	CreatedAt  time.Time  `json:"created_at"`            // Дата создания жалобы.
+}
+
+// ReportReasonsResponse представляет доступные категории жалоб.
+type ReportReasonsResponse struct {
+	Reasons []string `json:"reasons"` // Категории жалоб.
+}
+
+// ReportTriageQuery представляет параметры очереди разбора жалоб.
+type ReportTriageQuery struct {
+	Type string `form:"type" binding:"omitempty,oneof=article comment"` // Тип объектов (по умолчанию — все).
+}
+
+// ReportTriageResponse представляет страницу очереди разбора жалоб.
+type ReportTriageResponse struct {
+	Total   int64             `json:"total"`   // Всего объектов с открытыми жалобами.
+	Targets []ReportTargetDTO `json:"targets"` // Объекты, начиная с наибольшего количества жалоб.
+}
+
+// ReportTargetDTO представляет статью или комментарий с открытыми жалобами.
+type ReportTargetDTO struct {
+	TargetType      string           `json:"target_type"`       // Тип объекта: article или comment.
+	TargetID        uint             `json:"target_id"`         // ID объекта.
+	ArticleID       uint             `json:"article_id"`        // ID статьи (для комментария — статьи, к которой он относится).
+	AuthorID        uint             `json:"author_id"`         // ID автора объекта.
+	Excerpt         string           `json:"excerpt"`           // Заголовок статьи или начало текста комментария.
+	Hidden          bool             `json:"hidden"`            // Скрыт ли объект.
+	ReportCount     int64            `json:"report_count"`      // Количество открытых жалоб.
+	Reasons         map[string]int   `json:"reasons"`           // Количество открытых жалоб по категориям.
+	FirstReportedAt time.Time        `json:"first_reported_at"` // Дата первой открытой жалобы.
+	LastReportedAt  time.Time        `json:"last_reported_at"`  // Дата последней открытой жалобы.
+	AuthorWarnings  int64            `json:"author_warnings"`   // Количество предупреждений автора.
+	AuthorBanned    bool             `json:"author_banned"`     // Заблокирован ли автор.
+	Reports         []ReportResponse `json:"reports"`           // Открытые жалобы, начиная со старых.
+}
+
+// ReportResolutionInput представляет решение модератора по жалобам на объект.
+type ReportResolutionInput struct {
+	Action string `json:"action" binding:"required,oneof=dismiss hide warn ban"` // Решение: dismiss, hide, warn или ban.
+	Note   string `json:"note" binding:"max=1000"`                               // Комментарий модератора (сохраняется в предупреждении).
+}
+
+// ReportResolutionResponse представляет результат рассмотрения жалоб.
+type ReportResolutionResponse struct {
+	TargetType   string `json:"target_type"`   // Тип объекта.
+	TargetID     uint   `json:"target_id"`     // ID объекта.
+	Action       string `json:"action"`        // Применённое решение.
+	Resolved     int64  `json:"resolved"`      // Количество закрытых жалоб.
+	Hidden       bool   `json:"hidden"`        // Скрыт ли объект после решения.
+	AuthorBanned bool   `json:"author_banned"` // Заблокирован ли автор после решения.
+}
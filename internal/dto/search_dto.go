@@ -0,0 +1,15 @@
+package dto
+
+// SearchHitResponse представляет один результат поиска.
+type SearchHitResponse struct {
+	Type      string  `json:"type"`            // Тип документа ("article" или "comment").
+	ID        uint    `json:"id"`              // Идентификатор найденной сущности.
+	ArticleID uint    `json:"article_id"`      // Идентификатор статьи.
+	Title     string  `json:"title,omitempty"` // Заголовок статьи.
+	Score     float64 `json:"score"`           // Релевантность результата.
+}
+
+// ReindexResponse представляет результат переиндексации.
+type ReindexResponse struct {
+	Indexed int `json:"indexed"` // Количество проиндексированных документов.
+}
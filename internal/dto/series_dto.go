@@ -0,0 +1,47 @@
+package dto
+
+// SeriesInput представляет входные данные для создания или обновления серии.
+type SeriesInput struct {
+	Title       string `json:"title" binding:"required,max=255"`         // Название серии.
+	Slug        string `json:"slug" binding:"omitempty,max=128"`         // Slug серии (по умолчанию формируется из названия).
+	Description string `json:"description" binding:"omitempty,max=2000"` // Описание серии.
+	ArticleIDs  []uint `json:"article_ids,omitempty"`                    // ID статей по порядку частей (только при создании).
+}
+
+// SeriesPartsInput представляет новый состав серии.
+type SeriesPartsInput struct {
+	ArticleIDs []uint `json:"article_ids" binding:"required"` // ID статей по порядку частей.
+}
+
+// SeriesResponse представляет ответ с данными серии.
+type SeriesResponse struct {
+	ID          uint            `json:"id"`          // Уникальный идентификатор серии.
+	AuthorID    uint            `json:"author_id"`   // Идентификатор создателя серии.
+	Title       string          `json:"title"`       // Название серии.
+	Slug        string          `json:"slug"`        // Slug серии.
+	Description string          `json:"description"` // Описание серии.
+	Parts       []SeriesPartDTO `json:"parts"`       // Части серии по порядку.
+	CreatedAt   string          `json:"created_at"`  // Дата создания.
+	UpdatedAt   string          `json:"updated_at"`  // Дата обновления.
+}
+
+// SeriesPartDTO представляет часть серии.
+type SeriesPartDTO struct {
+	Position  int    `json:"position"`             // Порядковый номер части, начиная с 1.
+	ArticleID uint   `json:"article_id"`           // Идентификатор статьи.
+	Title     string `json:"title"`                // Заголовок статьи.
+	Summary   string `json:"summary,omitempty"`    // Краткое содержание статьи.
+	Published bool   `json:"published"`            // Опубликована ли статья.
+	CreatedAt string `json:"created_at,omitempty"` // Дата создания статьи.
+}
+
+// SeriesNavigationDTO описывает положение статьи в серии и соседние части.
+type SeriesNavigationDTO struct {
+	ID       uint           `json:"id"`       // Идентификатор серии.
+	Title    string         `json:"title"`    // Название серии.
+	Slug     string         `json:"slug"`     // Slug серии.
+	Position int            `json:"position"` // Номер текущей части.
+	Total    int            `json:"total"`    // Количество частей.
+	Previous *SeriesPartDTO `json:"previous"` // Предыдущая часть.
+	Next     *SeriesPartDTO `json:"next"`     // Следующая часть.
+}
@@ -0,0 +1,24 @@
+package dto
+
+// TagInput представляет входные данные для создания или обновления тега.
+type TagInput struct {
+	Name string `json:"name" binding:"required,max=64"`  // Название тега.
+	Slug string `json:"slug" binding:"omitempty,max=64"` // Slug тега (по умолчанию формируется из названия).
+}
+
+// TagResponse представляет ответ с данными тега.
+type TagResponse struct {
+	ID           uint   `json:"id"`                      // Уникальный идентификатор тега.
+	Name         string `json:"name"`                    // Название тега.
+	Slug         string `json:"slug"`                    // Slug тега.
+	ArticleCount *int64 `json:"article_count,omitempty"` // Количество опубликованных статей с тегом.
+	CreatedAt    string `json:"created_at"`              // Дата создания.
+	UpdatedAt    string `json:"updated_at"`              // Дата обновления.
+}
+
+// TagDTO представляет краткие данные тега в составе статьи.
+type TagDTO struct {
+	ID   uint   `json:"id"`   // Уникальный идентификатор тега.
+	Name string `json:"name"` // Название тега.
+	Slug string `json:"slug"` // Slug тега.
+}
@@ -0,0 +1,30 @@
+package dto
+
+// TranslationInput представляет входные данные для создания или обновления перевода статьи.
+type TranslationInput struct {
+	Title     string `json:"title" binding:"required,max=255"` // Заголовок перевода.
+	Text      string `json:"text" binding:"required"`          // Текст перевода в формате Markdown.
+	Published bool   `json:"published"`                        // Опубликован ли перевод.
+}
+
+// TranslationResponse представляет ответ с данными перевода статьи.
+type TranslationResponse struct {
+	ID           uint         `json:"id"`            // Уникальный идентификатор перевода.
+	ArticleID    uint         `json:"article_id"`    // Идентификатор статьи.
+	Locale       string       `json:"locale"`        // Локаль перевода.
+	Title        string       `json:"title"`         // Заголовок перевода.
+	TextMarkdown string       `json:"text_markdown"` // Текст перевода в формате Markdown.
+	TextHTML     string       `json:"text_html"`     // Текст перевода, отрендеренный в безопасный HTML.
+	Published    bool         `json:"published"`     // Опубликован ли перевод.
+	CreatedAt    string       `json:"created_at"`    // Дата создания.
+	UpdatedAt    string       `json:"updated_at"`    // Дата обновления.
+	Stats        ContentStats `json:"stats"`         // Статистика текста перевода.
+}
+
+// MissingTranslationResponse описывает статью, для которой не хватает переводов.
+type MissingTranslationResponse struct {
+	ArticleID      uint     `json:"article_id"`      // Идентификатор статьи.
+	Title          string   `json:"title"`           // Заголовок статьи на исходной локали.
+	Locale         string   `json:"locale"`          // Исходная локаль статьи.
+	MissingLocales []string `json:"missing_locales"` // Локали, на которые статья не переведена.
+}
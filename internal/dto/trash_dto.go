@@ -0,0 +1,12 @@
+package dto
+
+// TrashItemResponse представляет запись, находящуюся в корзине.
+type TrashItemResponse struct {
+	Type      string `json:"type"`                 // Тип записи ("article", "comment" или "media").
+	ID        uint   `json:"id"`                   // Идентификатор записи.
+	AuthorID  uint   `json:"author_id"`            // Идентификатор автора.
+	ArticleID *uint  `json:"article_id,omitempty"` // Идентификатор статьи (для комментариев и медиафайлов).
+	Title     string `json:"title"`                // Заголовок статьи, начало комментария или путь к файлу.
+	DeletedAt string `json:"deleted_at"`           // Дата удаления.
+	PurgeAt   string `json:"purge_at"`             // Дата окончательного удаления.
+}
@@ -0,0 +1,57 @@
+package feeds
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// Content-Type для поддерживаемых форматов лент.
+const (
+	ContentTypeRSS  = "application/rss+xml; charset=utf-8"
+	ContentTypeAtom = "application/atom+xml; charset=utf-8"
+	ContentTypeJSON = "application/feed+json; charset=utf-8"
+)
+
+// Feed описывает ленту независимо от формата вывода.
+type Feed struct {
+	Title       string    // Название ленты.
+	Description string    // Описание ленты.
+	Link        string    // Адрес сайта.
+	FeedURL     string    // Адрес самой ленты.
+	Language    string    // Язык ленты.
+	Updated     time.Time // Время последнего изменения записей ленты.
+	Items       []Item    // Записи ленты, начиная с новых.
+}
+
+// Item описывает запись ленты.
+type Item struct {
+	ID          string      // Постоянный идентификатор записи.
+	Title       string      // Заголовок.
+	Link        string      // Адрес записи.
+	Summary     string      // Краткое содержание простым текстом.
+	ContentHTML string      // Полный текст в HTML.
+	AuthorName  string      // Имя автора.
+	Categories  []string    // Рубрика и теги.
+	Published   time.Time   // Дата публикации.
+	Updated     time.Time   // Дата последнего изменения.
+	Enclosures  []Enclosure // Вложения (медиафайлы).
+}
+
+// Enclosure описывает вложение записи ленты.
+type Enclosure struct {
+	URL    string // Абсолютный адрес файла.
+	Type   string // MIME-тип файла.
+	Length int64  // Размер файла в байтах.
+}
+
+// ETag возвращает слабый валидатор ленты, зависящий от её адреса, формата и состава записей.
+func (f *Feed) ETag(format string) string {
+	hash := sha1.New()
+	fmt.Fprintf(hash, "%s|%s|%d", format, f.FeedURL, len(f.Items))
+	for _, item := range f.Items {
+		fmt.Fprintf(hash, "|%s@%d", item.ID, item.Updated.UnixNano())
+	}
+	return `W/"` + hex.EncodeToString(hash.Sum(nil)) + `"`
+}
@@ -0,0 +1,206 @@
+package feeds
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+var moscow = time.FixedZone("MSK", 3*60*60)
+
+// sampleFeed возвращает ленту с символами, которые экранируются в XML и HTML,
+// и с датами не в UTC.
+func sampleFeed() *Feed {
+	published := time.Date(2024, 3, 1, 13, 0, 0, 0, moscow)
+	return &Feed{
+		Title:    "Tom & Jerry's blog",
+		Link:     "https://example.com",
+		FeedURL:  "https://example.com/feed.xml",
+		Language: "ru",
+		Updated:  published,
+		Items: []Item{{
+			ID:          "https://example.com/articles/1",
+			Title:       `Q&A: "quotes" & <tags>`,
+			Link:        "https://example.com/articles/1",
+			Summary:     "a < b",
+			ContentHTML: "<p>Tom &amp; Jerry</p><pre>]]></pre>",
+			AuthorName:  "Анна",
+			Categories:  []string{"R&D", "Go"},
+			Published:   published,
+			Updated:     published.Add(time.Hour),
+			Enclosures: []Enclosure{
+				{URL: "https://example.com/media/1.png?w=1&h=2", Type: "image/png", Length: 42},
+				{URL: "https://example.com/media/2.png", Type: "image/png", Length: 7},
+			},
+		}},
+	}
+}
+
+func TestRenderRSSRoundTrip(t *testing.T) {
+	body, err := RenderRSS(sampleFeed())
+	if err != nil {
+		t.Fatalf("RenderRSS() error = %v", err)
+	}
+	var doc struct {
+		Channel struct {
+			Title         string `xml:"title"`
+			LastBuildDate string `xml:"lastBuildDate"`
+			Items         []struct {
+				Title      string   `xml:"title"`
+				Content    string   `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+				Categories []string `xml:"category"`
+				GUID       struct {
+					IsPermaLink bool `xml:"isPermaLink,attr"`
+				} `xml:"guid"`
+				PubDate    string `xml:"pubDate"`
+				Enclosures []struct {
+					URL string `xml:"url,attr"`
+				} `xml:"enclosure"`
+			} `xml:"item"`
+		} `xml:"channel"`
+	}
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("RSS is not valid XML: %v\n%s", err, body)
+	}
+	item := doc.Channel.Items[0]
+	if doc.Channel.Title != "Tom & Jerry's blog" || item.Title != `Q&A: "quotes" & <tags>` {
+		t.Errorf("titles = %q, %q; want them unchanged after decoding", doc.Channel.Title, item.Title)
+	}
+	if item.Content != "<p>Tom &amp; Jerry</p><pre>]]></pre>" {
+		t.Errorf("content:encoded = %q, want the HTML unchanged even with a CDATA terminator", item.Content)
+	}
+	if strings.Join(item.Categories, ",") != "R&D,Go" {
+		t.Errorf("categories = %v", item.Categories)
+	}
+	if !item.GUID.IsPermaLink {
+		t.Error("guid isPermaLink = false, want true when the ID is the article URL")
+	}
+	if item.PubDate != "Fri, 01 Mar 2024 10:00:00 GMT" || doc.Channel.LastBuildDate != item.PubDate {
+		t.Errorf("pubDate = %q, lastBuildDate = %q; want RFC 1123 dates in GMT", item.PubDate, doc.Channel.LastBuildDate)
+	}
+	if len(item.Enclosures) != 1 || item.Enclosures[0].URL != "https://example.com/media/1.png?w=1&h=2" {
+		t.Errorf("enclosures = %+v, want only the first one", item.Enclosures)
+	}
+}
+
+func TestRenderRSSGUIDIsNotPermaLinkForOtherIDs(t *testing.T) {
+	feed := sampleFeed()
+	feed.Items[0].ID = "urn:article:1"
+	body, err := RenderRSS(feed)
+	if err != nil {
+		t.Fatalf("RenderRSS() error = %v", err)
+	}
+	if !strings.Contains(string(body), `<guid isPermaLink="false">urn:article:1</guid>`) {
+		t.Errorf("RSS guid is not marked as a non-permalink:\n%s", body)
+	}
+}
+
+func TestRenderAtomRoundTrip(t *testing.T) {
+	body, err := RenderAtom(sampleFeed())
+	if err != nil {
+		t.Fatalf("RenderAtom() error = %v", err)
+	}
+	var doc struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		Updated string   `xml:"updated"`
+		Entries []struct {
+			Title     string `xml:"title"`
+			Published string `xml:"published"`
+			Updated   string `xml:"updated"`
+			Links     []struct {
+				Rel  string `xml:"rel,attr"`
+				Href string `xml:"href,attr"`
+			} `xml:"link"`
+			Content struct {
+				Type string `xml:"type,attr"`
+				Body string `xml:",chardata"`
+			} `xml:"content"`
+		} `xml:"entry"`
+	}
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("Atom is not valid XML: %v\n%s", err, body)
+	}
+	entry := doc.Entries[0]
+	if doc.Updated != "2024-03-01T10:00:00Z" || entry.Published != "2024-03-01T10:00:00Z" || entry.Updated != "2024-03-01T11:00:00Z" {
+		t.Errorf("dates = %q, %q, %q; want RFC 3339 in UTC", doc.Updated, entry.Published, entry.Updated)
+	}
+	if entry.Content.Type != "html" || entry.Content.Body != "<p>Tom &amp; Jerry</p><pre>]]></pre>" {
+		t.Errorf("content = %+v", entry.Content)
+	}
+	var enclosures int
+	for _, link := range entry.Links {
+		if link.Rel == "enclosure" {
+			enclosures++
+		}
+	}
+	if enclosures != 2 {
+		t.Errorf("enclosure links = %d, want every enclosure", enclosures)
+	}
+}
+
+func TestRenderAtomWithoutUpdatedUsesCurrentTime(t *testing.T) {
+	feed := &Feed{Title: "Empty", FeedURL: "https://example.com/atom.xml"}
+	body, err := RenderAtom(feed)
+	if err != nil {
+		t.Fatalf("RenderAtom() error = %v", err)
+	}
+	var doc struct {
+		Updated string `xml:"updated"`
+	}
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("Atom is not valid XML: %v", err)
+	}
+	updated, err := time.Parse(time.RFC3339, doc.Updated)
+	if err != nil || time.Since(updated) > time.Minute {
+		t.Errorf("updated = %q, want the current time", doc.Updated)
+	}
+}
+
+func TestRenderJSON(t *testing.T) {
+	body, err := RenderJSON(sampleFeed())
+	if err != nil {
+		t.Fatalf("RenderJSON() error = %v", err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Fatalf("JSON Feed is not valid JSON: %v", err)
+	}
+	if doc["version"] != "https://jsonfeed.org/version/1.1" {
+		t.Errorf("version = %v", doc["version"])
+	}
+	item := doc["items"].([]interface{})[0].(map[string]interface{})
+	if item["title"] != `Q&A: "quotes" & <tags>` || item["date_published"] != "2024-03-01T10:00:00Z" {
+		t.Errorf("item = %v", item)
+	}
+	if attachments := item["attachments"].([]interface{}); len(attachments) != 2 {
+		t.Errorf("attachments = %v, want every enclosure", attachments)
+	}
+
+	empty, err := RenderJSON(&Feed{Title: "Empty"})
+	if err != nil {
+		t.Fatalf("RenderJSON() error = %v", err)
+	}
+	if !strings.Contains(string(empty), `"items": []`) {
+		t.Errorf("empty feed must have an empty items array:\n%s", empty)
+	}
+}
+
+func TestFeedETag(t *testing.T) {
+	feed := sampleFeed()
+	rss := feed.ETag("rss")
+	if !strings.HasPrefix(rss, `W/"`) {
+		t.Errorf("ETag() = %q, want a weak validator", rss)
+	}
+	if rss != sampleFeed().ETag("rss") {
+		t.Error("ETag() differs for the same feed")
+	}
+	if rss == feed.ETag("atom") {
+		t.Error("ETag() is the same for different formats")
+	}
+	feed.Items[0].Updated = feed.Items[0].Updated.Add(time.Second)
+	if rss == feed.ETag("rss") {
+		t.Error("ETag() did not change after an item was updated")
+	}
+}
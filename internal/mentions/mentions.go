@@ -0,0 +1,55 @@
+// Package mentions находит упоминания пользователей (@username) в тексте комментариев.
+package mentions
+
+import (
+	"html"
+	"regexp"
+	"strings"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// MaxMentions — максимальное количество различных упоминаний в одном тексте;
+// остальные упоминания игнорируются, чтобы комментарий не мог разослать массовые уведомления.
+const MaxMentions = 10
+
+// mentionPattern находит @username, перед которым нет буквы, цифры или символа имени:
+// адреса электронной почты вида user@example.com упоминаниями не считаются.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.@-])@([\p{L}\p{N}_][\p{L}\p{N}_.-]{0,63})`)
+
+// codePattern находит элементы code и pre, а codeSpanPattern — фрагменты в обратных кавычках:
+// @username в примерах кода упоминанием не считается.
+var (
+	codePattern     = regexp.MustCompile(`(?is)<pre\b[^>]*>.*?</pre>|<code\b[^>]*>.*?</code>`)
+	codeSpanPattern = regexp.MustCompile("`[^`\n]*`")
+)
+
+// textPolicy удаляет разметку, заменяя теги пробелами, чтобы упоминания в соседних
+// элементах (например, в конце абзаца и в начале следующего) не склеивались.
+var textPolicy = bluemonday.StrictPolicy().AddSpaceWhenStrippingTag(true)
+
+// Parse возвращает имена упомянутых пользователей в нижнем регистре без повторов,
+// в порядке первого упоминания. Текст может содержать HTML-разметку; упоминания внутри
+// кода пропускаются.
+func Parse(text string) []string {
+	text = codePattern.ReplaceAllString(text, " ")
+	text = html.UnescapeString(textPolicy.Sanitize(text))
+	text = codeSpanPattern.ReplaceAllString(text, " ")
+	seen := make(map[string]struct{})
+	usernames := []string{}
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		username := strings.ToLower(strings.TrimRight(match[1], ".-"))
+		if username == "" {
+			continue
+		}
+		if _, ok := seen[username]; ok {
+			continue
+		}
+		seen[username] = struct{}{}
+		usernames = append(usernames, username)
+		if len(usernames) == MaxMentions {
+			break
+		}
+	}
+	return usernames
+}
@@ -0,0 +1,48 @@
+package mentions
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{"@alice, @bob! (@carol) @dave: @eve? «@frank»", []string{"alice", "bob", "carol", "dave", "eve", "frank"}},
+		{"thanks @alice. and @bob-", []string{"alice", "bob"}},
+		{"@first.last and @with_under-score", []string{"first.last", "with_under-score"}},
+		{"write to a@b.com or alice@example.org", []string{}},
+		{"user.@alice x-@bob @@carol", []string{}},
+		{"@Alice @alice @ALICE @bob @Alice", []string{"alice", "bob"}},
+		{"@Иван и @иван", []string{"иван"}},
+		{"@", []string{}},
+		{"@... @-dash", []string{}},
+		{"<p>hi <b>@alice</b></p><a href=\"/u/bob\">@bob</a>", []string{"alice", "bob"}},
+		{"see <code>@admin</code> and <pre><code class=\"go\">\n// @root\n</code></pre> ping @alice", []string{"alice"}},
+		{"use `@admin` or `git blame @{upstream}` then @bob", []string{"bob"}},
+		{"an unpaired ` before @alice", []string{"alice"}},
+	}
+	for _, tt := range tests {
+		if got := Parse(tt.text); !slices.Equal(got, tt.want) {
+			t.Errorf("Parse(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestParseLimitsMentions(t *testing.T) {
+	var names []string
+	for i := range MaxMentions + 5 {
+		names = append(names, fmt.Sprintf("@user%d @USER%d", i, i))
+	}
+	got := Parse(strings.Join(names, " "))
+	if len(got) != MaxMentions {
+		t.Fatalf("Parse() returned %d mentions, want %d", len(got), MaxMentions)
+	}
+	if got[0] != "user0" || got[MaxMentions-1] != fmt.Sprintf("user%d", MaxMentions-1) {
+		t.Errorf("Parse() = %q, want the first %d distinct mentions in order", got, MaxMentions)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
 	"github.com/AsterOzlob/content_managment_api/internal/logger"
+	"github.com/AsterOzlob/content_managment_api/internal/services"
 )
 
 // StartTokenCleanupScheduler запускает планировщик для очистки истекших токенов.
@@ -21,3 +22,16 @@ func StartTokenCleanupScheduler(refreshTokenRepo *repositories.RefreshTokenRepos
 		}
 	}()
 }
+
+// StartTrashPurgeScheduler запускает планировщик окончательной очистки корзины.
+func StartTrashPurgeScheduler(trashService *services.TrashService, interval time.Duration, logger logger.Logger) {
+	go func() {
+		for {
+			logger.Info("Running scheduled trash purge")
+			if err := trashService.Purge(); err != nil {
+				logger.WithError(err).Error("Error during trash purge")
+			}
+			time.Sleep(interval)
+		}
+	}()
+}
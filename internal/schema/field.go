@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Типы полей пользовательских типов контента.
+const (
+	FieldString   = "string"
+	FieldRichText = "rich_text"
+	FieldNumber   = "number"
+	FieldDate     = "date"
+	FieldMedia    = "media"
+	FieldEnum     = "enum"
+	FieldList     = "list"
+)
+
+// Field описывает одно поле типа контента.
+type Field struct {
+	Name      string   `json:"name"`                 // Ключ поля в данных записи.
+	Label     string   `json:"label,omitempty"`      // Отображаемое название поля.
+	Type      string   `json:"type"`                 // Тип поля.
+	Required  bool     `json:"required,omitempty"`   // Обязательно ли поле.
+	MaxLength int      `json:"max_length,omitempty"` // Максимальная длина строки (string, rich_text).
+	Min       *float64 `json:"min,omitempty"`        // Минимальное значение (number).
+	Max       *float64 `json:"max,omitempty"`        // Максимальное значение (number).
+	Options   []string `json:"options,omitempty"`    // Допустимые значения (enum).
+	ItemType  string   `json:"item_type,omitempty"`  // Тип элементов списка (list).
+	MaxItems  int      `json:"max_items,omitempty"`  // Максимальное количество элементов (list).
+}
+
+// Fields — упорядоченный список полей, хранящийся в БД как JSON.
+type Fields []Field
+
+// Value сериализует список полей в JSON для записи в БД.
+func (f Fields) Value() (driver.Value, error) {
+	return marshalJSON(f)
+}
+
+// Scan десериализует список полей из JSON, прочитанного из БД.
+func (f *Fields) Scan(value interface{}) error {
+	return unmarshalJSON(value, f)
+}
+
+// Values — данные записи, ключами которых являются имена полей.
+type Values map[string]interface{}
+
+// Value сериализует данные записи в JSON для записи в БД.
+func (v Values) Value() (driver.Value, error) {
+	return marshalJSON(v)
+}
+
+// Scan десериализует данные записи из JSON, прочитанного из БД.
+func (v *Values) Scan(value interface{}) error {
+	return unmarshalJSON(value, v)
+}
+
+func marshalJSON(value interface{}) (driver.Value, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func unmarshalJSON(value interface{}, target interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, target)
+	case string:
+		return json.Unmarshal([]byte(v), target)
+	default:
+		return fmt.Errorf("unsupported type for JSON column: %T", value)
+	}
+}
@@ -0,0 +1,232 @@
+package schema
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// Ограничения по умолчанию.
+const (
+	MaxFields              = 100
+	defaultStringMaxLength = 255
+	defaultRichTextLength  = 100000
+)
+
+// namePattern ограничивает допустимые имена полей.
+var namePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// dateLayouts — допустимые форматы значений полей типа date.
+var dateLayouts = []string{time.RFC3339, time.DateOnly}
+
+// scalarTypes — типы, допустимые для элементов списка.
+var scalarTypes = []string{FieldString, FieldRichText, FieldNumber, FieldDate, FieldMedia, FieldEnum}
+
+// stringPolicy удаляет любую разметку из строковых полей.
+var stringPolicy = bluemonday.StrictPolicy()
+
+// ValidationError описывает ошибку в определении поля или в значении поля записи.
+type ValidationError struct {
+	Field   string // Имя поля.
+	Message string // Описание ошибки.
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("field %q: %s", e.Field, e.Message)
+}
+
+// ValidateFields проверяет корректность определений полей типа контента.
+func ValidateFields(fields Fields) error {
+	if len(fields) > MaxFields {
+		return &ValidationError{Message: fmt.Sprintf("content type must have at most %d fields", MaxFields)}
+	}
+	seen := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		if !namePattern.MatchString(field.Name) {
+			return &ValidationError{Field: field.Name, Message: "name must start with a lowercase letter and contain only a-z, 0-9 and _"}
+		}
+		if seen[field.Name] {
+			return &ValidationError{Field: field.Name, Message: "duplicate field name"}
+		}
+		seen[field.Name] = true
+
+		if field.Type == FieldList {
+			if !slices.Contains(scalarTypes, field.ItemType) {
+				return &ValidationError{Field: field.Name, Message: "item_type must be a non-list field type"}
+			}
+		} else if !slices.Contains(scalarTypes, field.Type) {
+			return &ValidationError{Field: field.Name, Message: "unknown field type"}
+		}
+		if (field.Type == FieldEnum || field.ItemType == FieldEnum) && len(field.Options) == 0 {
+			return &ValidationError{Field: field.Name, Message: "enum field requires options"}
+		}
+		if field.Min != nil && field.Max != nil && *field.Min > *field.Max {
+			return &ValidationError{Field: field.Name, Message: "min must not exceed max"}
+		}
+		if field.MaxLength < 0 || field.MaxItems < 0 {
+			return &ValidationError{Field: field.Name, Message: "limits must not be negative"}
+		}
+	}
+	return nil
+}
+
+// ValidateValues проверяет данные записи по определению полей и возвращает санитизированную копию.
+// Строковые поля очищаются от разметки, поля rich_text — санитизируются как пользовательский HTML.
+func ValidateValues(fields Fields, values Values) (Values, error) {
+	known := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		known[field.Name] = true
+	}
+	for name := range values {
+		if !known[name] {
+			return nil, &ValidationError{Field: name, Message: "unknown field"}
+		}
+	}
+
+	cleaned := make(Values, len(values))
+	for _, field := range fields {
+		value, ok := values[field.Name]
+		if !ok || value == nil {
+			if field.Required {
+				return nil, &ValidationError{Field: field.Name, Message: "field is required"}
+			}
+			continue
+		}
+		var (
+			result  interface{}
+			message string
+		)
+		if field.Type == FieldList {
+			result, message = validateList(field, value)
+		} else {
+			result, message = validateScalar(field, field.Type, value)
+		}
+		if message != "" {
+			return nil, &ValidationError{Field: field.Name, Message: message}
+		}
+		cleaned[field.Name] = result
+	}
+	return cleaned, nil
+}
+
+// MediaIDs возвращает идентификаторы медиафайлов, на которые ссылаются санитизированные данные записи.
+func MediaIDs(fields Fields, values Values) []uint {
+	var ids []uint
+	for _, field := range fields {
+		switch {
+		case field.Type == FieldMedia:
+			if id, ok := values[field.Name].(uint); ok {
+				ids = append(ids, id)
+			}
+		case field.Type == FieldList && field.ItemType == FieldMedia:
+			if items, ok := values[field.Name].([]interface{}); ok {
+				for _, item := range items {
+					if id, ok := item.(uint); ok {
+						ids = append(ids, id)
+					}
+				}
+			}
+		}
+	}
+	return ids
+}
+
+// validateList проверяет значение поля-списка и каждый его элемент.
+func validateList(field Field, value interface{}) (interface{}, string) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, "must be a list"
+	}
+	if field.MaxItems > 0 && len(items) > field.MaxItems {
+		return nil, fmt.Sprintf("must contain at most %d items", field.MaxItems)
+	}
+	if field.Required && len(items) == 0 {
+		return nil, "field is required"
+	}
+	result := make([]interface{}, 0, len(items))
+	for i, item := range items {
+		cleaned, message := validateScalar(field, field.ItemType, item)
+		if message != "" {
+			return nil, fmt.Sprintf("item %d: %s", i, message)
+		}
+		result = append(result, cleaned)
+	}
+	return result, ""
+}
+
+// validateScalar проверяет и нормализует одиночное значение указанного типа.
+func validateScalar(field Field, fieldType string, value interface{}) (interface{}, string) {
+	switch fieldType {
+	case FieldString, FieldRichText:
+		text, ok := value.(string)
+		if !ok {
+			return nil, "must be a string"
+		}
+		maxLength := field.MaxLength
+		if maxLength == 0 {
+			maxLength = defaultStringMaxLength
+			if fieldType == FieldRichText {
+				maxLength = defaultRichTextLength
+			}
+		}
+		if utf8.RuneCountInString(text) > maxLength {
+			return nil, fmt.Sprintf("must be at most %d characters", maxLength)
+		}
+		if field.Required && strings.TrimSpace(text) == "" {
+			return nil, "field is required"
+		}
+		if fieldType == FieldRichText {
+			return utils.Sanitize(text), ""
+		}
+		return stringPolicy.Sanitize(text), ""
+	case FieldNumber:
+		number, ok := value.(float64)
+		if !ok || math.IsNaN(number) || math.IsInf(number, 0) {
+			return nil, "must be a number"
+		}
+		if field.Min != nil && number < *field.Min {
+			return nil, fmt.Sprintf("must be at least %v", *field.Min)
+		}
+		if field.Max != nil && number > *field.Max {
+			return nil, fmt.Sprintf("must be at most %v", *field.Max)
+		}
+		return number, ""
+	case FieldDate:
+		text, ok := value.(string)
+		if !ok {
+			return nil, "must be a date string"
+		}
+		for _, layout := range dateLayouts {
+			if parsed, err := time.Parse(layout, text); err == nil {
+				if layout == time.DateOnly {
+					return parsed.Format(time.DateOnly), ""
+				}
+				return parsed.UTC().Format(time.RFC3339), ""
+			}
+		}
+		return nil, "must be a date in YYYY-MM-DD or RFC 3339 format"
+	case FieldMedia:
+		number, ok := value.(float64)
+		if !ok || number < 1 || number != math.Trunc(number) || number > math.MaxUint32 {
+			return nil, "must be a media ID"
+		}
+		return uint(number), ""
+	case FieldEnum:
+		text, ok := value.(string)
+		if !ok || !slices.Contains(field.Options, text) {
+			return nil, "must be one of: " + strings.Join(field.Options, ", ")
+		}
+		return text, ""
+	}
+	return nil, "unknown field type"
+}
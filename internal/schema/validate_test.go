@@ -0,0 +1,147 @@
+package schema
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func ptr(v float64) *float64 {
+	return &v
+}
+
+// decode разбирает данные записи так же, как их получает сервис из тела запроса.
+func decode(t *testing.T, data string) Values {
+	t.Helper()
+	var values Values
+	if err := json.Unmarshal([]byte(data), &values); err != nil {
+		t.Fatalf("json.Unmarshal(%s) error = %v", data, err)
+	}
+	return values
+}
+
+var eventFields = Fields{
+	{Name: "title", Type: FieldString, Required: true, MaxLength: 20},
+	{Name: "body", Type: FieldRichText},
+	{Name: "price", Type: FieldNumber, Min: ptr(0)},
+	{Name: "starts_at", Type: FieldDate},
+	{Name: "cover", Type: FieldMedia},
+	{Name: "format", Type: FieldEnum, Options: []string{"online", "offline"}},
+	{Name: "gallery", Type: FieldList, ItemType: FieldMedia, MaxItems: 2},
+	{Name: "speakers", Type: FieldList, ItemType: FieldString, Required: true},
+}
+
+func TestValidateFieldsAcceptsEventSchema(t *testing.T) {
+	if err := ValidateFields(eventFields); err != nil {
+		t.Fatalf("ValidateFields() error = %v, want nil", err)
+	}
+}
+
+func TestValidateFieldsRejectsBrokenDefinitions(t *testing.T) {
+	for want, fields := range map[string]Fields{
+		"name must start with a lowercase letter": {{Name: "Title", Type: FieldString}},
+		"duplicate field name":                    {{Name: "a", Type: FieldString}, {Name: "a", Type: FieldNumber}},
+		"unknown field type":                      {{Name: "a", Type: "json"}},
+		"item_type must be a non-list field type": {{Name: "a", Type: FieldList, ItemType: FieldList}},
+		"enum field requires options":             {{Name: "a", Type: FieldList, ItemType: FieldEnum}},
+		"min must not exceed max":                 {{Name: "a", Type: FieldNumber, Min: ptr(2), Max: ptr(1)}},
+		"limits must not be negative":             {{Name: "a", Type: FieldList, ItemType: FieldString, MaxItems: -1}},
+	} {
+		if err := ValidateFields(fields); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("ValidateFields(%+v) error = %v, want %q", fields, err, want)
+		}
+	}
+
+	tooMany := make(Fields, MaxFields+1)
+	if err := ValidateFields(tooMany); err == nil || err.Error() != "content type must have at most 100 fields" {
+		t.Errorf("ValidateFields() with %d fields error = %v", len(tooMany), err)
+	}
+}
+
+func TestValidateValuesNormalizesJSONInput(t *testing.T) {
+	values := decode(t, `{
+		"title": "<b>Конференция</b>",
+		"body": "<p>ok</p><script>alert(1)</script>",
+		"price": 0,
+		"starts_at": "2024-03-01T10:00:00+03:00",
+		"cover": 3,
+		"format": "online",
+		"gallery": [1, 2],
+		"speakers": ["Анна"]
+	}`)
+
+	got, err := ValidateValues(eventFields, values)
+	if err != nil {
+		t.Fatalf("ValidateValues() error = %v", err)
+	}
+	want := Values{
+		"title":     "Конференция",
+		"body":      "<p>ok</p>",
+		"price":     0.0,
+		"starts_at": "2024-03-01T07:00:00Z",
+		"cover":     uint(3),
+		"format":    "online",
+		"gallery":   []interface{}{uint(1), uint(2)},
+		"speakers":  []interface{}{"Анна"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ValidateValues() = %#v\nwant %#v", got, want)
+	}
+	if ids := MediaIDs(eventFields, got); !reflect.DeepEqual(ids, []uint{3, 1, 2}) {
+		t.Errorf("MediaIDs() = %v, want [3 1 2]", ids)
+	}
+}
+
+func TestValidateValuesKeepsDateOnlyValues(t *testing.T) {
+	got, err := ValidateValues(eventFields, decode(t, `{"title": "x", "speakers": ["a"], "starts_at": "2024-03-01"}`))
+	if err != nil {
+		t.Fatalf("ValidateValues() error = %v", err)
+	}
+	if got["starts_at"] != "2024-03-01" {
+		t.Errorf("starts_at = %v, want the date unchanged", got["starts_at"])
+	}
+}
+
+func TestValidateValuesCountsRunes(t *testing.T) {
+	title := strings.Repeat("я", 20)
+	if _, err := ValidateValues(eventFields, Values{"title": title, "speakers": []interface{}{"a"}}); err != nil {
+		t.Errorf("title of 20 Cyrillic letters: error = %v, want nil", err)
+	}
+}
+
+func TestValidateValuesRejects(t *testing.T) {
+	for _, tt := range []struct {
+		data string
+		want string
+	}{
+		{`{"speakers": ["a"]}`, `field "title": field is required`},
+		{`{"title": null, "speakers": ["a"]}`, `field "title": field is required`},
+		{`{"title": "   ", "speakers": ["a"]}`, `field "title": field is required`},
+		{`{"title": "x", "speakers": []}`, `field "speakers": field is required`},
+		{`{"title": "x", "speakers": ["a"], "extra": 1}`, `field "extra": unknown field`},
+		{`{"title": "x", "speakers": ["a"], "price": "5"}`, `field "price": must be a number`},
+		{`{"title": "x", "speakers": ["a"], "price": -0.5}`, `field "price": must be at least 0`},
+		{`{"title": "x", "speakers": ["a"], "starts_at": "01.03.2024"}`, "must be a date in YYYY-MM-DD or RFC 3339 format"},
+		{`{"title": "x", "speakers": ["a"], "cover": 0}`, `field "cover": must be a media ID`},
+		{`{"title": "x", "speakers": ["a"], "cover": 1.5}`, `field "cover": must be a media ID`},
+		{`{"title": "x", "speakers": ["a"], "cover": 1e12}`, `field "cover": must be a media ID`},
+		{`{"title": "x", "speakers": ["a"], "format": "Online"}`, "must be one of: online, offline"},
+		{`{"title": "x", "speakers": ["a"], "gallery": 1}`, `field "gallery": must be a list`},
+		{`{"title": "x", "speakers": ["a"], "gallery": [1, 2, 3]}`, "must contain at most 2 items"},
+		{`{"title": "x", "speakers": ["a"], "gallery": [1, "2"]}`, `field "gallery": item 1: must be a media ID`},
+	} {
+		_, err := ValidateValues(eventFields, decode(t, tt.data))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ValidateValues(%s) error = %v, want %q", tt.data, err, tt.want)
+		}
+	}
+}
+
+func TestValidateValuesDefaultStringLimit(t *testing.T) {
+	fields := Fields{{Name: "note", Type: FieldString}}
+	_, err := ValidateValues(fields, Values{"note": strings.Repeat("a", defaultStringMaxLength+1)})
+	if err == nil || !strings.Contains(err.Error(), "must be at most 255 characters") {
+		t.Errorf("ValidateValues() error = %v, want the default limit", err)
+	}
+}
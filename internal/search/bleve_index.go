@@ -0,0 +1,174 @@
+package search
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/mapping"
+)
+
+// deleteBatchSize — количество документов, удаляемых за одну итерацию DeleteByArticle.
+const deleteBatchSize = 500
+
+// BleveIndex реализует SearchIndex на основе встроенного дискового индекса Bleve.
+type BleveIndex struct {
+	mu    sync.RWMutex
+	path  string
+	index bleve.Index
+}
+
+// NewBleveIndex открывает индекс Bleve по указанному пути или создаёт новый.
+func NewBleveIndex(path string) (*BleveIndex, error) {
+	index, err := bleve.Open(path)
+	if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+		index, err = bleve.New(path, newBleveMapping())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bleve index: %w", err)
+	}
+	return &BleveIndex{path: path, index: index}, nil
+}
+
+// newBleveMapping описывает схему документов индекса.
+func newBleveMapping() mapping.IndexMapping {
+	keyword := bleve.NewKeywordFieldMapping()
+	text := bleve.NewTextFieldMapping()
+	numeric := bleve.NewNumericFieldMapping()
+	boolean := bleve.NewBooleanFieldMapping()
+
+	doc := bleve.NewDocumentMapping()
+	doc.AddFieldMappingsAt("type", keyword)
+	doc.AddFieldMappingsAt("entity_id", numeric)
+	doc.AddFieldMappingsAt("article_id", numeric)
+	doc.AddFieldMappingsAt("title", text)
+	doc.AddFieldMappingsAt("text", text)
+	doc.AddFieldMappingsAt("published", boolean)
+
+	indexMapping := bleve.NewIndexMapping()
+	indexMapping.DefaultMapping = doc
+	return indexMapping
+}
+
+// documentID формирует идентификатор документа в индексе Bleve.
+func documentID(docType string, entityID uint) string {
+	return docType + ":" + strconv.FormatUint(uint64(entityID), 10)
+}
+
+// Index добавляет документ в индекс или обновляет существующий.
+func (i *BleveIndex) Index(doc Document) error {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.index.Index(documentID(doc.Type, doc.EntityID), map[string]interface{}{
+		"type":       doc.Type,
+		"entity_id":  float64(doc.EntityID),
+		"article_id": float64(doc.ArticleID),
+		"title":      doc.Title,
+		"text":       doc.Text,
+		"published":  doc.Published,
+	})
+}
+
+// Delete удаляет документ из индекса.
+func (i *BleveIndex) Delete(docType string, entityID uint) error {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.index.Delete(documentID(docType, entityID))
+}
+
+// DeleteByArticle удаляет статью и все связанные с ней документы.
+func (i *BleveIndex) DeleteByArticle(articleID uint) error {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	value := float64(articleID)
+	inclusive := true
+	query := bleve.NewNumericRangeInclusiveQuery(&value, &value, &inclusive, &inclusive)
+	query.SetField("article_id")
+
+	for {
+		result, err := i.index.Search(bleve.NewSearchRequestOptions(query, deleteBatchSize, 0, false))
+		if err != nil {
+			return err
+		}
+		if len(result.Hits) == 0 {
+			return nil
+		}
+		batch := i.index.NewBatch()
+		for _, hit := range result.Hits {
+			batch.Delete(hit.ID)
+		}
+		if err := i.index.Batch(batch); err != nil {
+			return err
+		}
+	}
+}
+
+// Search выполняет поиск по заголовку и тексту опубликованных документов.
+func (i *BleveIndex) Search(query string, limit, offset int) ([]Hit, error) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	titleQuery := bleve.NewMatchQuery(query)
+	titleQuery.SetField("title")
+	titleQuery.SetBoost(2)
+	textQuery := bleve.NewMatchQuery(query)
+	textQuery.SetField("text")
+	publishedQuery := bleve.NewBoolFieldQuery(true)
+	publishedQuery.SetField("published")
+
+	request := bleve.NewSearchRequestOptions(
+		bleve.NewConjunctionQuery(bleve.NewDisjunctionQuery(titleQuery, textQuery), publishedQuery),
+		limit, offset, false,
+	)
+	request.Fields = []string{"type", "entity_id", "article_id", "title"}
+
+	result, err := i.index.Search(request)
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]Hit, 0, len(result.Hits))
+	for _, match := range result.Hits {
+		hit := Hit{Score: match.Score}
+		hit.Type, _ = match.Fields["type"].(string)
+		hit.Title, _ = match.Fields["title"].(string)
+		if entityID, ok := match.Fields["entity_id"].(float64); ok {
+			hit.EntityID = uint(entityID)
+		}
+		if articleID, ok := match.Fields["article_id"].(float64); ok {
+			hit.ArticleID = uint(articleID)
+		}
+		hits = append(hits, hit)
+	}
+	return hits, nil
+}
+
+// Reset удаляет индекс с диска и создаёт его заново.
+func (i *BleveIndex) Reset() error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if err := i.index.Close(); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(i.path); err != nil {
+		return err
+	}
+	index, err := bleve.New(i.path, newBleveMapping())
+	if err != nil {
+		return fmt.Errorf("failed to recreate bleve index: %w", err)
+	}
+	i.index = index
+	return nil
+}
+
+// Close закрывает индекс.
+func (i *BleveIndex) Close() error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.index.Close()
+}
@@ -0,0 +1,69 @@
+package search
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"gorm.io/gorm"
+)
+
+// Типы документов поискового индекса.
+const (
+	DocTypeArticle = "article"
+	DocTypeComment = "comment"
+)
+
+// Поддерживаемые бэкенды поиска.
+const (
+	BackendPostgres = "postgres"
+	BackendBleve    = "bleve"
+)
+
+// Document представляет сущность, передаваемую в поисковый индекс.
+type Document struct {
+	Type      string    // Тип документа (DocTypeArticle или DocTypeComment).
+	EntityID  uint      // Идентификатор сущности.
+	ArticleID uint      // Идентификатор статьи (для статьи совпадает с EntityID).
+	Title     string    // Заголовок документа.
+	Text      string    // Текст документа.
+	Published bool      // Доступен ли документ в выдаче.
+	UpdatedAt time.Time // Дата последнего обновления сущности.
+}
+
+// Hit представляет один результат поиска.
+type Hit struct {
+	Type      string  // Тип найденного документа.
+	EntityID  uint    // Идентификатор найденной сущности.
+	ArticleID uint    // Идентификатор статьи.
+	Title     string  // Заголовок документа.
+	Score     float64 // Релевантность результата.
+}
+
+// SearchIndex — интерфейс поискового индекса, общий для всех бэкендов.
+type SearchIndex interface {
+	// Index добавляет документ в индекс или обновляет существующий.
+	Index(doc Document) error
+	// Delete удаляет документ из индекса.
+	Delete(docType string, entityID uint) error
+	// DeleteByArticle удаляет статью и все связанные с ней документы.
+	DeleteByArticle(articleID uint) error
+	// Search выполняет поиск по опубликованным документам.
+	Search(query string, limit, offset int) ([]Hit, error)
+	// Reset полностью очищает индекс.
+	Reset() error
+	// Close освобождает ресурсы индекса.
+	Close() error
+}
+
+// NewIndex создаёт поисковый индекс согласно настройкам.
+func NewIndex(cfg *config.SearchConfig, db *gorm.DB) (SearchIndex, error) {
+	switch cfg.Backend {
+	case BackendPostgres:
+		return NewPostgresIndex(db, cfg.PGLanguage), nil
+	case BackendBleve:
+		return NewBleveIndex(cfg.BlevePath)
+	default:
+		return nil, fmt.Errorf("unknown search backend: %s", cfg.Backend)
+	}
+}
@@ -0,0 +1,190 @@
+package search
+
+import (
+	"sync"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/logger"
+)
+
+// operationKind определяет тип операции над индексом.
+type operationKind int
+
+const (
+	opIndex operationKind = iota
+	opDelete
+	opDeleteArticle
+)
+
+// operation описывает отложенное изменение индекса.
+type operation struct {
+	kind     operationKind
+	doc      Document
+	docType  string
+	entityID uint
+}
+
+// Indexer асинхронно передаёт изменения контента в поисковый индекс,
+// чтобы запись статей и комментариев не ждала индексации.
+type Indexer struct {
+	index    SearchIndex
+	queue    chan operation
+	Logger   logger.Logger
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewIndexer создаёт Indexer и запускает фоновый обработчик очереди.
+func NewIndexer(index SearchIndex, queueSize int, logger logger.Logger) *Indexer {
+	indexer := &Indexer{
+		index:  index,
+		queue:  make(chan operation, queueSize),
+		Logger: logger,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	go indexer.run()
+	return indexer
+}
+
+// Stop останавливает обработчик: применяет оставшиеся в очереди операции и дожидается
+// завершения. Индекс можно закрывать только после Stop; операции, поставленные позже, отбрасываются.
+func (i *Indexer) Stop() {
+	i.stopOnce.Do(func() { close(i.stop) })
+	<-i.done
+}
+
+// run последовательно применяет операции из очереди.
+func (i *Indexer) run() {
+	defer close(i.done)
+	for {
+		select {
+		case op := <-i.queue:
+			i.apply(op)
+		case <-i.stop:
+			i.drain()
+			return
+		}
+	}
+}
+
+// drain применяет операции, оставшиеся в очереди.
+func (i *Indexer) drain() {
+	for {
+		select {
+		case op := <-i.queue:
+			i.apply(op)
+		default:
+			return
+		}
+	}
+}
+
+// apply применяет одну операцию к индексу.
+func (i *Indexer) apply(op operation) {
+	var err error
+	switch op.kind {
+	case opIndex:
+		err = i.index.Index(op.doc)
+	case opDelete:
+		err = i.index.Delete(op.docType, op.entityID)
+	case opDeleteArticle:
+		err = i.index.DeleteByArticle(op.entityID)
+	}
+	if err != nil {
+		i.Logger.WithFields(map[string]interface{}{
+			"doc_type":  op.docType,
+			"entity_id": op.entityID,
+		}).WithError(err).Error("Failed to apply search index operation")
+	}
+}
+
+// enqueue ставит операцию индексации в очередь; при переполнении операция отбрасывается
+// и будет восстановлена при следующей переиндексации.
+func (i *Indexer) enqueue(op operation) {
+	select {
+	case i.queue <- op:
+	default:
+		i.logDropped(op, "Search index queue is full, dropping operation")
+	}
+}
+
+// enqueueRemoval ставит удаление в очередь, дожидаясь свободного места: пропущенное удаление
+// оставило бы в выдаче снятый с публикации или скрытый контент до следующей переиндексации.
+// После Stop операция отбрасывается.
+func (i *Indexer) enqueueRemoval(op operation) {
+	select {
+	case i.queue <- op:
+	case <-i.stop:
+		i.logDropped(op, "Search indexer is stopped, dropping operation")
+	}
+}
+
+// logDropped записывает в журнал отброшенную операцию.
+func (i *Indexer) logDropped(op operation, message string) {
+	i.Logger.WithFields(map[string]interface{}{
+		"op":        op.kind,
+		"doc_type":  op.docType,
+		"entity_id": op.entityID,
+	}).Warn(message)
+}
+
+// IndexArticle ставит статью в очередь на индексацию.
+func (i *Indexer) IndexArticle(article *models.Article) {
+	i.enqueue(operation{kind: opIndex, doc: ArticleDocument(article), docType: DocTypeArticle, entityID: article.ID})
+}
+
+// IndexComment ставит комментарий статьи article в очередь на индексацию.
+// Неодобренные и скрытые по жалобам комментарии, а также комментарии к статьям в корзине
+// (article равен nil) вместо этого удаляются из индекса.
+func (i *Indexer) IndexComment(comment *models.Comment, article *models.Article) {
+	if article == nil || !comment.IsPublic() || comment.Tombstone {
+		i.RemoveComment(comment.ID)
+		return
+	}
+	i.enqueue(operation{kind: opIndex, doc: CommentDocument(comment, article), docType: DocTypeComment, entityID: comment.ID})
+}
+
+// IndexArticleComments заново индексирует комментарии статьи, например после изменения её видимости.
+func (i *Indexer) IndexArticleComments(article *models.Article, comments []*models.Comment) {
+	for _, comment := range comments {
+		i.IndexComment(comment, article)
+	}
+}
+
+// RemoveArticle ставит в очередь удаление статьи и её комментариев из индекса.
+func (i *Indexer) RemoveArticle(articleID uint) {
+	i.enqueueRemoval(operation{kind: opDeleteArticle, docType: DocTypeArticle, entityID: articleID})
+}
+
+// RemoveComment ставит в очередь удаление комментария из индекса.
+func (i *Indexer) RemoveComment(commentID uint) {
+	i.enqueueRemoval(operation{kind: opDelete, docType: DocTypeComment, entityID: commentID})
+}
+
+// ArticleDocument преобразует статью в документ индекса.
+func ArticleDocument(article *models.Article) Document {
+	return Document{
+		Type:      DocTypeArticle,
+		EntityID:  article.ID,
+		ArticleID: article.ID,
+		Title:     article.Title,
+		Text:      article.PlainText,
+		Published: article.IsPublic(),
+		UpdatedAt: article.UpdatedAt,
+	}
+}
+
+// CommentDocument преобразует комментарий статьи article в документ индекса.
+// Комментарий доступен в выдаче, только если видны и он, и статья.
+func CommentDocument(comment *models.Comment, article *models.Article) Document {
+	return Document{
+		Type:      DocTypeComment,
+		EntityID:  comment.ID,
+		ArticleID: comment.ArticleID,
+		Text:      comment.Text,
+		Published: article.IsPublic() && comment.IsPublic(),
+		UpdatedAt: comment.UpdatedAt,
+	}
+}
@@ -0,0 +1,172 @@
+package search
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/logger"
+)
+
+// recordingIndex запоминает применённые к индексу операции.
+type recordingIndex struct {
+	mu  sync.Mutex
+	ops []string
+}
+
+func (r *recordingIndex) record(op string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ops = append(r.ops, op)
+	return nil
+}
+
+func (r *recordingIndex) Index(doc Document) error {
+	if !doc.Published {
+		return r.record("index-hidden " + doc.Type)
+	}
+	return r.record("index " + doc.Type)
+}
+
+func (r *recordingIndex) Delete(docType string, entityID uint) error {
+	return r.record("delete " + docType)
+}
+
+func (r *recordingIndex) DeleteByArticle(articleID uint) error {
+	return r.record("delete-article")
+}
+
+func (r *recordingIndex) Search(query string, limit, offset int) ([]Hit, error) { return nil, nil }
+func (r *recordingIndex) Reset() error                                          { return nil }
+func (r *recordingIndex) Close() error                                          { return nil }
+
+func (r *recordingIndex) applied() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]string(nil), r.ops...)
+}
+
+// newTestIndexer создаёт Indexer без фонового обработчика: операции остаются в очереди,
+// пока тест не вызовет drain.
+func newTestIndexer(t *testing.T, index SearchIndex, queueSize int) *Indexer {
+	return &Indexer{
+		index:  index,
+		queue:  make(chan operation, queueSize),
+		Logger: logger.NewLogger(filepath.Join(t.TempDir(), "search.log")),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+}
+
+func equalOps(got, want []string) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestIndexerDrainAppliesOperationsInOrder(t *testing.T) {
+	index := &recordingIndex{}
+	indexer := newTestIndexer(t, index, 8)
+	article := &models.Article{ID: 1, Published: true}
+
+	indexer.IndexArticle(article)
+	indexer.RemoveComment(2)
+	indexer.RemoveArticle(1)
+	indexer.drain()
+
+	want := []string{"index article", "delete comment", "delete-article"}
+	if got := index.applied(); !equalOps(got, want) {
+		t.Errorf("applied %v, want %v", got, want)
+	}
+}
+
+func TestIndexerFullQueueDropsIndexingButWaitsForRemoval(t *testing.T) {
+	index := &recordingIndex{}
+	indexer := newTestIndexer(t, index, 1)
+	article := &models.Article{ID: 1, Published: true}
+
+	indexer.IndexArticle(article)
+	indexer.IndexArticle(article) // очередь заполнена: индексация отбрасывается без ожидания
+
+	removed := make(chan struct{})
+	go func() {
+		indexer.RemoveArticle(1)
+		close(removed)
+	}()
+	select {
+	case <-removed:
+		t.Fatal("RemoveArticle() returned while the queue was full, the removal was dropped")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	indexer.apply(<-indexer.queue)
+	<-removed
+	indexer.drain()
+
+	want := []string{"index article", "delete-article"}
+	if got := index.applied(); !equalOps(got, want) {
+		t.Errorf("applied %v, want %v", got, want)
+	}
+}
+
+func TestIndexerStopDrainsQueueAndReleasesBlockedRemovals(t *testing.T) {
+	index := &recordingIndex{}
+	indexer := NewIndexer(index, 4, logger.NewLogger(filepath.Join(t.TempDir(), "search.log")))
+	article := &models.Article{ID: 1, Published: true}
+
+	indexer.IndexArticle(article)
+	indexer.RemoveComment(3)
+	indexer.Stop()
+	indexer.Stop() // повторный вызов не должен паниковать или блокироваться
+
+	want := []string{"index article", "delete comment"}
+	if got := index.applied(); !equalOps(got, want) {
+		t.Errorf("applied %v, want %v", got, want)
+	}
+
+	// Обработчик остановлен: удаление не должно ждать места в очереди бесконечно
+	for range cap(indexer.queue) + 1 {
+		indexer.RemoveArticle(1)
+	}
+	if got := index.applied(); len(got) != len(want) {
+		t.Errorf("operations after Stop() were applied: %v", got)
+	}
+}
+
+func TestIndexCommentRemovesInvisibleComments(t *testing.T) {
+	published := &models.Article{ID: 1, Published: true}
+	hiddenArticle := &models.Article{ID: 1, Published: true, Hidden: true}
+	approved := &models.Comment{ID: 5, ArticleID: 1, Status: models.CommentStatusApproved}
+	tests := []struct {
+		name    string
+		comment *models.Comment
+		article *models.Article
+		want    string
+	}{
+		{"approved comment of published article", approved, published, "index comment"},
+		{"comment of hidden article", approved, hiddenArticle, "index-hidden comment"},
+		{"comment of article in trash", approved, nil, "delete comment"},
+		{"pending comment", &models.Comment{ID: 5, ArticleID: 1, Status: models.CommentStatusPending}, published, "delete comment"},
+		{"comment hidden by reports", &models.Comment{ID: 5, ArticleID: 1, Status: models.CommentStatusApproved, Hidden: true}, published, "delete comment"},
+		{"tombstone", &models.Comment{ID: 5, ArticleID: 1, Status: models.CommentStatusApproved, Tombstone: true}, published, "delete comment"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := &recordingIndex{}
+			indexer := newTestIndexer(t, index, 1)
+			indexer.IndexComment(tt.comment, tt.article)
+			indexer.drain()
+			if got := index.applied(); !equalOps(got, []string{tt.want}) {
+				t.Errorf("applied %v, want [%s]", got, tt.want)
+			}
+		})
+	}
+}
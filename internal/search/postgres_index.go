@@ -0,0 +1,91 @@
+package search
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+)
+
+// PostgresIndex реализует SearchIndex на основе полнотекстового поиска PostgreSQL.
+type PostgresIndex struct {
+	db       *gorm.DB
+	language string
+}
+
+// NewPostgresIndex создаёт новый экземпляр PostgresIndex.
+func NewPostgresIndex(db *gorm.DB, language string) *PostgresIndex {
+	return &PostgresIndex{db: db, language: language}
+}
+
+// Index добавляет документ в таблицу search_documents или обновляет существующий.
+func (i *PostgresIndex) Index(doc Document) error {
+	return i.db.Exec(`
+		INSERT INTO search_documents (doc_type, entity_id, article_id, title, body, published, updated_at, tsv)
+		VALUES (?, ?, ?, ?, ?, ?, ?,
+			setweight(to_tsvector(?::regconfig, ?), 'A') || setweight(to_tsvector(?::regconfig, ?), 'B'))
+		ON CONFLICT (doc_type, entity_id) DO UPDATE SET
+			article_id = EXCLUDED.article_id,
+			title = EXCLUDED.title,
+			body = EXCLUDED.body,
+			published = EXCLUDED.published,
+			updated_at = EXCLUDED.updated_at,
+			tsv = EXCLUDED.tsv`,
+		doc.Type, doc.EntityID, doc.ArticleID, doc.Title, doc.Text, doc.Published, doc.UpdatedAt,
+		i.language, doc.Title, i.language, doc.Text,
+	).Error
+}
+
+// Delete удаляет документ из индекса.
+func (i *PostgresIndex) Delete(docType string, entityID uint) error {
+	return i.db.Where("doc_type = ? AND entity_id = ?", docType, entityID).
+		Delete(&models.SearchDocument{}).Error
+}
+
+// DeleteByArticle удаляет статью и все связанные с ней документы.
+func (i *PostgresIndex) DeleteByArticle(articleID uint) error {
+	return i.db.Where("article_id = ?", articleID).Delete(&models.SearchDocument{}).Error
+}
+
+// Search выполняет ранжированный поиск по опубликованным документам.
+func (i *PostgresIndex) Search(query string, limit, offset int) ([]Hit, error) {
+	var rows []struct {
+		DocType   string
+		EntityID  uint
+		ArticleID uint
+		Title     string
+		Score     float64
+	}
+	err := i.db.Raw(`
+		SELECT doc_type, entity_id, article_id, title, ts_rank(tsv, q) AS score
+		FROM search_documents, plainto_tsquery(?::regconfig, ?) AS q
+		WHERE tsv @@ q AND published = TRUE
+		ORDER BY score DESC, updated_at DESC
+		LIMIT ? OFFSET ?`,
+		i.language, query, limit, offset,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	hits := make([]Hit, 0, len(rows))
+	for _, row := range rows {
+		hits = append(hits, Hit{
+			Type:      row.DocType,
+			EntityID:  row.EntityID,
+			ArticleID: row.ArticleID,
+			Title:     row.Title,
+			Score:     row.Score,
+		})
+	}
+	return hits, nil
+}
+
+// Reset полностью очищает индекс.
+func (i *PostgresIndex) Reset() error {
+	return i.db.Session(&gorm.Session{AllowGlobalUpdate: true}).Delete(&models.SearchDocument{}).Error
+}
+
+// Close ничего не делает: подключение к БД принадлежит приложению.
+func (i *PostgresIndex) Close() error {
+	return nil
+}
@@ -0,0 +1,208 @@
+package services
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/analytics"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+)
+
+// Параметры отчётов по просмотрам по умолчанию.
+const (
+	defaultAnalyticsDays  = 30
+	defaultAnalyticsLimit = 10
+	analyticsDateLayout   = "2006-01-02"
+)
+
+// Period — период отчёта: даты from и to включительно (UTC).
+type Period struct {
+	From time.Time
+	To   time.Time
+}
+
+// ArticleViews содержит просмотры статьи по дням за период.
+type ArticleViews struct {
+	ArticleID uint
+	Period    Period
+	Days      []DailyViews
+	Total     int
+}
+
+// DailyViews содержит количество уникальных просмотров за сутки.
+type DailyViews struct {
+	Day   time.Time
+	Views int
+}
+
+// AnalyticsService собирает просмотры статей и формирует отчёты для авторов и администраторов.
+type AnalyticsService struct {
+	repo        *repositories.AnalyticsRepository
+	articleRepo *repositories.ArticleRepository
+	recorder    *analytics.Recorder
+	cfg         *config.AnalyticsConfig
+	Logger      logger.Logger
+}
+
+// NewAnalyticsService создаёт новый экземпляр AnalyticsService.
+func NewAnalyticsService(
+	repo *repositories.AnalyticsRepository,
+	articleRepo *repositories.ArticleRepository,
+	recorder *analytics.Recorder,
+	cfg *config.AnalyticsConfig,
+	logger logger.Logger,
+) *AnalyticsService {
+	return &AnalyticsService{
+		repo:        repo,
+		articleRepo: articleRepo,
+		recorder:    recorder,
+		cfg:         cfg,
+		Logger:      logger,
+	}
+}
+
+// RecordView ставит просмотр статьи в очередь записи. Просмотры черновиков, просмотры автором
+// своей статьи и запросы поисковых роботов не учитываются. Метод не обращается к БД.
+func (s *AnalyticsService) RecordView(article *models.Article, userID uint, ip, userAgent, referrer string) {
+	if !s.cfg.Enabled || !article.IsPublic() || article.AuthorID == userID || analytics.IsBot(userAgent) {
+		return
+	}
+	s.recorder.Record(analytics.View{
+		ArticleID: article.ID,
+		IP:        ip,
+		UserAgent: userAgent,
+		Referrer:  referrer,
+		At:        time.Now(),
+	})
+}
+
+// GetArticleViews возвращает просмотры статьи по дням за период; дни без просмотров заполняются нулями.
+// Доступно автору статьи и администраторам.
+func (s *AnalyticsService) GetArticleViews(articleID uint, query dto.AnalyticsQuery, userID uint, userRoles []string) (*ArticleViews, error) {
+	period, err := s.resolvePeriod(query)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkArticleAccess(articleID, userID, userRoles); err != nil {
+		return nil, err
+	}
+	rows, err := s.repo.GetDailyViews(articleID, period.From, period.To)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	counts := make(map[string]int, len(rows))
+	for _, row := range rows {
+		counts[row.Day.Format(analyticsDateLayout)] = row.Views
+	}
+	result := &ArticleViews{ArticleID: articleID, Period: period}
+	for day := period.From; !day.After(period.To); day = day.AddDate(0, 0, 1) {
+		views := counts[day.Format(analyticsDateLayout)]
+		result.Days = append(result.Days, DailyViews{Day: day, Views: views})
+		result.Total += views
+	}
+	return result, nil
+}
+
+// GetArticleReferrers возвращает источники перехода на статью за период.
+// Доступно автору статьи и администраторам.
+func (s *AnalyticsService) GetArticleReferrers(articleID uint, query dto.AnalyticsQuery, userID uint, userRoles []string) (Period, []*repositories.ReferrerTotal, error) {
+	period, err := s.resolvePeriod(query)
+	if err != nil {
+		return Period{}, nil, err
+	}
+	if err := s.checkArticleAccess(articleID, userID, userRoles); err != nil {
+		return Period{}, nil, err
+	}
+	referrers, err := s.repo.GetReferrers(articleID, 0, period.From, period.To, analyticsLimit(query))
+	if err != nil {
+		return Period{}, nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return period, referrers, nil
+}
+
+// GetTopArticles возвращает самые просматриваемые статьи за период:
+// для автора — среди его статей, для администратора — среди всех.
+func (s *AnalyticsService) GetTopArticles(query dto.AnalyticsQuery, userID uint, userRoles []string) (Period, []*repositories.ArticleViewTotal, error) {
+	period, err := s.resolvePeriod(query)
+	if err != nil {
+		return Period{}, nil, err
+	}
+	top, err := s.repo.GetTopArticles(analyticsOwnerFilter(userID, userRoles), period.From, period.To, analyticsLimit(query))
+	if err != nil {
+		return Period{}, nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return period, top, nil
+}
+
+// GetReferrers возвращает источники перехода за период:
+// для автора — на его статьи, для администратора — на все статьи.
+func (s *AnalyticsService) GetReferrers(query dto.AnalyticsQuery, userID uint, userRoles []string) (Period, []*repositories.ReferrerTotal, error) {
+	period, err := s.resolvePeriod(query)
+	if err != nil {
+		return Period{}, nil, err
+	}
+	referrers, err := s.repo.GetReferrers(0, analyticsOwnerFilter(userID, userRoles), period.From, period.To, analyticsLimit(query))
+	if err != nil {
+		return Period{}, nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return period, referrers, nil
+}
+
+// checkArticleAccess проверяет, что статья существует и пользователь может видеть её статистику.
+func (s *AnalyticsService) checkArticleAccess(articleID uint, userID uint, userRoles []string) error {
+	article, err := s.articleRepo.GetByID(articleID)
+	if err != nil {
+		return errors.New(apperrors.ErrArticleNotFound)
+	}
+	if article.AuthorID != userID && !slices.Contains(userRoles, "admin") {
+		return errors.New(apperrors.ErrAccessDenied)
+	}
+	return nil
+}
+
+// resolvePeriod разбирает период отчёта. По умолчанию отчёт строится за последние 30 дней,
+// длина периода ограничена ANALYTICS_MAX_RANGE_DAYS.
+func (s *AnalyticsService) resolvePeriod(query dto.AnalyticsQuery) (Period, error) {
+	to := time.Now().UTC().Truncate(24 * time.Hour)
+	if query.To != "" {
+		parsed, err := time.Parse(analyticsDateLayout, query.To)
+		if err != nil {
+			return Period{}, errors.New(apperrors.ErrInvalidDateRange)
+		}
+		to = parsed
+	}
+	from := to.AddDate(0, 0, -(defaultAnalyticsDays - 1))
+	if query.From != "" {
+		parsed, err := time.Parse(analyticsDateLayout, query.From)
+		if err != nil {
+			return Period{}, errors.New(apperrors.ErrInvalidDateRange)
+		}
+		from = parsed
+	}
+	if from.After(to) || to.Sub(from) >= time.Duration(s.cfg.MaxRangeDays)*24*time.Hour {
+		return Period{}, errors.New(apperrors.ErrInvalidDateRange)
+	}
+	return Period{From: from, To: to}, nil
+}
+
+// analyticsOwnerFilter возвращает автора, статьями которого ограничивается отчёт (0 — все статьи).
+func analyticsOwnerFilter(userID uint, userRoles []string) uint {
+	if slices.Contains(userRoles, "admin") {
+		return 0
+	}
+	return userID
+}
+
+// analyticsLimit возвращает количество строк отчёта.
+func analyticsLimit(query dto.AnalyticsQuery) int {
+	if query.Limit > 0 {
+		return query.Limit
+	}
+	return defaultAnalyticsLimit
+}
@@ -2,24 +2,53 @@ package services
 
 import (
 	"errors"
+	"slices"
+	"strings"
 
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/blocks"
 	"github.com/AsterOzlob/content_managment_api/internal/database/models"
 	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
 	"github.com/AsterOzlob/content_managment_api/internal/dto"
 	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"github.com/AsterOzlob/content_managment_api/internal/search"
 	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
 	"github.com/AsterOzlob/content_managment_api/pkg/utils"
 )
 
 // ArticleService предоставляет методы для управления статьями.
 type ArticleService struct {
-	repo   *repositories.ArticleRepository
-	Logger logger.Logger
+	repo         *repositories.ArticleRepository
+	tagRepo      *repositories.TagRepository
+	categoryRepo *repositories.CategoryRepository
+	mediaRepo    *repositories.MediaRepository
+	commentRepo  *repositories.CommentRepository
+	indexer      *search.Indexer
+	locales      *config.LocaleConfig
+	Logger       logger.Logger
 }
 
 // NewArticleService создаёт новый экземпляр ArticleService.
-func NewArticleService(repo *repositories.ArticleRepository, logger logger.Logger) *ArticleService {
-	return &ArticleService{repo: repo, Logger: logger}
+func NewArticleService(
+	repo *repositories.ArticleRepository,
+	tagRepo *repositories.TagRepository,
+	categoryRepo *repositories.CategoryRepository,
+	mediaRepo *repositories.MediaRepository,
+	commentRepo *repositories.CommentRepository,
+	indexer *search.Indexer,
+	locales *config.LocaleConfig,
+	logger logger.Logger,
+) *ArticleService {
+	return &ArticleService{
+		repo:         repo,
+		tagRepo:      tagRepo,
+		categoryRepo: categoryRepo,
+		mediaRepo:    mediaRepo,
+		commentRepo:  commentRepo,
+		indexer:      indexer,
+		locales:      locales,
+		Logger:       logger,
+	}
 }
 
 // CreateArticle создаёт новую статью.
@@ -32,22 +61,75 @@ func (s *ArticleService) CreateArticle(input dto.ArticleInput, userID uint) (*mo
 		}).Error("User not found")
 		return nil, errors.New(apperrors.ErrUserNotFound)
 	}
+	if user.BannedAt != nil {
+		return nil, errors.New(apperrors.ErrUserBanned)
+	}
+	if err := s.validateContent(input); err != nil {
+		return nil, err
+	}
+	locale, err := s.resolveLocale(input.Locale, s.locales.Default)
+	if err != nil {
+		return nil, err
+	}
+	category, tags, err := s.resolveTaxonomy(input)
+	if err != nil {
+		return nil, err
+	}
+	ogImage, err := s.resolveOGImage(input.OGImageID)
+	if err != nil {
+		return nil, err
+	}
 	article := &models.Article{
-		AuthorID:  userID,
-		Title:     input.Title,
-		Text:      input.Text,
-		Published: input.Published,
+		AuthorID:            userID,
+		Title:               input.Title,
+		Text:                input.Text,
+		Blocks:              input.Blocks,
+		Published:           input.Published,
+		Locale:              locale,
+		CategoryID:          input.CategoryID,
+		Category:            category,
+		Tags:                tags,
+		PremoderateComments: input.PremoderateComments,
 	}
+	applySEO(article, input, ogImage)
 	if err := s.repo.Create(article); err != nil {
 		s.Logger.WithError(err).Error("Failed to create article in repository")
 		return nil, err
 	}
+	s.indexer.IndexArticle(article)
 	return article, nil
 }
 
-// GetAllArticles возвращает список всех статей.
-func (s *ArticleService) GetAllArticles() ([]*models.Article, error) {
-	articles, err := s.repo.GetAll()
+// GetAllArticles возвращает список статей с учётом фильтров по тегу, рубрике, автору и объёму текста.
+// Фильтр по рубрике включает все вложенные рубрики. Черновики видны только их авторам,
+// редакторам, модераторам и администраторам; для анонимных запросов userID равен 0.
+func (s *ArticleService) GetAllArticles(query dto.ArticleListQuery, userID uint, userRoles []string) ([]*models.Article, error) {
+	filter := repositories.ArticleFilter{
+		TagSlug:        query.Tag,
+		AuthorID:       query.Author,
+		MinWords:       query.MinWords,
+		MaxWords:       query.MaxWords,
+		MinReadingTime: query.MinReadingTime,
+		MaxReadingTime: query.MaxReadingTime,
+		Sort:           query.Sort,
+	}
+	if !canViewAllDrafts(userRoles) {
+		filter.PublishedOnly = true
+		filter.DraftsOf = userID
+	}
+	if query.Category != "" {
+		category, err := s.categoryRepo.GetBySlug(query.Category)
+		if err != nil {
+			return nil, errors.New(apperrors.ErrCategoryNotFound)
+		}
+		categoryIDs, err := s.categoryRepo.GetDescendantIDs(category.ID)
+		if err != nil {
+			s.Logger.WithError(err).Error("Failed to fetch category descendants from repository")
+			return nil, errors.New(apperrors.ErrInternalServerError)
+		}
+		filter.CategoryIDs = categoryIDs
+	}
+	articles, err := s.repo.GetAll(filter)
 	if err != nil {
 		s.Logger.WithError(err).Error("Failed to fetch all articles from repository")
 		return nil, errors.New(apperrors.ErrInternalServerError)
@@ -56,12 +138,17 @@ func (s *ArticleService) GetAllArticles() ([]*models.Article, error) {
 }
 
 // GetArticleByID возвращает статью по ID.
-func (s *ArticleService) GetArticleByID(id uint) (*models.Article, error) {
+// Неопубликованная статья доступна только автору, редакторам, модераторам и администраторам,
+// для остальных она считается несуществующей.
+func (s *ArticleService) GetArticleByID(id uint, userID uint, userRoles []string) (*models.Article, error) {
 	article, err := s.repo.GetByID(id)
 	if err != nil {
 		s.Logger.WithError(err).Error("Failed to fetch article by ID from repository")
 		return nil, errors.New(apperrors.ErrArticleNotFound)
 	}
+	if !canViewArticle(article, userID, userRoles) {
+		return nil, errors.New(apperrors.ErrArticleNotFound)
+	}
 	return article, nil
 }
 
@@ -75,17 +162,44 @@ func (s *ArticleService) UpdateArticle(id uint, input dto.ArticleInput, userID u
 	if !utils.IsOwner(article.AuthorID, userID, userRoles) {
 		return nil, errors.New(apperrors.ErrAccessDenied)
 	}
+	if err := s.validateContent(input); err != nil {
+		return nil, err
+	}
+	locale, err := s.resolveLocale(input.Locale, article.Locale)
+	if err != nil {
+		return nil, err
+	}
+	category, tags, err := s.resolveTaxonomy(input)
+	if err != nil {
+		return nil, err
+	}
+	ogImage, err := s.resolveOGImage(input.OGImageID)
+	if err != nil {
+		return nil, err
+	}
+	wasPublic := article.IsPublic()
 	article.Title = input.Title
 	article.Text = input.Text
+	article.Blocks = input.Blocks
 	article.Published = input.Published
+	article.Locale = locale
+	article.CategoryID = input.CategoryID
+	article.Category = category
+	article.Tags = tags
+	article.PremoderateComments = input.PremoderateComments
+	applySEO(article, input, ogImage)
 	if err := s.repo.Update(article); err != nil {
 		s.Logger.WithError(err).Error("Failed to update article in repository")
 		return nil, err
 	}
+	s.indexer.IndexArticle(article)
+	if article.IsPublic() != wasPublic {
+		reindexArticleComments(s.indexer, s.commentRepo, article)
+	}
 	return article, nil
 }
 
-// DeleteArticle удаляет статью по ID после проверки прав доступа.
+// DeleteArticle перемещает статью в корзину после проверки прав доступа.
 func (s *ArticleService) DeleteArticle(id uint, userID uint, userRoles []string) error {
 	article, err := s.repo.GetByID(id)
 	if err != nil {
@@ -104,5 +218,127 @@ func (s *ArticleService) DeleteArticle(id uint, userID uint, userRoles []string)
 		s.Logger.WithError(err).Error("Failed to delete article from repository")
 		return err
 	}
+	s.indexer.RemoveArticle(id)
+	return nil
+}
+
+// canViewAllDrafts сообщает, может ли пользователь видеть черновики любых авторов.
+func canViewAllDrafts(userRoles []string) bool {
+	return slices.ContainsFunc(userRoles, func(role string) bool {
+		return role == "editor" || role == "moderator" || role == "admin"
+	})
+}
+
+// canViewArticle сообщает, видна ли статья пользователю: черновик и скрытая по жалобам статья
+// видны только автору, редакторам, модераторам и администраторам.
+func canViewArticle(article *models.Article, userID uint, userRoles []string) bool {
+	return article.IsPublic() || article.AuthorID == userID || canViewAllDrafts(userRoles)
+}
+
+// resolveLocale проверяет локаль исходного текста статьи; пустое значение заменяется fallback.
+func (s *ArticleService) resolveLocale(locale, fallback string) (string, error) {
+	if locale == "" {
+		return fallback, nil
+	}
+	locale = utils.NormalizeLocale(locale)
+	if !s.locales.IsSupported(locale) {
+		return "", errors.New(apperrors.ErrUnsupportedLocale)
+	}
+	return locale, nil
+}
+
+// resolveTaxonomy проверяет существование рубрики и тегов, указанных во входных данных.
+func (s *ArticleService) resolveTaxonomy(input dto.ArticleInput) (*models.Category, []models.Tag, error) {
+	var category *models.Category
+	if input.CategoryID != nil {
+		found, err := s.categoryRepo.GetByID(*input.CategoryID)
+		if err != nil {
+			return nil, nil, errors.New(apperrors.ErrCategoryNotFound)
+		}
+		category = found
+	}
+
+	tags := []models.Tag{}
+	if len(input.TagIDs) > 0 {
+		tagIDs := slices.Compact(slices.Sorted(slices.Values(input.TagIDs)))
+		found, err := s.tagRepo.GetByIDs(tagIDs)
+		if err != nil {
+			s.Logger.WithError(err).Error("Failed to fetch tags from repository")
+			return nil, nil, errors.New(apperrors.ErrInternalServerError)
+		}
+		if len(found) != len(tagIDs) {
+			return nil, nil, errors.New(apperrors.ErrTagNotFound)
+		}
+		tags = found
+	}
+	return category, tags, nil
+}
+
+// resolveOGImage проверяет, что изображение Open Graph существует и является картинкой.
+func (s *ArticleService) resolveOGImage(mediaID *uint) (*models.Media, error) {
+	if mediaID == nil {
+		return nil, nil
+	}
+	media, err := s.mediaRepo.GetByID(*mediaID)
+	if err != nil || !strings.HasPrefix(media.FileType, "image/") {
+		return nil, errors.New(apperrors.ErrInvalidOGImage)
+	}
+	return media, nil
+}
+
+// applySEO переносит SEO-метаданные из входных данных в статью.
+// Повторяющиеся ключевые слова (без учёта регистра) отбрасываются.
+func applySEO(article *models.Article, input dto.ArticleInput, ogImage *models.Media) {
+	keywords := make([]string, 0, len(input.Keywords))
+	seen := make(map[string]bool, len(input.Keywords))
+	for _, keyword := range input.Keywords {
+		keyword = strings.TrimSpace(keyword)
+		key := strings.ToLower(keyword)
+		if keyword == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		keywords = append(keywords, keyword)
+	}
+	article.MetaTitle = strings.TrimSpace(input.MetaTitle)
+	article.MetaDescription = strings.TrimSpace(input.MetaDescription)
+	article.CanonicalURL = input.CanonicalURL
+	article.OGImageID = input.OGImageID
+	article.OGImage = ogImage
+	article.NoIndex = input.NoIndex
+	article.Keywords = strings.Join(keywords, ",")
+}
+
+// validateContent проверяет блоки по схеме их типов и существование медиафайлов в блоках изображений.
+// Возвращает *blocks.ValidationError с описанием первого некорректного блока.
+func (s *ArticleService) validateContent(input dto.ArticleInput) error {
+	doc := input.Blocks
+	if len(doc) == 0 {
+		if strings.TrimSpace(input.Text) == "" {
+			return &blocks.ValidationError{Index: -1, Message: "either text or blocks is required"}
+		}
+		return nil
+	}
+	if err := blocks.Validate(doc); err != nil {
+		return err
+	}
+	mediaIDs := doc.MediaIDs()
+	if len(mediaIDs) == 0 {
+		return nil
+	}
+	media, err := s.mediaRepo.GetByIDs(mediaIDs)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch block media from repository")
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	found := make(map[uint]bool, len(media))
+	for _, m := range media {
+		found[m.ID] = true
+	}
+	for i, block := range doc {
+		if block.Type == blocks.TypeImage && !found[block.MediaID] {
+			return &blocks.ValidationError{Index: i, Type: block.Type, Message: "media not found"}
+		}
+	}
 	return nil
 }
@@ -11,7 +11,6 @@ import (
 	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
 	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
 	"github.com/AsterOzlob/content_managment_api/pkg/utils"
-	"gorm.io/gorm"
 )
 
 type AuthService struct {
@@ -42,17 +41,15 @@ func NewAuthService(
 
 // SignUp регистрирует нового пользователя и создает токены.
 func (s *AuthService) SignUp(input dto.AuthInput) (*models.User, *AuthTokens, error) {
-	// Проверяем, существует ли пользователь с таким email
-	user, err := s.userRepo.GetByEmail(input.Email)
-	if err == nil {
-		// Если пользователь уже существует
-		return nil, nil, errors.New(apperrors.ErrUserAlreadyExists)
-	}
-	if !errors.Is(err, gorm.ErrRecordNotFound) {
-		// Если произошла ошибка, отличная от "запись не найдена"
+	// Проверяем, не заняты ли email и имя пользователя, в том числе удалёнными пользователями
+	exists, err := s.userRepo.ExistsWithEmailOrUsername(input.Email, input.Username)
+	if err != nil {
 		s.Logger.WithError(err).Error("Failed to check if user exists")
 		return nil, nil, errors.New(apperrors.ErrInternalServerError)
 	}
+	if exists {
+		return nil, nil, errors.New(apperrors.ErrUserAlreadyExists)
+	}
 
 	// Хэшируем пароль
 	hashedPassword, err := utils.HashPassword(input.Password)
@@ -61,7 +58,7 @@ func (s *AuthService) SignUp(input dto.AuthInput) (*models.User, *AuthTokens, er
 	}
 
 	// Создаем нового пользователя
-	user = &models.User{
+	user := &models.User{
 		Username:     input.Username,
 		Email:        input.Email,
 		PasswordHash: hashedPassword,
@@ -118,6 +115,9 @@ func (s *AuthService) Login(input dto.AuthInput) (*models.User, *AuthTokens, err
 		s.Logger.Warn("Invalid password during authentication")
 		return nil, nil, errors.New(apperrors.ErrInvalidCredentials)
 	}
+	if user.BannedAt != nil {
+		return nil, nil, errors.New(apperrors.ErrUserBanned)
+	}
 	// Проверяем наличие активных токенов
 	existingToken, _ := s.refreshTokenRepo.GetActiveTokenByUser(user.ID)
 	if existingToken != nil && existingToken.ExpiresAt.After(time.Now()) {
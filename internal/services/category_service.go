@@ -0,0 +1,136 @@
+package services
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// CategoryService предоставляет методы для управления деревом рубрик.
+type CategoryService struct {
+	repo   *repositories.CategoryRepository
+	Logger logger.Logger
+}
+
+// NewCategoryService создаёт новый экземпляр CategoryService.
+func NewCategoryService(repo *repositories.CategoryRepository, logger logger.Logger) *CategoryService {
+	return &CategoryService{repo: repo, Logger: logger}
+}
+
+// CreateCategory создаёт новую рубрику.
+func (s *CategoryService) CreateCategory(input dto.CategoryInput) (*models.Category, error) {
+	if input.ParentID != nil {
+		if _, err := s.repo.GetByID(*input.ParentID); err != nil {
+			return nil, errors.New(apperrors.ErrCategoryNotFound)
+		}
+	}
+	slug := categorySlug(input)
+	if slug == "" {
+		return nil, errors.New(apperrors.ErrInvalidSlug)
+	}
+	if existing, err := s.repo.GetBySlug(slug); err == nil && existing != nil {
+		return nil, errors.New(apperrors.ErrCategoryAlreadyExists)
+	}
+	category := &models.Category{
+		ParentID:    input.ParentID,
+		Name:        input.Name,
+		Slug:        slug,
+		Description: input.Description,
+	}
+	if err := s.repo.Create(category); err != nil {
+		s.Logger.WithError(err).Error("Failed to create category in repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return category, nil
+}
+
+// GetAllCategories возвращает плоский список всех рубрик.
+func (s *CategoryService) GetAllCategories() ([]*models.Category, error) {
+	categories, err := s.repo.GetAll()
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch categories from repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return categories, nil
+}
+
+// GetCategoryByID возвращает рубрику по ID.
+func (s *CategoryService) GetCategoryByID(id uint) (*models.Category, error) {
+	category, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrCategoryNotFound)
+	}
+	return category, nil
+}
+
+// UpdateCategory обновляет рубрику, не допуская циклов в дереве.
+func (s *CategoryService) UpdateCategory(id uint, input dto.CategoryInput) (*models.Category, error) {
+	category, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrCategoryNotFound)
+	}
+	if input.ParentID != nil {
+		if _, err := s.repo.GetByID(*input.ParentID); err != nil {
+			return nil, errors.New(apperrors.ErrCategoryNotFound)
+		}
+		descendants, err := s.repo.GetDescendantIDs(id)
+		if err != nil {
+			s.Logger.WithError(err).Error("Failed to fetch category descendants from repository")
+			return nil, errors.New(apperrors.ErrInternalServerError)
+		}
+		if slices.Contains(descendants, *input.ParentID) {
+			return nil, errors.New(apperrors.ErrCategoryCycle)
+		}
+	}
+	slug := categorySlug(input)
+	if slug == "" {
+		return nil, errors.New(apperrors.ErrInvalidSlug)
+	}
+	if existing, err := s.repo.GetBySlug(slug); err == nil && existing.ID != category.ID {
+		return nil, errors.New(apperrors.ErrCategoryAlreadyExists)
+	}
+	category.ParentID = input.ParentID
+	category.Name = input.Name
+	category.Slug = slug
+	category.Description = input.Description
+	if err := s.repo.Update(category); err != nil {
+		s.Logger.WithError(err).Error("Failed to update category in repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return category, nil
+}
+
+// DeleteCategory удаляет рубрику, если у неё нет дочерних рубрик.
+// Статьи удалённой рубрики остаются без основной рубрики.
+func (s *CategoryService) DeleteCategory(id uint) error {
+	if _, err := s.repo.GetByID(id); err != nil {
+		return errors.New(apperrors.ErrCategoryNotFound)
+	}
+	children, err := s.repo.CountChildren(id)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to count category children in repository")
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	if children > 0 {
+		return errors.New(apperrors.ErrCategoryHasChildren)
+	}
+	if err := s.repo.Delete(id); err != nil {
+		s.Logger.WithError(err).Error("Failed to delete category from repository")
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	return nil
+}
+
+// categorySlug возвращает slug рубрики из входных данных или формирует его из названия.
+func categorySlug(input dto.CategoryInput) string {
+	if input.Slug != "" {
+		return utils.Slugify(input.Slug)
+	}
+	return utils.Slugify(input.Name)
+}
@@ -2,81 +2,540 @@ package services
 
 import (
 	"errors"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/AsterOzlob/content_managment_api/config"
 	"github.com/AsterOzlob/content_managment_api/internal/database/models"
 	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
 	"github.com/AsterOzlob/content_managment_api/internal/dto"
 	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"github.com/AsterOzlob/content_managment_api/internal/mentions"
+	"github.com/AsterOzlob/content_managment_api/internal/search"
+	"github.com/AsterOzlob/content_managment_api/internal/spam"
 	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
 	"github.com/AsterOzlob/content_managment_api/pkg/utils"
 )
 
 // CommentService предоставляет методы для управления комментариями.
 type CommentService struct {
-	repo   *repositories.CommentRepository
-	Logger logger.Logger
+	repo          *repositories.CommentRepository
+	articleRepo   *repositories.ArticleRepository
+	userRepo      *repositories.UserRepository
+	indexer       *search.Indexer
+	spamFilter    *spam.Filter
+	notifications *NotificationService
+	cfg           *config.CommentConfig
+	Logger        logger.Logger
 }
 
 // NewCommentService создает новый экземпляр CommentService.
-func NewCommentService(repo *repositories.CommentRepository, logger logger.Logger) *CommentService {
-	return &CommentService{repo: repo, Logger: logger}
+func NewCommentService(
+	repo *repositories.CommentRepository,
+	articleRepo *repositories.ArticleRepository,
+	userRepo *repositories.UserRepository,
+	indexer *search.Indexer,
+	spamFilter *spam.Filter,
+	notifications *NotificationService,
+	cfg *config.CommentConfig,
+	logger logger.Logger,
+) *CommentService {
+	return &CommentService{
+		repo:          repo,
+		articleRepo:   articleRepo,
+		userRepo:      userRepo,
+		indexer:       indexer,
+		spamFilter:    spamFilter,
+		notifications: notifications,
+		cfg:           cfg,
+		Logger:        logger,
+	}
 }
 
 // AddCommentToArticle добавляет комментарий к статье.
-func (s *CommentService) AddCommentToArticle(articleID uint, input dto.CommentInput, userID uint) (*models.Comment, error) {
+// Ответ допускается только на видимый пользователю комментарий той же статьи в открытой ветке;
+// модераторы и администраторы могут отвечать и в закрытых ветках.
+// Упоминания @username сохраняются как ссылки на пользователей; об опубликованном комментарии
+// уведомляются упомянутые пользователи, автор родительского комментария и автор статьи.
+// Комментарий проверяется спам-фильтром: явный спам отклоняется, подозрительный — отправляется
+// на модерацию. Если для статьи включена премодерация, комментарий нового пользователя
+// также получает статус pending и публикуется только после одобрения модератором.
+func (s *CommentService) AddCommentToArticle(articleID uint, input dto.CommentInput, userID uint, userRoles []string) (*models.Comment, error) {
+	if err := checkNotBanned(s.userRepo, userID); err != nil {
+		return nil, err
+	}
+	article, err := s.articleRepo.GetByID(articleID)
+	if err != nil || !canViewArticle(article, userID, userRoles) {
+		return nil, errors.New(apperrors.ErrArticleNotFound)
+	}
+	if input.ParentID != nil {
+		if err := s.checkParent(articleID, *input.ParentID, userID, userRoles); err != nil {
+			return nil, err
+		}
+	}
 	comment := &models.Comment{
 		ParentID:  input.ParentID,
 		ArticleID: articleID,
 		AuthorID:  userID,
 		Text:      input.Text,
 	}
+	mentioned, err := s.resolveMentions(comment.Text)
+	if err != nil {
+		return nil, err
+	}
+	comment.Mentions = mentionLinks(mentioned)
+	if err := s.assignStatus(article, comment, userRoles); err != nil {
+		return nil, err
+	}
 	if err := s.repo.Create(comment); err != nil {
 		s.Logger.WithError(err).Error("Failed to create comment in repository")
 		return nil, err
 	}
+	for i := range comment.Mentions {
+		comment.Mentions[i].User = mentioned[i]
+	}
+	s.indexer.IndexComment(comment, article)
+	if comment.IsPublic() {
+		s.notifications.NotifyComment(comment, article)
+	}
 	return comment, nil
 }
 
-// GetCommentsByArticleID возвращает все комментарии к статье, включая вложенные.
-func (s *CommentService) GetCommentsByArticleID(articleID uint) ([]*models.Comment, error) {
-	comments, err := s.repo.GetByArticleID(articleID)
+// GetCommentsByArticleID возвращает страницу веток комментариев к статье с ответами на COMMENT_MAX_DEPTH
+// уровней вглубь и общее количество комментариев верхнего уровня. Более глубокие ответы
+// подгружаются через GetReplies. Неодобренные комментарии видны только их автору,
+// модераторам и администраторам.
+func (s *CommentService) GetCommentsByArticleID(articleID uint, sort string, limit, offset int, userID uint, userRoles []string) ([]*models.Comment, int64, error) {
+	article, err := s.articleRepo.GetByID(articleID)
+	if err != nil || !canViewArticle(article, userID, userRoles) {
+		return nil, 0, errors.New(apperrors.ErrArticleNotFound)
+	}
+	return s.getThreads(articleID, nil, sort, limit, offset, userID, userRoles)
+}
+
+// GetReplies возвращает страницу ответов на комментарий с их ответами на COMMENT_MAX_DEPTH
+// уровней вглубь и общее количество прямых ответов на комментарий.
+func (s *CommentService) GetReplies(commentID uint, sort string, limit, offset int, userID uint, userRoles []string) ([]*models.Comment, int64, error) {
+	comment, err := s.repo.GetByID(commentID)
+	if err != nil || !canViewComment(comment, userID, userRoles) {
+		return nil, 0, errors.New(apperrors.ErrCommentNotFound)
+	}
+	article, err := s.articleRepo.GetByID(comment.ArticleID)
+	if err != nil || !canViewArticle(article, userID, userRoles) {
+		return nil, 0, errors.New(apperrors.ErrCommentNotFound)
+	}
+	return s.getThreads(comment.ArticleID, &comment.ID, sort, limit, offset, userID, userRoles)
+}
+
+// getThreads загружает страницу веток комментариев статьи под комментарием parentID.
+func (s *CommentService) getThreads(articleID uint, parentID *uint, sort string, limit, offset int, userID uint, userRoles []string) ([]*models.Comment, int64, error) {
+	comments, total, err := s.repo.GetThreads(repositories.CommentThreadQuery{
+		ArticleID:  articleID,
+		ParentID:   parentID,
+		Visibility: repositories.CommentVisibility{All: canModerateComments(userRoles), AuthorID: userID},
+		Sort:       sort,
+		Depth:      s.cfg.MaxDepth,
+		Limit:      limit,
+		Offset:     offset,
+	})
 	if err != nil {
-		s.Logger.WithError(err).Error("Failed to fetch comments by article ID from repository")
-		return nil, errors.New(apperrors.ErrArticleNotFound)
+		s.Logger.WithError(err).Error("Failed to fetch comment threads from repository")
+		return nil, 0, errors.New(apperrors.ErrInternalServerError)
+	}
+	return comments, total, nil
+}
+
+// GetModerationQueue возвращает комментарии в указанном статусе (по умолчанию — ожидающие проверки),
+// начиная с самых старых, и общее количество таких комментариев.
+func (s *CommentService) GetModerationQueue(status string, limit, offset int) ([]*models.Comment, int64, error) {
+	if status == "" {
+		status = models.CommentStatusPending
+	}
+	comments, total, err := s.repo.GetByStatus(status, limit, offset)
+	if err != nil {
+		return nil, 0, errors.New(apperrors.ErrInternalServerError)
+	}
+	return comments, total, nil
+}
+
+// ModerateComments устанавливает статус модерации комментариям и обновляет поисковый индекс:
+// одобренные комментарии индексируются, остальные удаляются из индекса.
+// Одобрение и отметка «спам» обучают спам-фильтр; отклонение его не обучает,
+// так как комментарий может быть отклонён не за спам. О впервые одобренных комментариях
+// рассылаются уведомления, как при публикации.
+// Возвращает изменённые комментарии; несуществующие ID пропускаются.
+func (s *CommentService) ModerateComments(ids []uint, status string) ([]*models.Comment, error) {
+	comments, err := s.repo.GetByIDs(ids)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	if len(comments) == 0 {
+		return nil, errors.New(apperrors.ErrCommentNotFound)
+	}
+	found := make([]uint, 0, len(comments))
+	for _, comment := range comments {
+		found = append(found, comment.ID)
+	}
+	if _, err := s.repo.UpdateStatus(found, status); err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	for _, comment := range comments {
+		published := comment.Status != models.CommentStatusApproved && status == models.CommentStatusApproved
+		comment.Status = status
+		indexComment(s.indexer, s.articleRepo, comment)
+		s.train(comment)
+		if published && comment.IsPublic() {
+			s.notifyPublished(comment)
+		}
 	}
 	return comments, nil
 }
 
-// UpdateComment редактирует содержимое комментария.
+// LockThread закрывает ветку под комментарием для новых ответов или открывает её.
+func (s *CommentService) LockThread(commentID uint, locked bool) (*models.Comment, error) {
+	comment, err := s.repo.GetByID(commentID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrCommentNotFound)
+	}
+	if err := s.repo.SetLocked(commentID, locked); err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	comment.Locked = locked
+	return comment, nil
+}
+
+// checkParent проверяет, что на комментарий parentID можно ответить в статье articleID:
+// он существует, виден пользователю, относится к той же статье и его ветка не закрыта.
+func (s *CommentService) checkParent(articleID, parentID uint, userID uint, userRoles []string) error {
+	parent, err := s.repo.GetByID(parentID)
+	if err != nil || !canViewComment(parent, userID, userRoles) {
+		return errors.New(apperrors.ErrParentCommentNotFound)
+	}
+	if parent.ArticleID != articleID {
+		return errors.New(apperrors.ErrParentCommentMismatch)
+	}
+	if canModerateComments(userRoles) {
+		return nil
+	}
+	locked, err := s.repo.IsThreadLocked(parentID)
+	if err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	if locked {
+		return errors.New(apperrors.ErrCommentThreadLocked)
+	}
+	return nil
+}
+
+// resolveMentions находит пользователей, упомянутых в тексте как @username.
+// Упоминания несуществующих пользователей пропускаются.
+func (s *CommentService) resolveMentions(text string) ([]*models.User, error) {
+	usernames := mentions.Parse(text)
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+	users, err := s.userRepo.GetByUsernames(usernames)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return users, nil
+}
+
+// notifyPublished рассылает уведомления о комментарии, одобренном модератором.
+func (s *CommentService) notifyPublished(comment *models.Comment) {
+	// Комментарий перечитывается вместе с упоминаниями
+	published, err := s.repo.GetByID(comment.ID)
+	if err != nil {
+		return
+	}
+	article, err := s.articleRepo.GetByID(comment.ArticleID)
+	if err != nil {
+		return
+	}
+	s.notifications.NotifyComment(published, article)
+}
+
+// mentionLinks преобразует упомянутых пользователей в ссылки упоминаний комментария.
+func mentionLinks(users []*models.User) []models.CommentMention {
+	links := make([]models.CommentMention, 0, len(users))
+	for _, user := range users {
+		links = append(links, models.CommentMention{UserID: user.ID})
+	}
+	return links
+}
+
+// assignStatus определяет статус нового комментария и сохраняет в нём оценку спам-фильтра.
+// Комментарии модераторов и администраторов публикуются сразу без проверки.
+func (s *CommentService) assignStatus(article *models.Article, comment *models.Comment, userRoles []string) error {
+	comment.Status = models.CommentStatusApproved
+	if canModerateComments(userRoles) {
+		return nil
+	}
+	verdict, err := s.spamFilter.Check(comment.AuthorID, comment.Text)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to check comment for spam")
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	comment.SpamScore = verdict.Score
+	comment.SpamReasons = strings.Join(verdict.Reasons, ",")
+	switch verdict.Action {
+	case spam.ActionReject:
+		comment.Status = models.CommentStatusSpam
+		return nil
+	case spam.ActionQueue:
+		comment.Status = models.CommentStatusPending
+		return nil
+	}
+
+	premoderate := s.cfg.PremoderateNewUsers
+	if article.PremoderateComments != nil {
+		premoderate = *article.PremoderateComments
+	}
+	if !premoderate {
+		return nil
+	}
+	isNew, err := s.isNewUser(comment.AuthorID)
+	if err != nil {
+		return err
+	}
+	if isNew {
+		comment.Status = models.CommentStatusPending
+	}
+	return nil
+}
+
+// isNewUser сообщает, считается ли пользователь новым: его учётная запись моложе
+// COMMENT_NEW_USER_DAYS или у него меньше COMMENT_TRUSTED_AFTER одобренных комментариев.
+func (s *CommentService) isNewUser(userID uint) (bool, error) {
+	if s.cfg.NewUserDays > 0 {
+		user, err := s.userRepo.GetByID(userID)
+		if err != nil {
+			return false, errors.New(apperrors.ErrUserNotFound)
+		}
+		if time.Since(user.CreatedAt) < s.cfg.NewUserPeriod() {
+			return true, nil
+		}
+	}
+	if s.cfg.TrustedAfter > 0 {
+		approved, err := s.repo.CountApprovedByAuthor(userID)
+		if err != nil {
+			return false, errors.New(apperrors.ErrInternalServerError)
+		}
+		if approved < int64(s.cfg.TrustedAfter) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// train обучает спам-фильтр на решении модератора по комментарию.
+// Ошибка обучения не отменяет решение и только записывается в журнал.
+func (s *CommentService) train(comment *models.Comment) {
+	var label string
+	switch comment.Status {
+	case models.CommentStatusSpam:
+		label = models.SpamLabelSpam
+	case models.CommentStatusApproved:
+		label = models.SpamLabelHam
+	default:
+		return
+	}
+	if err := s.spamFilter.Train(comment, label); err != nil {
+		s.Logger.WithField("comment_id", comment.ID).WithError(err).Error("Failed to train spam filter")
+	}
+}
+
+// canModerateComments сообщает, может ли пользователь модерировать комментарии.
+func canModerateComments(userRoles []string) bool {
+	return slices.ContainsFunc(userRoles, func(role string) bool {
+		return role == "moderator" || role == "admin"
+	})
+}
+
+// indexComment обновляет комментарий в поисковом индексе с учётом видимости его статьи;
+// комментарии к статьям в корзине удаляются из индекса.
+func indexComment(indexer *search.Indexer, articleRepo *repositories.ArticleRepository, comment *models.Comment) {
+	article, err := articleRepo.GetByID(comment.ArticleID)
+	if err != nil {
+		article = nil
+	}
+	indexer.IndexComment(comment, article)
+}
+
+// reindexArticleComments заново индексирует комментарии статьи после изменения её видимости.
+// Ошибка загрузки комментариев уже записана в журнал репозиторием: индекс восстановится
+// при следующей переиндексации.
+func reindexArticleComments(indexer *search.Indexer, commentRepo *repositories.CommentRepository, article *models.Article) {
+	comments, err := commentRepo.GetAllByArticleID(article.ID)
+	if err != nil {
+		return
+	}
+	indexer.IndexArticleComments(article, comments)
+}
+
+// canViewComment сообщает, виден ли комментарий пользователю: неодобренный или скрытый
+// по жалобам комментарий виден только его автору, модераторам и администраторам.
+// У надгробий автор стёрт (author_id = 0), поэтому анонимный запрос (userID = 0) автором не считается.
+func canViewComment(comment *models.Comment, userID uint, userRoles []string) bool {
+	return comment.IsPublic() || (userID != 0 && comment.AuthorID == userID) || canModerateComments(userRoles)
+}
+
+// UpdateComment редактирует содержимое комментария, сохраняя прежний текст в истории правок.
+// Автор может редактировать комментарий в течение COMMENT_EDIT_WINDOW после создания,
+// модераторы и администраторы — в любое время. Удалённый с сохранением ветки комментарий
+// редактировать нельзя. Новый текст проходит спам-фильтр и премодерацию, как новый комментарий.
 func (s *CommentService) UpdateComment(id uint, input dto.CommentInput, userID uint, roles []string) (*models.Comment, error) {
 	comment, err := s.repo.GetByID(id)
-	if err != nil {
+	if err != nil || comment.Tombstone {
 		return nil, errors.New(apperrors.ErrCommentNotFound)
 	}
 	if !utils.IsOwner(comment.AuthorID, userID, roles) {
 		return nil, errors.New(apperrors.ErrAccessDenied)
 	}
+	if !canModerateComments(roles) && s.cfg.EditWindowMinutes > 0 && time.Since(comment.CreatedAt) > s.cfg.EditWindow() {
+		return nil, errors.New(apperrors.ErrCommentEditExpired)
+	}
+	if utils.Sanitize(input.Text) == comment.Text {
+		return comment, nil
+	}
+	mentioned, err := s.resolveMentions(input.Text)
+	if err != nil {
+		return nil, err
+	}
+	previous := make(map[uint]bool, len(comment.Mentions))
+	for _, mention := range comment.Mentions {
+		previous[mention.UserID] = true
+	}
+	added := []uint{}
+	for _, user := range mentioned {
+		if !previous[user.ID] {
+			added = append(added, user.ID)
+		}
+	}
+
+	article, err := s.articleRepo.GetByID(comment.ArticleID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrCommentNotFound)
+	}
+
+	revision := &models.CommentRevision{CommentID: comment.ID, EditorID: userID, Text: comment.Text}
+	now := time.Now()
 	comment.Text = input.Text
-	if err := s.repo.Update(comment); err != nil {
+	comment.EditedAt = &now
+	comment.Mentions = mentionLinks(mentioned)
+	if err := s.recheckStatus(article, comment, roles); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(comment, revision); err != nil {
 		s.Logger.WithError(err).Error("Failed to update comment in repository")
 		return nil, err
 	}
+	for i := range comment.Mentions {
+		comment.Mentions[i].User = mentioned[i]
+	}
+	s.indexer.IndexComment(comment, article)
+	if len(added) > 0 && comment.IsPublic() {
+		s.notifications.NotifyMentions(comment, article, added)
+	}
 	return comment, nil
 }
 
-// DeleteComment удаляет комментарий по ID.
-func (s *CommentService) DeleteComment(commentID uint, userID uint, userRoles []string) error {
-	comment, err := s.repo.GetByID(commentID)
+// recheckStatus проверяет отредактированный комментарий так же, как новый, в assignStatus.
+// Правка может только ужесточить статус: одобренный комментарий возвращается на модерацию
+// или помечается как спам, но отклонённый или ожидающий модерации комментарий не публикуется.
+func (s *CommentService) recheckStatus(article *models.Article, comment *models.Comment, roles []string) error {
+	current := comment.Status
+	if err := s.assignStatus(article, comment, roles); err != nil {
+		return err
+	}
+	switch {
+	case comment.Status == models.CommentStatusSpam && current != models.CommentStatusRejected:
+	case comment.Status == models.CommentStatusPending && current == models.CommentStatusApproved:
+	default:
+		comment.Status = current
+	}
+	return nil
+}
+
+// GetRevisions возвращает историю правок комментария, начиная с последней.
+func (s *CommentService) GetRevisions(commentID uint) ([]*models.CommentRevision, error) {
+	if _, err := s.repo.GetByID(commentID); err != nil {
+		return nil, errors.New(apperrors.ErrCommentNotFound)
+	}
+	revisions, err := s.repo.GetRevisions(commentID)
 	if err != nil {
-		return errors.New(apperrors.ErrCommentNotFound)
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return revisions, nil
+}
+
+// DeleteComment удаляет комментарий. Комментарий с ответами заменяется надгробием: автор и текст
+// стираются, а ветка ответов сохраняется; комментарий без ответов перемещается в корзину.
+// Возвращает true, если комментарий заменён надгробием.
+func (s *CommentService) DeleteComment(commentID uint, userID uint, userRoles []string) (bool, error) {
+	comment, err := s.repo.GetByID(commentID)
+	if err != nil || comment.Tombstone {
+		return false, errors.New(apperrors.ErrCommentNotFound)
 	}
 	// Проверяем права через IsOwner
 	if !utils.IsOwner(comment.AuthorID, userID, userRoles) {
-		return errors.New(apperrors.ErrAccessDenied)
+		return false, errors.New(apperrors.ErrAccessDenied)
+	}
+	replies, err := s.repo.CountReplies(commentID)
+	if err != nil {
+		return false, errors.New(apperrors.ErrInternalServerError)
+	}
+	if replies > 0 {
+		if err := s.repo.Tombstone(commentID); err != nil {
+			return false, errors.New(apperrors.ErrInternalServerError)
+		}
+		s.indexer.RemoveComment(commentID)
+		return true, nil
 	}
 	if err := s.repo.Delete(commentID); err != nil {
 		s.Logger.WithError(err).Error("Failed to delete comment from repository")
-		return err
+		return false, err
 	}
-	return nil
+	s.indexer.RemoveComment(commentID)
+	return false, nil
+}
+
+// RemoveSubtree окончательно удаляет комментарий модератором вместе со всеми ответами на него
+// и записывает удаление с причиной в журнал.
+func (s *CommentService) RemoveSubtree(commentID uint, reason string, moderatorID uint) (*models.CommentRemoval, error) {
+	comment, err := s.repo.GetByID(commentID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrCommentNotFound)
+	}
+	removal := &models.CommentRemoval{
+		CommentID:   comment.ID,
+		ArticleID:   comment.ArticleID,
+		AuthorID:    comment.AuthorID,
+		ModeratorID: moderatorID,
+		Reason:      reason,
+	}
+	ids, err := s.repo.RemoveSubtree(removal)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	for _, id := range ids {
+		s.indexer.RemoveComment(id)
+	}
+	s.Logger.WithFields(map[string]interface{}{
+		"comment_id":    commentID,
+		"moderator_id":  moderatorID,
+		"removed_count": removal.RemovedCount,
+	}).Info("Comment subtree removed by moderator")
+	return removal, nil
+}
+
+// GetRemovals возвращает журнал удалений веток комментариев модераторами, начиная с недавних,
+// и общее количество записей.
+func (s *CommentService) GetRemovals(limit, offset int) ([]*models.CommentRemoval, int64, error) {
+	removals, total, err := s.repo.GetRemovals(limit, offset)
+	if err != nil {
+		return nil, 0, errors.New(apperrors.ErrInternalServerError)
+	}
+	return removals, total, nil
 }
@@ -0,0 +1,37 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+)
+
+func TestCanViewComment(t *testing.T) {
+	approved := models.Comment{AuthorID: 5, Status: models.CommentStatusApproved}
+	pending := models.Comment{AuthorID: 5, Status: models.CommentStatusPending}
+	hidden := models.Comment{AuthorID: 5, Status: models.CommentStatusApproved, Hidden: true}
+	pendingTombstone := models.Comment{AuthorID: 0, Status: models.CommentStatusPending, Tombstone: true}
+	tests := []struct {
+		name    string
+		comment models.Comment
+		userID  uint
+		roles   []string
+		want    bool
+	}{
+		{name: "approved comment to anonymous reader", comment: approved, want: true},
+		{name: "pending comment to anonymous reader", comment: pending, want: false},
+		{name: "pending comment to its author", comment: pending, userID: 5, roles: []string{"user"}, want: true},
+		{name: "pending comment to another user", comment: pending, userID: 6, roles: []string{"user"}, want: false},
+		{name: "hidden comment to its author", comment: hidden, userID: 5, roles: []string{"user"}, want: true},
+		{name: "hidden comment to moderator", comment: hidden, userID: 6, roles: []string{"moderator"}, want: true},
+		{name: "pending tombstone to anonymous reader", comment: pendingTombstone, want: false},
+		{name: "pending tombstone to admin", comment: pendingTombstone, userID: 1, roles: []string{"admin"}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := canViewComment(&tt.comment, tt.userID, tt.roles); got != tt.want {
+				t.Errorf("canViewComment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
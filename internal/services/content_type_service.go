@@ -0,0 +1,126 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"github.com/AsterOzlob/content_managment_api/internal/schema"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// ContentTypeService предоставляет методы для управления пользовательскими типами контента.
+type ContentTypeService struct {
+	repo   *repositories.ContentTypeRepository
+	Logger logger.Logger
+}
+
+// NewContentTypeService создаёт новый экземпляр ContentTypeService.
+func NewContentTypeService(repo *repositories.ContentTypeRepository, logger logger.Logger) *ContentTypeService {
+	return &ContentTypeService{repo: repo, Logger: logger}
+}
+
+// CreateContentType создаёт новый тип контента.
+// Возвращает *schema.ValidationError, если определения полей некорректны.
+func (s *ContentTypeService) CreateContentType(input dto.ContentTypeInput) (*models.ContentType, error) {
+	if err := schema.ValidateFields(input.Fields); err != nil {
+		return nil, err
+	}
+	slug := contentTypeSlug(input)
+	if slug == "" {
+		return nil, errors.New(apperrors.ErrInvalidSlug)
+	}
+	if existing, err := s.repo.GetBySlug(slug); err == nil && existing != nil {
+		return nil, errors.New(apperrors.ErrContentTypeAlreadyExists)
+	}
+	contentType := &models.ContentType{
+		Name:        input.Name,
+		Slug:        slug,
+		Description: input.Description,
+		Fields:      input.Fields,
+	}
+	if err := s.repo.Create(contentType); err != nil {
+		s.Logger.WithError(err).Error("Failed to create content type in repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return contentType, nil
+}
+
+// GetAllContentTypes возвращает список всех типов контента.
+func (s *ContentTypeService) GetAllContentTypes() ([]*models.ContentType, error) {
+	contentTypes, err := s.repo.GetAll()
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch content types from repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return contentTypes, nil
+}
+
+// GetContentType возвращает тип контента по slug.
+func (s *ContentTypeService) GetContentType(slug string) (*models.ContentType, error) {
+	contentType, err := s.repo.GetBySlug(slug)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrContentTypeNotFound)
+	}
+	return contentType, nil
+}
+
+// UpdateContentType обновляет тип контента.
+// Существующие записи не перепроверяются: новые правила применяются при их следующем сохранении.
+func (s *ContentTypeService) UpdateContentType(slug string, input dto.ContentTypeInput) (*models.ContentType, error) {
+	contentType, err := s.repo.GetBySlug(slug)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrContentTypeNotFound)
+	}
+	if err := schema.ValidateFields(input.Fields); err != nil {
+		return nil, err
+	}
+	newSlug := contentTypeSlug(input)
+	if newSlug == "" {
+		return nil, errors.New(apperrors.ErrInvalidSlug)
+	}
+	if existing, err := s.repo.GetBySlug(newSlug); err == nil && existing.ID != contentType.ID {
+		return nil, errors.New(apperrors.ErrContentTypeAlreadyExists)
+	}
+	contentType.Name = input.Name
+	contentType.Slug = newSlug
+	contentType.Description = input.Description
+	contentType.Fields = input.Fields
+	if err := s.repo.Update(contentType); err != nil {
+		s.Logger.WithError(err).Error("Failed to update content type in repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return contentType, nil
+}
+
+// DeleteContentType удаляет тип контента, если у него нет записей.
+func (s *ContentTypeService) DeleteContentType(slug string) error {
+	contentType, err := s.repo.GetBySlug(slug)
+	if err != nil {
+		return errors.New(apperrors.ErrContentTypeNotFound)
+	}
+	entries, err := s.repo.CountEntries(contentType.ID)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to count content type entries in repository")
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	if entries > 0 {
+		return errors.New(apperrors.ErrContentTypeHasEntries)
+	}
+	if err := s.repo.Delete(contentType.ID); err != nil {
+		s.Logger.WithError(err).Error("Failed to delete content type from repository")
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	return nil
+}
+
+// contentTypeSlug возвращает slug типа контента из входных данных или формирует его из названия.
+func contentTypeSlug(input dto.ContentTypeInput) string {
+	if input.Slug != "" {
+		return utils.Slugify(input.Slug)
+	}
+	return utils.Slugify(input.Name)
+}
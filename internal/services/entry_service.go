@@ -0,0 +1,178 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"github.com/AsterOzlob/content_managment_api/internal/schema"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// EntryService предоставляет методы для управления записями пользовательских типов контента.
+type EntryService struct {
+	repo      *repositories.EntryRepository
+	typeRepo  *repositories.ContentTypeRepository
+	mediaRepo *repositories.MediaRepository
+	Logger    logger.Logger
+}
+
+// NewEntryService создаёт новый экземпляр EntryService.
+func NewEntryService(
+	repo *repositories.EntryRepository,
+	typeRepo *repositories.ContentTypeRepository,
+	mediaRepo *repositories.MediaRepository,
+	logger logger.Logger,
+) *EntryService {
+	return &EntryService{
+		repo:      repo,
+		typeRepo:  typeRepo,
+		mediaRepo: mediaRepo,
+		Logger:    logger,
+	}
+}
+
+// CreateEntry создаёт запись указанного типа.
+// Возвращает *schema.ValidationError, если данные не соответствуют определению типа.
+func (s *EntryService) CreateEntry(typeSlug string, input dto.EntryInput, userID uint) (*models.Entry, *models.ContentType, error) {
+	contentType, err := s.typeRepo.GetBySlug(typeSlug)
+	if err != nil {
+		return nil, nil, errors.New(apperrors.ErrContentTypeNotFound)
+	}
+	data, err := s.validateData(contentType, input.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	entry := &models.Entry{
+		ContentTypeID: contentType.ID,
+		AuthorID:      userID,
+		Data:          data,
+		Published:     input.Published,
+	}
+	if err := s.repo.Create(entry); err != nil {
+		s.Logger.WithError(err).Error("Failed to create entry in repository")
+		return nil, nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return entry, contentType, nil
+}
+
+// GetEntries возвращает страницу записей указанного типа. Как и черновики статей, неопубликованные
+// записи видны только их авторам, редакторам, модераторам и администраторам;
+// для анонимных запросов userID равен 0.
+func (s *EntryService) GetEntries(typeSlug string, limit, offset int, userID uint, userRoles []string) ([]*models.Entry, *models.ContentType, error) {
+	contentType, err := s.typeRepo.GetBySlug(typeSlug)
+	if err != nil {
+		return nil, nil, errors.New(apperrors.ErrContentTypeNotFound)
+	}
+	entries, err := s.repo.GetAllByContentType(contentType.ID, !canViewAllDrafts(userRoles), userID, limit, offset)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch entries from repository")
+		return nil, nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return entries, contentType, nil
+}
+
+// GetEntry возвращает запись указанного типа по ID. Неопубликованная запись видна только автору,
+// редакторам, модераторам и администраторам; остальным возвращается ErrEntryNotFound.
+func (s *EntryService) GetEntry(typeSlug string, id uint, userID uint, userRoles []string) (*models.Entry, *models.ContentType, error) {
+	entry, contentType, err := s.getEntry(typeSlug, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !canViewEntry(entry, userID, userRoles) {
+		return nil, nil, errors.New(apperrors.ErrEntryNotFound)
+	}
+	return entry, contentType, nil
+}
+
+// canViewEntry сообщает, видна ли запись пользователю: черновик виден только автору,
+// редакторам, модераторам и администраторам.
+func canViewEntry(entry *models.Entry, userID uint, userRoles []string) bool {
+	return entry.Published || (userID != 0 && entry.AuthorID == userID) || canViewAllDrafts(userRoles)
+}
+
+// getEntry возвращает запись указанного типа по ID без проверки видимости.
+func (s *EntryService) getEntry(typeSlug string, id uint) (*models.Entry, *models.ContentType, error) {
+	contentType, err := s.typeRepo.GetBySlug(typeSlug)
+	if err != nil {
+		return nil, nil, errors.New(apperrors.ErrContentTypeNotFound)
+	}
+	entry, err := s.repo.GetByID(contentType.ID, id)
+	if err != nil {
+		return nil, nil, errors.New(apperrors.ErrEntryNotFound)
+	}
+	return entry, contentType, nil
+}
+
+// UpdateEntry обновляет запись после проверки прав доступа.
+func (s *EntryService) UpdateEntry(typeSlug string, id uint, input dto.EntryInput, userID uint, userRoles []string) (*models.Entry, *models.ContentType, error) {
+	entry, contentType, err := s.getEntry(typeSlug, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !utils.IsOwner(entry.AuthorID, userID, userRoles) {
+		return nil, nil, errors.New(apperrors.ErrAccessDenied)
+	}
+	data, err := s.validateData(contentType, input.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	entry.Data = data
+	entry.Published = input.Published
+	if err := s.repo.Update(entry); err != nil {
+		s.Logger.WithError(err).Error("Failed to update entry in repository")
+		return nil, nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return entry, contentType, nil
+}
+
+// DeleteEntry удаляет запись после проверки прав доступа.
+func (s *EntryService) DeleteEntry(typeSlug string, id uint, userID uint, userRoles []string) error {
+	entry, _, err := s.getEntry(typeSlug, id)
+	if err != nil {
+		return err
+	}
+	if !utils.IsOwner(entry.AuthorID, userID, userRoles) {
+		s.Logger.WithFields(map[string]interface{}{
+			"entry_id": id,
+			"user_id":  userID,
+			"roles":    userRoles,
+		}).Warn("Access denied: user is not the owner or doesn't have required role")
+		return errors.New(apperrors.ErrAccessDenied)
+	}
+	if err := s.repo.Delete(id); err != nil {
+		s.Logger.WithError(err).Error("Failed to delete entry from repository")
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	return nil
+}
+
+// validateData проверяет данные записи по определению типа и существование медиафайлов в полях media.
+func (s *EntryService) validateData(contentType *models.ContentType, raw map[string]interface{}) (schema.Values, error) {
+	data, err := schema.ValidateValues(contentType.Fields, raw)
+	if err != nil {
+		return nil, err
+	}
+	mediaIDs := schema.MediaIDs(contentType.Fields, data)
+	if len(mediaIDs) == 0 {
+		return data, nil
+	}
+	media, err := s.mediaRepo.GetByIDs(mediaIDs)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch entry media from repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	found := make(map[uint]bool, len(media))
+	for _, m := range media {
+		found[m.ID] = true
+	}
+	for _, id := range mediaIDs {
+		if !found[id] {
+			return nil, &schema.ValidationError{Message: "referenced media not found"}
+		}
+	}
+	return data, nil
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	"github.com/AsterOzlob/content_managment_api/internal/feeds"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// feedSummaryLength — максимальная длина краткого содержания записи ленты в символах.
+const feedSummaryLength = 300
+
+// FeedService формирует ленты опубликованных статей.
+type FeedService struct {
+	articleRepo  *repositories.ArticleRepository
+	tagRepo      *repositories.TagRepository
+	categoryRepo *repositories.CategoryRepository
+	userRepo     *repositories.UserRepository
+	seriesRepo   *repositories.SeriesRepository
+	site         *config.SiteConfig
+	locales      *config.LocaleConfig
+	Logger       logger.Logger
+}
+
+// NewFeedService создаёт новый экземпляр FeedService.
+func NewFeedService(
+	articleRepo *repositories.ArticleRepository,
+	tagRepo *repositories.TagRepository,
+	categoryRepo *repositories.CategoryRepository,
+	userRepo *repositories.UserRepository,
+	seriesRepo *repositories.SeriesRepository,
+	site *config.SiteConfig,
+	locales *config.LocaleConfig,
+	logger logger.Logger,
+) *FeedService {
+	return &FeedService{
+		articleRepo:  articleRepo,
+		tagRepo:      tagRepo,
+		categoryRepo: categoryRepo,
+		userRepo:     userRepo,
+		seriesRepo:   seriesRepo,
+		site:         site,
+		locales:      locales,
+		Logger:       logger,
+	}
+}
+
+// BuildArticleFeed формирует ленту последних опубликованных статей с учётом фильтров
+// по автору, тегу и рубрике. feedPath — путь ленты относительно базового URL сайта.
+func (s *FeedService) BuildArticleFeed(query dto.FeedQuery, feedPath string) (*feeds.Feed, error) {
+	filter := repositories.ArticleFilter{
+		TagSlug:       query.Tag,
+		AuthorID:      query.AuthorID,
+		PublishedOnly: true,
+		Limit:         s.site.FeedLimit,
+	}
+	var titleParts []string
+
+	if query.AuthorID != 0 {
+		author, err := s.userRepo.GetByID(query.AuthorID)
+		if err != nil {
+			return nil, errors.New(apperrors.ErrUserNotFound)
+		}
+		titleParts = append(titleParts, "автор: "+author.Username)
+	}
+	if query.Tag != "" {
+		tag, err := s.tagRepo.GetBySlug(query.Tag)
+		if err != nil {
+			return nil, errors.New(apperrors.ErrTagNotFound)
+		}
+		titleParts = append(titleParts, "тег: "+tag.Name)
+	}
+	if query.Category != "" {
+		category, err := s.categoryRepo.GetBySlug(query.Category)
+		if err != nil {
+			return nil, errors.New(apperrors.ErrCategoryNotFound)
+		}
+		categoryIDs, err := s.categoryRepo.GetDescendantIDs(category.ID)
+		if err != nil {
+			s.Logger.WithError(err).Error("Failed to fetch category descendants from repository")
+			return nil, errors.New(apperrors.ErrInternalServerError)
+		}
+		filter.CategoryIDs = categoryIDs
+		titleParts = append(titleParts, "рубрика: "+category.Name)
+	}
+
+	articles, err := s.articleRepo.GetAll(filter)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch articles for feed from repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+
+	title := s.site.Title
+	if len(titleParts) > 0 {
+		title = fmt.Sprintf("%s — %s", title, strings.Join(titleParts, ", "))
+	}
+	return s.buildFeed(title, s.site.Description, s.site.URL("/"), feedPath, articles)
+}
+
+// BuildSeriesFeed формирует ленту опубликованных частей серии в порядке их следования.
+// feedPath — путь ленты относительно базового URL сайта.
+func (s *FeedService) BuildSeriesFeed(slug string, feedPath string) (*feeds.Feed, error) {
+	series, err := s.seriesRepo.GetBySlug(slug)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrSeriesNotFound)
+	}
+	ids := make([]uint, 0, len(series.Parts))
+	positions := make(map[uint]int, len(series.Parts))
+	for _, part := range series.Parts {
+		ids = append(ids, part.ArticleID)
+		positions[part.ArticleID] = part.Position
+	}
+	articles, err := s.articleRepo.GetAll(repositories.ArticleFilter{IDs: ids, PublishedOnly: true})
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch series articles for feed from repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	slices.SortFunc(articles, func(a, b *models.Article) int {
+		return positions[a.ID] - positions[b.ID]
+	})
+
+	title := fmt.Sprintf("%s — %s", s.site.Title, html.UnescapeString(series.Title))
+	description := html.UnescapeString(series.Description)
+	if description == "" {
+		description = s.site.Description
+	}
+	return s.buildFeed(title, description, s.site.URL("/series/"+series.Slug), feedPath, articles)
+}
+
+// buildFeed собирает ленту из статей, сохраняя их порядок.
+func (s *FeedService) buildFeed(title, description, link, feedPath string, articles []*models.Article) (*feeds.Feed, error) {
+	authors, err := s.authorNames(articles)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	feed := &feeds.Feed{
+		Title:       title,
+		Description: description,
+		Link:        link,
+		FeedURL:     s.site.URL(feedPath),
+		Language:    s.locales.Default,
+		Items:       make([]feeds.Item, 0, len(articles)),
+	}
+	for _, article := range articles {
+		item := s.articleItem(article, authors[article.AuthorID])
+		if item.Updated.After(feed.Updated) {
+			feed.Updated = item.Updated
+		}
+		feed.Items = append(feed.Items, item)
+	}
+	return feed, nil
+}
+
+// articleItem преобразует статью в запись ленты.
+// Заголовки хранятся HTML-экранированными, поэтому перед сериализацией они раскодируются.
+func (s *FeedService) articleItem(article *models.Article, authorName string) feeds.Item {
+	link := s.site.URL(fmt.Sprintf("/articles/%d", article.ID))
+	item := feeds.Item{
+		ID:          link,
+		Title:       html.UnescapeString(article.Title),
+		Link:        link,
+		Summary:     utils.Excerpt(article.PlainText, feedSummaryLength),
+		ContentHTML: article.TextHTML,
+		AuthorName:  authorName,
+		Published:   article.CreatedAt,
+		Updated:     article.UpdatedAt,
+	}
+	if article.Category != nil {
+		item.Categories = append(item.Categories, html.UnescapeString(article.Category.Name))
+	}
+	for _, tag := range article.Tags {
+		item.Categories = append(item.Categories, html.UnescapeString(tag.Name))
+	}
+	for _, media := range article.Media {
+		item.Enclosures = append(item.Enclosures, feeds.Enclosure{
+			URL:    s.site.URL(media.FilePath),
+			Type:   media.FileType,
+			Length: media.FileSize,
+		})
+	}
+	return item
+}
+
+// authorNames загружает имена авторов статей.
+func (s *FeedService) authorNames(articles []*models.Article) (map[uint]string, error) {
+	names := make(map[uint]string)
+	if len(articles) == 0 {
+		return names, nil
+	}
+	ids := make([]uint, 0, len(articles))
+	for _, article := range articles {
+		ids = append(ids, article.AuthorID)
+	}
+	users, err := s.userRepo.GetByIDs(ids)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch feed authors from repository")
+		return nil, err
+	}
+	for _, user := range users {
+		names[user.ID] = user.Username
+	}
+	return names, nil
+}
@@ -0,0 +1,171 @@
+package services
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+)
+
+// Following описывает авторов и теги, на которые подписан пользователь.
+type Following struct {
+	Authors []*models.AuthorFollow
+	Tags    []*models.TagFollow
+}
+
+// PersonalFeed описывает страницу персональной ленты.
+type PersonalFeed struct {
+	Articles   []*models.Article
+	NextCursor string // Курсор следующей страницы; пустой, если статей больше нет.
+}
+
+// FollowService предоставляет методы для управления подписками и персональной лентой.
+type FollowService struct {
+	repo        *repositories.FollowRepository
+	userRepo    *repositories.UserRepository
+	tagRepo     *repositories.TagRepository
+	articleRepo *repositories.ArticleRepository
+	Logger      logger.Logger
+}
+
+// NewFollowService создаёт новый экземпляр FollowService.
+func NewFollowService(
+	repo *repositories.FollowRepository,
+	userRepo *repositories.UserRepository,
+	tagRepo *repositories.TagRepository,
+	articleRepo *repositories.ArticleRepository,
+	logger logger.Logger,
+) *FollowService {
+	return &FollowService{
+		repo:        repo,
+		userRepo:    userRepo,
+		tagRepo:     tagRepo,
+		articleRepo: articleRepo,
+		Logger:      logger,
+	}
+}
+
+// FollowAuthor подписывает пользователя на автора.
+func (s *FollowService) FollowAuthor(authorID uint, userID uint) error {
+	if authorID == userID {
+		return errors.New(apperrors.ErrCannotFollowYourself)
+	}
+	if _, err := s.userRepo.GetByID(authorID); err != nil {
+		return errors.New(apperrors.ErrUserNotFound)
+	}
+	if err := s.repo.FollowAuthor(userID, authorID); err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	return nil
+}
+
+// UnfollowAuthor отписывает пользователя от автора.
+func (s *FollowService) UnfollowAuthor(authorID uint, userID uint) error {
+	if err := s.repo.UnfollowAuthor(userID, authorID); err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	return nil
+}
+
+// FollowTag подписывает пользователя на тег.
+func (s *FollowService) FollowTag(tagID uint, userID uint) error {
+	if _, err := s.tagRepo.GetByID(tagID); err != nil {
+		return errors.New(apperrors.ErrTagNotFound)
+	}
+	if err := s.repo.FollowTag(userID, tagID); err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	return nil
+}
+
+// UnfollowTag отписывает пользователя от тега.
+func (s *FollowService) UnfollowTag(tagID uint, userID uint) error {
+	if err := s.repo.UnfollowTag(userID, tagID); err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	return nil
+}
+
+// GetFollowing возвращает авторов и теги, на которые подписан пользователь.
+// Удалённые авторы не возвращаются.
+func (s *FollowService) GetFollowing(userID uint) (*Following, error) {
+	authors, err := s.repo.GetFollowedAuthors(userID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	tags, err := s.repo.GetFollowedTags(userID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	active := authors[:0]
+	for _, follow := range authors {
+		if follow.Author != nil {
+			active = append(active, follow)
+		}
+	}
+	return &Following{Authors: active, Tags: tags}, nil
+}
+
+// GetPersonalFeed возвращает страницу опубликованных статей авторов и тегов, на которые подписан
+// пользователь, от недавно опубликованных к давним. cursor — курсор из предыдущей страницы или пустая строка.
+func (s *FollowService) GetPersonalFeed(userID uint, cursor string, limit int) (*PersonalFeed, error) {
+	filter := repositories.ArticleFilter{
+		FollowedBy:    userID,
+		PublishedOnly: true,
+		Limit:         limit + 1,
+		Sort:          repositories.ArticleSortPublished,
+	}
+	if cursor != "" {
+		before, err := decodeArticleCursor(cursor)
+		if err != nil {
+			return nil, errors.New(apperrors.ErrInvalidCursor)
+		}
+		filter.Before = before
+	}
+	articles, err := s.articleRepo.GetAll(filter)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch personal feed from repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	feed := &PersonalFeed{Articles: articles}
+	if len(articles) > limit {
+		feed.Articles = articles[:limit]
+		last := feed.Articles[limit-1]
+		feed.NextCursor = encodeArticleCursor(repositories.ArticleCursor{PublishedAt: *last.PublishedAt, ID: last.ID})
+	}
+	return feed, nil
+}
+
+// encodeArticleCursor кодирует позицию статьи в непрозрачную строку для клиента.
+func encodeArticleCursor(cursor repositories.ArticleCursor) string {
+	raw := fmt.Sprintf("%d:%d", cursor.PublishedAt.UnixMicro(), cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeArticleCursor восстанавливает позицию статьи из строки, полученной от encodeArticleCursor.
+func decodeArticleCursor(value string) (*repositories.ArticleCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+	micros, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return nil, fmt.Errorf("malformed article cursor %q", raw)
+	}
+	publishedAt, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	articleID, err := strconv.ParseUint(id, 10, 0)
+	if err != nil {
+		return nil, err
+	}
+	return &repositories.ArticleCursor{PublishedAt: time.UnixMicro(publishedAt), ID: uint(articleID)}, nil
+}
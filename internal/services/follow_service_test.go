@@ -0,0 +1,56 @@
+package services
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+)
+
+func TestArticleCursorRoundTrip(t *testing.T) {
+	cursors := []repositories.ArticleCursor{
+		{PublishedAt: time.Date(2024, 2, 29, 23, 59, 59, 123456000, time.UTC), ID: 42},
+		{PublishedAt: time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC), ID: 1},
+		{PublishedAt: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), ID: 4294967295},
+	}
+	for _, cursor := range cursors {
+		encoded := encodeArticleCursor(cursor)
+		decoded, err := decodeArticleCursor(encoded)
+		if err != nil {
+			t.Fatalf("decodeArticleCursor(%q) error = %v", encoded, err)
+		}
+		if !decoded.PublishedAt.Equal(cursor.PublishedAt) || decoded.ID != cursor.ID {
+			t.Errorf("cursor %v decoded as %v", cursor, *decoded)
+		}
+	}
+
+	// PostgreSQL хранит время с точностью до микросекунд, курсор тоже
+	withNanos := repositories.ArticleCursor{PublishedAt: time.Unix(100, 1500), ID: 7}
+	decoded, _ := decodeArticleCursor(encodeArticleCursor(withNanos))
+	if want := time.Unix(100, 1000); !decoded.PublishedAt.Equal(want) {
+		t.Errorf("cursor time = %v, want it truncated to %v", decoded.PublishedAt, want)
+	}
+}
+
+func TestDecodeArticleCursorRejectsInvalidInput(t *testing.T) {
+	encode := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+	invalid := map[string]string{
+		"not base64":              "%%%",
+		"padded base64":           base64.URLEncoding.EncodeToString([]byte("10:2")),
+		"empty payload":           "",
+		"no separator":            encode("1700000000000000"),
+		"empty id":                encode("1700000000000000:"),
+		"empty time":              encode(":5"),
+		"negative id":             encode("1700000000000000:-5"),
+		"trailing data":           encode("1700000000000000:5x"),
+		"extra field":             encode("1700000000000000:5:6"),
+		"time with fraction":      encode("1.5:5"),
+		"time out of int64 range": encode("99999999999999999999:5"),
+	}
+	for name, value := range invalid {
+		if cursor, err := decodeArticleCursor(value); err == nil {
+			t.Errorf("%s: decodeArticleCursor(%q) = %v, want an error", name, value, *cursor)
+		}
+	}
+}
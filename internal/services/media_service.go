@@ -2,7 +2,6 @@ package services
 
 import (
 	"errors"
-	"os"
 
 	"github.com/AsterOzlob/content_managment_api/internal/database/models"
 	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
@@ -83,7 +82,8 @@ func (s *MediaService) GetAllByArticleID(articleID uint) ([]*models.Media, error
 	return media, nil
 }
 
-// DeleteFile удаляет медиафайл по его ID.
+// DeleteFile перемещает медиафайл в корзину. Сам файл удаляется с диска
+// при окончательной очистке корзины.
 func (s *MediaService) DeleteFile(id uint, userID uint, userRoles []string) error {
 	media, err := s.repo.GetByID(id)
 	if err != nil {
@@ -99,8 +99,5 @@ func (s *MediaService) DeleteFile(id uint, userID uint, userRoles []string) erro
 		s.Logger.WithError(err).Error("Failed to delete media from repository")
 		return err
 	}
-	if err := os.Remove(media.FilePath); err != nil {
-		s.Logger.Warn("Failed to delete media file from filesystem")
-	}
 	return nil
 }
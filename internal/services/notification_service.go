@@ -0,0 +1,204 @@
+package services
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+)
+
+// NotificationService предоставляет методы для работы с уведомлениями внутри приложения.
+type NotificationService struct {
+	repo        *repositories.NotificationRepository
+	commentRepo *repositories.CommentRepository
+	Logger      logger.Logger
+}
+
+// NewNotificationService создаёт новый экземпляр NotificationService.
+func NewNotificationService(
+	repo *repositories.NotificationRepository,
+	commentRepo *repositories.CommentRepository,
+	logger logger.Logger,
+) *NotificationService {
+	return &NotificationService{
+		repo:        repo,
+		commentRepo: commentRepo,
+		Logger:      logger,
+	}
+}
+
+// NotifyComment уведомляет о публикации комментария упомянутых в нём пользователей, автора
+// родительского комментария и автора статьи. Каждый получатель получает не больше одного
+// уведомления: упоминание важнее ответа, ответ важнее комментария к статье. Автор комментария
+// и пользователи, отключившие уведомления этого типа, уведомления не получают; уже уведомлённые
+// о комментарии пользователи повторно не уведомляются, даже если комментарий одобрен снова. Об упоминаниях
+// в комментариях к неопубликованной статье не уведомляется: упомянутые её не видят.
+// Ошибки только записываются в журнал: уведомления не должны мешать публикации комментария.
+func (s *NotificationService) NotifyComment(comment *models.Comment, article *models.Article) {
+	recipients := map[uint]string{}
+	order := []uint{}
+	add := func(userID uint, notificationType string) {
+		if userID == 0 || userID == comment.AuthorID {
+			return
+		}
+		if _, ok := recipients[userID]; ok {
+			return
+		}
+		recipients[userID] = notificationType
+		order = append(order, userID)
+	}
+
+	if article.IsPublic() {
+		for _, mention := range comment.Mentions {
+			add(mention.UserID, models.NotificationTypeMention)
+		}
+	}
+	if comment.ParentID != nil {
+		if parent, err := s.commentRepo.GetByID(*comment.ParentID); err == nil {
+			add(parent.AuthorID, models.NotificationTypeReply)
+		}
+	}
+	add(article.AuthorID, models.NotificationTypeArticleComment)
+
+	s.send(comment, order, recipients)
+}
+
+// NotifyMentions уведомляет пользователей userIDs об упоминании в опубликованном комментарии,
+// например после того как их добавили в текст при редактировании.
+func (s *NotificationService) NotifyMentions(comment *models.Comment, article *models.Article, userIDs []uint) {
+	if !article.IsPublic() {
+		return
+	}
+	recipients := map[uint]string{}
+	order := []uint{}
+	for _, userID := range userIDs {
+		if userID == comment.AuthorID {
+			continue
+		}
+		if _, ok := recipients[userID]; !ok {
+			recipients[userID] = models.NotificationTypeMention
+			order = append(order, userID)
+		}
+	}
+	s.send(comment, order, recipients)
+}
+
+// GetNotifications возвращает уведомления пользователя, начиная с новых, общее количество
+// уведомлений в выборке и количество непрочитанных.
+func (s *NotificationService) GetNotifications(userID uint, unreadOnly bool, limit, offset int) ([]*models.Notification, int64, int64, error) {
+	notifications, total, err := s.repo.GetByUser(userID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, 0, 0, errors.New(apperrors.ErrInternalServerError)
+	}
+	unread, err := s.repo.CountUnread(userID)
+	if err != nil {
+		return nil, 0, 0, errors.New(apperrors.ErrInternalServerError)
+	}
+	return notifications, total, unread, nil
+}
+
+// CountUnread возвращает количество непрочитанных уведомлений пользователя.
+func (s *NotificationService) CountUnread(userID uint) (int64, error) {
+	unread, err := s.repo.CountUnread(userID)
+	if err != nil {
+		return 0, errors.New(apperrors.ErrInternalServerError)
+	}
+	return unread, nil
+}
+
+// MarkRead отмечает уведомления пользователя прочитанными (все, если ids пуст).
+// Возвращает количество отмеченных уведомлений.
+func (s *NotificationService) MarkRead(userID uint, ids []uint) (int64, error) {
+	updated, err := s.repo.MarkRead(userID, ids)
+	if err != nil {
+		return 0, errors.New(apperrors.ErrInternalServerError)
+	}
+	return updated, nil
+}
+
+// GetPreferences возвращает настройки уведомлений пользователя для всех типов;
+// по умолчанию уведомления включены.
+func (s *NotificationService) GetPreferences(userID uint) (map[string]bool, error) {
+	saved, err := s.repo.GetPreferences(userID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	preferences := make(map[string]bool, len(models.NotificationTypes))
+	for _, notificationType := range models.NotificationTypes {
+		enabled, ok := saved[notificationType]
+		preferences[notificationType] = !ok || enabled
+	}
+	return preferences, nil
+}
+
+// UpdatePreferences сохраняет настройки уведомлений пользователя для указанных типов
+// и возвращает настройки для всех типов.
+func (s *NotificationService) UpdatePreferences(userID uint, preferences map[string]bool) (map[string]bool, error) {
+	for notificationType := range preferences {
+		if !slices.Contains(models.NotificationTypes, notificationType) {
+			return nil, errors.New(apperrors.ErrUnsupportedNotificationType)
+		}
+	}
+	if err := s.repo.SetPreferences(userID, preferences); err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return s.GetPreferences(userID)
+}
+
+// send создаёт уведомления о комментарии получателям, не отключившим уведомления своего типа.
+// Пользователи, уже получившие уведомление об этом комментарии, пропускаются: комментарий
+// может публиковаться повторно, если после правки он вернулся на модерацию и снова одобрен.
+func (s *NotificationService) send(comment *models.Comment, order []uint, recipients map[uint]string) {
+	if len(order) == 0 {
+		return
+	}
+	notified, err := s.repo.GetNotifiedUsers(comment.ID, order)
+	if err != nil {
+		s.Logger.WithField("comment_id", comment.ID).WithError(err).Error("Failed to check existing notifications")
+		return
+	}
+	skip := map[uint]bool{}
+	for _, userID := range notified {
+		skip[userID] = true
+	}
+	for _, notificationType := range models.NotificationTypes {
+		userIDs := []uint{}
+		for _, userID := range order {
+			if recipients[userID] == notificationType {
+				userIDs = append(userIDs, userID)
+			}
+		}
+		if len(userIDs) == 0 {
+			continue
+		}
+		off, err := s.repo.GetDisabledUsers(notificationType, userIDs)
+		if err != nil {
+			s.Logger.WithField("comment_id", comment.ID).WithError(err).Error("Failed to check notification preferences")
+			return
+		}
+		for _, userID := range off {
+			skip[userID] = true
+		}
+	}
+
+	commentID := comment.ID
+	notifications := make([]*models.Notification, 0, len(order))
+	for _, userID := range order {
+		if skip[userID] {
+			continue
+		}
+		notifications = append(notifications, &models.Notification{
+			UserID:    userID,
+			ActorID:   comment.AuthorID,
+			Type:      recipients[userID],
+			ArticleID: comment.ArticleID,
+			CommentID: &commentID,
+		})
+	}
+	if err := s.repo.CreateBatch(notifications); err != nil {
+		s.Logger.WithField("comment_id", comment.ID).WithError(err).Error("Failed to create comment notifications")
+	}
+}
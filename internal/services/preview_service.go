@@ -0,0 +1,169 @@
+package services
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// IssuedPreviewToken описывает выданную ссылку предпросмотра вместе с подписанным токеном.
+type IssuedPreviewToken struct {
+	Token  *models.PreviewToken
+	Signed string
+	URL    string
+}
+
+// PreviewService предоставляет методы для выдачи, отзыва и использования ссылок предпросмотра черновиков.
+type PreviewService struct {
+	repo        *repositories.PreviewTokenRepository
+	articleRepo *repositories.ArticleRepository
+	cfg         *config.PreviewConfig
+	site        *config.SiteConfig
+	Logger      logger.Logger
+}
+
+// NewPreviewService создаёт новый экземпляр PreviewService.
+func NewPreviewService(
+	repo *repositories.PreviewTokenRepository,
+	articleRepo *repositories.ArticleRepository,
+	cfg *config.PreviewConfig,
+	site *config.SiteConfig,
+	logger logger.Logger,
+) *PreviewService {
+	return &PreviewService{
+		repo:        repo,
+		articleRepo: articleRepo,
+		cfg:         cfg,
+		site:        site,
+		Logger:      logger,
+	}
+}
+
+// CreateToken выдаёт ссылку предпросмотра статьи. Доступно автору статьи,
+// редакторам, модераторам и администраторам.
+func (s *PreviewService) CreateToken(articleID uint, input dto.PreviewTokenInput, userID uint, userRoles []string) (*IssuedPreviewToken, error) {
+	if _, err := s.getManageableArticle(articleID, userID, userRoles); err != nil {
+		return nil, err
+	}
+	ttl := input.ExpiresInHours
+	if ttl == 0 {
+		ttl = s.cfg.DefaultTTL
+	}
+	if ttl > s.cfg.MaxTTL {
+		return nil, errors.New(apperrors.ErrPreviewTTLTooLong)
+	}
+	token := &models.PreviewToken{
+		ArticleID:   articleID,
+		CreatedByID: userID,
+		Note:        input.Note,
+		ExpiresAt:   time.Now().Add(time.Duration(ttl) * time.Hour),
+		TrackViews:  input.TrackViews,
+	}
+	if err := s.repo.Create(token); err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	signed, err := utils.GeneratePreviewToken(token.ID, articleID, token.ExpiresAt, s.cfg)
+	if err != nil {
+		s.Logger.WithError(err).WithField("token_id", token.ID).Error("Failed to sign preview token")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	s.Logger.WithFields(map[string]interface{}{
+		"article_id": articleID,
+		"token_id":   token.ID,
+		"user_id":    userID,
+	}).Info("Preview token issued")
+	return &IssuedPreviewToken{Token: token, Signed: signed, URL: s.site.URL("/preview/" + signed)}, nil
+}
+
+// GetTokens возвращает все ссылки предпросмотра статьи, включая отозванные и истёкшие.
+func (s *PreviewService) GetTokens(articleID uint, userID uint, userRoles []string) ([]*models.PreviewToken, error) {
+	if _, err := s.getManageableArticle(articleID, userID, userRoles); err != nil {
+		return nil, err
+	}
+	tokens, err := s.repo.GetByArticleID(articleID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return tokens, nil
+}
+
+// RevokeToken отзывает ссылку предпросмотра статьи.
+func (s *PreviewService) RevokeToken(articleID, tokenID uint, userID uint, userRoles []string) error {
+	if _, err := s.getManageableArticle(articleID, userID, userRoles); err != nil {
+		return err
+	}
+	token, err := s.repo.GetByID(tokenID)
+	if err != nil || token.ArticleID != articleID {
+		return errors.New(apperrors.ErrPreviewTokenNotFound)
+	}
+	if err := s.repo.Revoke(tokenID, time.Now()); err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	s.Logger.WithFields(map[string]interface{}{
+		"article_id": articleID,
+		"token_id":   tokenID,
+		"user_id":    userID,
+	}).Info("Preview token revoked")
+	return nil
+}
+
+// OpenPreview проверяет подписанный токен и возвращает текущее состояние статьи, включая черновик.
+// Если для токена включён учёт просмотров, записываются счётчик, время и IP-адрес.
+func (s *PreviewService) OpenPreview(signed, ip string) (*models.Article, error) {
+	tokenID, articleID, err := utils.ParsePreviewToken(signed, s.cfg)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInvalidPreviewToken)
+	}
+	token, err := s.repo.GetByID(tokenID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInvalidPreviewToken)
+	}
+	now := time.Now()
+	if err := checkPreviewToken(token, articleID, now); err != nil {
+		return nil, err
+	}
+	article, err := s.articleRepo.GetByID(articleID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrArticleNotFound)
+	}
+	if token.TrackViews {
+		if err := s.repo.RecordView(token.ID, ip, now); err != nil {
+			s.Logger.WithError(err).WithField("token_id", token.ID).Warn("Failed to record preview view")
+		}
+	}
+	return article, nil
+}
+
+// checkPreviewToken проверяет, что запись token выдана для статьи articleID из подписанного токена
+// и в момент now не отозвана и не истекла. Срок в подписи проверяется при разборе токена,
+// отзыв — только по записи.
+func checkPreviewToken(token *models.PreviewToken, articleID uint, now time.Time) error {
+	if token.ArticleID != articleID {
+		return errors.New(apperrors.ErrInvalidPreviewToken)
+	}
+	if !token.Active(now) {
+		return errors.New(apperrors.ErrPreviewTokenExpired)
+	}
+	return nil
+}
+
+// getManageableArticle возвращает статью, если пользователь может управлять её ссылками предпросмотра:
+// автор статьи, редактор, модератор или администратор.
+func (s *PreviewService) getManageableArticle(articleID uint, userID uint, userRoles []string) (*models.Article, error) {
+	article, err := s.articleRepo.GetByID(articleID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrArticleNotFound)
+	}
+	if !utils.IsOwner(article.AuthorID, userID, userRoles) && !slices.Contains(userRoles, "editor") {
+		return nil, errors.New(apperrors.ErrAccessDenied)
+	}
+	return article, nil
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+)
+
+func TestCheckPreviewToken(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	revokedAt := now.Add(-time.Minute)
+	tests := []struct {
+		name      string
+		token     models.PreviewToken
+		articleID uint
+		want      string
+	}{
+		{name: "active token", token: models.PreviewToken{ArticleID: 3, ExpiresAt: now.Add(time.Hour)}, articleID: 3},
+		{name: "token of another article", token: models.PreviewToken{ArticleID: 4, ExpiresAt: now.Add(time.Hour)}, articleID: 3, want: apperrors.ErrInvalidPreviewToken},
+		{name: "revoked before expiry", token: models.PreviewToken{ArticleID: 3, ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt}, articleID: 3, want: apperrors.ErrPreviewTokenExpired},
+		{name: "expired record", token: models.PreviewToken{ArticleID: 3, ExpiresAt: now.Add(-time.Second)}, articleID: 3, want: apperrors.ErrPreviewTokenExpired},
+		{name: "expires exactly now", token: models.PreviewToken{ArticleID: 3, ExpiresAt: now}, articleID: 3, want: apperrors.ErrPreviewTokenExpired},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkPreviewToken(&tt.token, tt.articleID, now)
+			got := ""
+			if err != nil {
+				got = err.Error()
+			}
+			if got != tt.want {
+				t.Errorf("checkPreviewToken() error = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+)
+
+// ReactionResult описывает результат переключения реакции.
+type ReactionResult struct {
+	Type    string                // Тип реакции.
+	Reacted bool                  // Поставлена ли реакция после переключения.
+	Counts  models.ReactionCounts // Счётчики реакций на статью или комментарий.
+}
+
+// ReactionService предоставляет методы для управления реакциями на статьи и комментарии.
+type ReactionService struct {
+	repo        *repositories.ReactionRepository
+	articleRepo *repositories.ArticleRepository
+	commentRepo *repositories.CommentRepository
+	cfg         *config.ReactionConfig
+	Logger      logger.Logger
+}
+
+// NewReactionService создаёт новый экземпляр ReactionService.
+func NewReactionService(
+	repo *repositories.ReactionRepository,
+	articleRepo *repositories.ArticleRepository,
+	commentRepo *repositories.CommentRepository,
+	cfg *config.ReactionConfig,
+	logger logger.Logger,
+) *ReactionService {
+	return &ReactionService{
+		repo:        repo,
+		articleRepo: articleRepo,
+		commentRepo: commentRepo,
+		cfg:         cfg,
+		Logger:      logger,
+	}
+}
+
+// GetTypes возвращает доступные типы реакций.
+func (s *ReactionService) GetTypes() []string {
+	return s.cfg.Types
+}
+
+// ToggleArticleReaction ставит реакцию пользователя на статью или снимает уже поставленную.
+// Реагировать можно только на статьи, которые пользователь может просматривать.
+func (s *ReactionService) ToggleArticleReaction(articleID uint, reactionType string, userID uint, userRoles []string) (*ReactionResult, error) {
+	if !s.cfg.IsSupported(reactionType) {
+		return nil, errors.New(apperrors.ErrUnsupportedReactionType)
+	}
+	if err := s.checkArticleVisible(articleID, userID, userRoles); err != nil {
+		return nil, err
+	}
+	reacted, counts, err := s.repo.ToggleArticleReaction(articleID, userID, reactionType)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return &ReactionResult{Type: reactionType, Reacted: reacted, Counts: counts}, nil
+}
+
+// ToggleCommentReaction ставит реакцию пользователя на комментарий или снимает уже поставленную.
+// Реагировать можно только на видимые пользователю комментарии к статьям, которые он может просматривать.
+func (s *ReactionService) ToggleCommentReaction(commentID uint, reactionType string, userID uint, userRoles []string) (*ReactionResult, error) {
+	if !s.cfg.IsSupported(reactionType) {
+		return nil, errors.New(apperrors.ErrUnsupportedReactionType)
+	}
+	comment, err := s.commentRepo.GetByID(commentID)
+	if err != nil || !canViewComment(comment, userID, userRoles) {
+		return nil, errors.New(apperrors.ErrCommentNotFound)
+	}
+	if err := s.checkArticleVisible(comment.ArticleID, userID, userRoles); err != nil {
+		return nil, errors.New(apperrors.ErrCommentNotFound)
+	}
+	reacted, counts, err := s.repo.ToggleCommentReaction(commentID, userID, reactionType)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return &ReactionResult{Type: reactionType, Reacted: reacted, Counts: counts}, nil
+}
+
+// checkArticleVisible проверяет, что статья существует и доступна пользователю:
+// черновики видны только автору и редакторам.
+func (s *ReactionService) checkArticleVisible(articleID uint, userID uint, userRoles []string) error {
+	article, err := s.articleRepo.GetByID(articleID)
+	if err != nil {
+		return errors.New(apperrors.ErrArticleNotFound)
+	}
+	if !canViewArticle(article, userID, userRoles) {
+		return errors.New(apperrors.ErrArticleNotFound)
+	}
+	return nil
+}
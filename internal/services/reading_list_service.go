@@ -0,0 +1,275 @@
+package services
+
+import (
+	"errors"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// shareIDSize — количество случайных байт в идентификаторе публичной ссылки на список.
+const shareIDSize = 12
+
+// ReadingListService предоставляет методы для управления закладками и списками для чтения.
+type ReadingListService struct {
+	repo        *repositories.ReadingListRepository
+	articleRepo *repositories.ArticleRepository
+	Logger      logger.Logger
+}
+
+// NewReadingListService создаёт новый экземпляр ReadingListService.
+func NewReadingListService(
+	repo *repositories.ReadingListRepository,
+	articleRepo *repositories.ArticleRepository,
+	logger logger.Logger,
+) *ReadingListService {
+	return &ReadingListService{
+		repo:        repo,
+		articleRepo: articleRepo,
+		Logger:      logger,
+	}
+}
+
+// AddBookmark добавляет статью в закладки пользователя.
+func (s *ReadingListService) AddBookmark(articleID uint, userID uint, userRoles []string) error {
+	if err := s.checkArticleVisible(articleID, userID, userRoles); err != nil {
+		return err
+	}
+	if err := s.repo.AddBookmark(userID, articleID); err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	return nil
+}
+
+// RemoveBookmark удаляет статью из закладок пользователя.
+func (s *ReadingListService) RemoveBookmark(articleID uint, userID uint) error {
+	if err := s.repo.RemoveBookmark(userID, articleID); err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	return nil
+}
+
+// GetBookmarks возвращает закладки пользователя. Статьи в корзине и черновики,
+// ставшие недоступными пользователю, не возвращаются.
+func (s *ReadingListService) GetBookmarks(userID uint, userRoles []string) ([]*models.Bookmark, error) {
+	bookmarks, err := s.repo.GetBookmarks(userID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	visible := bookmarks[:0]
+	for _, bookmark := range bookmarks {
+		if bookmark.Article != nil && canViewArticle(bookmark.Article, userID, userRoles) {
+			visible = append(visible, bookmark)
+		}
+	}
+	return visible, nil
+}
+
+// SavedArticleIDs возвращает множество статей из articleIDs, сохранённых пользователем в закладки.
+// Для анонимных запросов (userID равен 0) и при ошибке БД возвращается пустое множество.
+func (s *ReadingListService) SavedArticleIDs(userID uint, articleIDs []uint) map[uint]bool {
+	saved := make(map[uint]bool)
+	if userID == 0 || len(articleIDs) == 0 {
+		return saved
+	}
+	ids, err := s.repo.GetBookmarkedIDs(userID, articleIDs)
+	if err != nil {
+		return saved
+	}
+	for _, id := range ids {
+		saved[id] = true
+	}
+	return saved
+}
+
+// CreateList создаёт список для чтения пользователя.
+func (s *ReadingListService) CreateList(input dto.ReadingListInput, userID uint) (*models.ReadingList, error) {
+	shareID, err := utils.RandomToken(shareIDSize)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to generate reading list share ID")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	list := &models.ReadingList{
+		UserID:      userID,
+		Name:        input.Name,
+		Description: input.Description,
+		Public:      input.Public,
+		ShareID:     shareID,
+	}
+	if err := s.repo.Create(list); err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return s.getList(list.ID, userID, nil)
+}
+
+// GetLists возвращает списки для чтения пользователя.
+func (s *ReadingListService) GetLists(userID uint, userRoles []string) ([]*models.ReadingList, error) {
+	lists, err := s.repo.GetByUser(userID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	for _, list := range lists {
+		filterVisibleItems(list, userID, userRoles)
+	}
+	return lists, nil
+}
+
+// GetList возвращает список для чтения владельцу или, если список публичный, любому пользователю.
+func (s *ReadingListService) GetList(id uint, userID uint, userRoles []string) (*models.ReadingList, error) {
+	list, err := s.repo.GetByID(id)
+	if err != nil || (list.UserID != userID && !list.Public) {
+		return nil, errors.New(apperrors.ErrReadingListNotFound)
+	}
+	filterVisibleItems(list, userID, userRoles)
+	return list, nil
+}
+
+// GetSharedList возвращает публичный список для чтения по идентификатору ссылки.
+// Для анонимных запросов userID равен 0.
+func (s *ReadingListService) GetSharedList(shareID string, userID uint, userRoles []string) (*models.ReadingList, error) {
+	list, err := s.repo.GetByShareID(shareID)
+	if err != nil || !list.Public {
+		return nil, errors.New(apperrors.ErrReadingListNotFound)
+	}
+	filterVisibleItems(list, userID, userRoles)
+	return list, nil
+}
+
+// UpdateList обновляет название, описание и видимость списка.
+func (s *ReadingListService) UpdateList(id uint, input dto.ReadingListInput, userID uint, userRoles []string) (*models.ReadingList, error) {
+	list, err := s.getOwnList(id, userID)
+	if err != nil {
+		return nil, err
+	}
+	list.Name = input.Name
+	list.Description = input.Description
+	list.Public = input.Public
+	if err := s.repo.Update(list); err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return s.getList(id, userID, userRoles)
+}
+
+// DeleteList удаляет список для чтения. Статьи списка не удаляются.
+func (s *ReadingListService) DeleteList(id uint, userID uint) error {
+	if _, err := s.getOwnList(id, userID); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(id); err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	return nil
+}
+
+// AddItem добавляет статью в конец списка для чтения.
+func (s *ReadingListService) AddItem(id uint, input dto.ReadingListItemInput, userID uint, userRoles []string) (*models.ReadingList, error) {
+	list, err := s.getOwnList(id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkArticleVisible(input.ArticleID, userID, userRoles); err != nil {
+		return nil, err
+	}
+	if listPosition(list, input.ArticleID) > 0 {
+		return nil, errors.New(apperrors.ErrArticleAlreadyInReadingList)
+	}
+	if err := s.repo.AddItem(id, input.ArticleID); err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return s.getList(id, userID, userRoles)
+}
+
+// RemoveItem удаляет статью из списка для чтения.
+func (s *ReadingListService) RemoveItem(id uint, articleID uint, userID uint, userRoles []string) (*models.ReadingList, error) {
+	list, err := s.getOwnList(id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if listPosition(list, articleID) == 0 {
+		return nil, errors.New(apperrors.ErrArticleNotInReadingList)
+	}
+	if err := s.repo.RemoveItem(id, articleID); err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return s.getList(id, userID, userRoles)
+}
+
+// ReorderItems меняет порядок статей списка. Статьи из input.ArticleIDs ставятся в начало
+// в указанном порядке, остальные следуют за ними в прежнем порядке.
+func (s *ReadingListService) ReorderItems(id uint, input dto.ReadingListOrderInput, userID uint, userRoles []string) (*models.ReadingList, error) {
+	list, err := s.getOwnList(id, userID)
+	if err != nil {
+		return nil, err
+	}
+	listed := make(map[uint]bool, len(input.ArticleIDs))
+	for _, articleID := range input.ArticleIDs {
+		if listed[articleID] || listPosition(list, articleID) == 0 {
+			return nil, errors.New(apperrors.ErrInvalidReadingListOrder)
+		}
+		listed[articleID] = true
+	}
+	order := append([]uint{}, input.ArticleIDs...)
+	for _, item := range list.Items {
+		if !listed[item.ArticleID] {
+			order = append(order, item.ArticleID)
+		}
+	}
+	if err := s.repo.ReorderItems(id, order); err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return s.getList(id, userID, userRoles)
+}
+
+// getOwnList возвращает список, если он принадлежит пользователю.
+// Чужие списки считаются несуществующими.
+func (s *ReadingListService) getOwnList(id uint, userID uint) (*models.ReadingList, error) {
+	list, err := s.repo.GetByID(id)
+	if err != nil || list.UserID != userID {
+		return nil, errors.New(apperrors.ErrReadingListNotFound)
+	}
+	return list, nil
+}
+
+// getList перечитывает список вместе со статьями, видимыми пользователю.
+func (s *ReadingListService) getList(id uint, userID uint, userRoles []string) (*models.ReadingList, error) {
+	list, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	filterVisibleItems(list, userID, userRoles)
+	return list, nil
+}
+
+// checkArticleVisible проверяет, что статья существует и доступна пользователю.
+func (s *ReadingListService) checkArticleVisible(articleID uint, userID uint, userRoles []string) error {
+	article, err := s.articleRepo.GetByID(articleID)
+	if err != nil || !canViewArticle(article, userID, userRoles) {
+		return errors.New(apperrors.ErrArticleNotFound)
+	}
+	return nil
+}
+
+// filterVisibleItems убирает из списка статьи в корзине и черновики, недоступные пользователю.
+func filterVisibleItems(list *models.ReadingList, userID uint, userRoles []string) {
+	visible := list.Items[:0]
+	for _, item := range list.Items {
+		if item.Article != nil && canViewArticle(item.Article, userID, userRoles) {
+			visible = append(visible, item)
+		}
+	}
+	list.Items = visible
+}
+
+// listPosition возвращает порядковый номер статьи в списке или 0, если статьи в списке нет.
+func listPosition(list *models.ReadingList, articleID uint) int {
+	for _, item := range list.Items {
+		if item.ArticleID == articleID {
+			return item.Position
+		}
+	}
+	return 0
+}
@@ -0,0 +1,395 @@
+package services
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"github.com/AsterOzlob/content_managment_api/internal/search"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// reportExcerptLength — длина фрагмента контента в очереди разбора жалоб.
+const reportExcerptLength = 200
+
+// ReportTriageItem описывает статью или комментарий с открытыми жалобами.
+type ReportTriageItem struct {
+	Target         *repositories.ReportTarget // Количество и даты открытых жалоб.
+	TargetType     string                     // Тип объекта: article или comment.
+	TargetID       uint                       // Идентификатор объекта.
+	ArticleID      uint                       // Статья объекта (для комментария — статья, к которой он относится).
+	AuthorID       uint                       // Автор объекта (0, если объект удалён).
+	Excerpt        string                     // Заголовок статьи или начало текста комментария.
+	Hidden         bool                       // Скрыт ли объект.
+	AuthorWarnings int64                      // Количество предупреждений автора.
+	AuthorBanned   bool                       // Заблокирован ли автор.
+	Reports        []*models.Report           // Открытые жалобы, начиная со старых.
+}
+
+// ReportResolution описывает результат рассмотрения жалоб модератором.
+type ReportResolution struct {
+	TargetType   string // Тип объекта.
+	TargetID     uint   // Идентификатор объекта.
+	Action       string // Решение модератора.
+	Resolved     int64  // Количество закрытых жалоб.
+	Hidden       bool   // Скрыт ли объект после решения.
+	AuthorBanned bool   // Заблокирован ли автор после решения.
+}
+
+// ReportService предоставляет методы для работы с жалобами на статьи и комментарии.
+type ReportService struct {
+	repo        *repositories.ReportRepository
+	articleRepo *repositories.ArticleRepository
+	commentRepo *repositories.CommentRepository
+	userRepo    *repositories.UserRepository
+	indexer     *search.Indexer
+	cfg         *config.ReportConfig
+	Logger      logger.Logger
+}
+
+// NewReportService создаёт новый экземпляр ReportService.
+func NewReportService(
+	repo *repositories.ReportRepository,
+	articleRepo *repositories.ArticleRepository,
+	commentRepo *repositories.CommentRepository,
+	userRepo *repositories.UserRepository,
+	indexer *search.Indexer,
+	cfg *config.ReportConfig,
+	logger logger.Logger,
+) *ReportService {
+	return &ReportService{
+		repo:        repo,
+		articleRepo: articleRepo,
+		commentRepo: commentRepo,
+		userRepo:    userRepo,
+		indexer:     indexer,
+		cfg:         cfg,
+		Logger:      logger,
+	}
+}
+
+// GetReasons возвращает доступные категории жалоб.
+func (s *ReportService) GetReasons() []string {
+	return s.cfg.Reasons
+}
+
+// ReportArticle создаёт жалобу пользователя на статью, которую он может просматривать.
+// Повторная жалоба на ту же статью, пока открыта предыдущая, не создаётся:
+// возвращается существующая жалоба с признаком false.
+func (s *ReportService) ReportArticle(articleID uint, input dto.ReportInput, userID uint, userRoles []string) (*models.Report, bool, error) {
+	if err := checkNotBanned(s.userRepo, userID); err != nil {
+		return nil, false, err
+	}
+	article, err := s.articleRepo.GetByID(articleID)
+	if err != nil || !canViewArticle(article, userID, userRoles) {
+		return nil, false, errors.New(apperrors.ErrArticleNotFound)
+	}
+	report := &models.Report{ReporterID: userID, ArticleID: &articleID, Reason: input.Reason, Text: input.Text}
+	return s.submit(report, article.AuthorID, article.Hidden)
+}
+
+// ReportComment создаёт жалобу пользователя на комментарий, который он может просматривать.
+// Повторная жалоба на тот же комментарий, пока открыта предыдущая, не создаётся:
+// возвращается существующая жалоба с признаком false.
+func (s *ReportService) ReportComment(commentID uint, input dto.ReportInput, userID uint, userRoles []string) (*models.Report, bool, error) {
+	if err := checkNotBanned(s.userRepo, userID); err != nil {
+		return nil, false, err
+	}
+	comment, err := s.commentRepo.GetByID(commentID)
+	if err != nil || !canViewComment(comment, userID, userRoles) {
+		return nil, false, errors.New(apperrors.ErrCommentNotFound)
+	}
+	article, err := s.articleRepo.GetByID(comment.ArticleID)
+	if err != nil || !canViewArticle(article, userID, userRoles) {
+		return nil, false, errors.New(apperrors.ErrCommentNotFound)
+	}
+	report := &models.Report{ReporterID: userID, CommentID: &commentID, Reason: input.Reason, Text: input.Text}
+	return s.submit(report, comment.AuthorID, comment.Hidden)
+}
+
+// GetTriage возвращает объекты с открытыми жалобами для разбора модератором, начиная
+// с объектов с наибольшим количеством жалоб, и общее количество таких объектов.
+func (s *ReportService) GetTriage(targetType string, limit, offset int) ([]*ReportTriageItem, int64, error) {
+	targets, total, err := s.repo.GetOpenTargets(targetType, limit, offset)
+	if err != nil {
+		return nil, 0, errors.New(apperrors.ErrInternalServerError)
+	}
+	articleIDs := []uint{}
+	commentIDs := []uint{}
+	for _, target := range targets {
+		if target.CommentID != nil {
+			commentIDs = append(commentIDs, *target.CommentID)
+		} else if target.ArticleID != nil {
+			articleIDs = append(articleIDs, *target.ArticleID)
+		}
+	}
+
+	reports, err := s.repo.GetOpenByTargets(articleIDs, commentIDs)
+	if err != nil {
+		return nil, 0, errors.New(apperrors.ErrInternalServerError)
+	}
+	articles := map[uint]*models.Article{}
+	if len(articleIDs) > 0 {
+		found, err := s.articleRepo.GetAll(repositories.ArticleFilter{IDs: articleIDs})
+		if err != nil {
+			return nil, 0, errors.New(apperrors.ErrInternalServerError)
+		}
+		for _, article := range found {
+			articles[article.ID] = article
+		}
+	}
+	comments := map[uint]*models.Comment{}
+	if len(commentIDs) > 0 {
+		found, err := s.commentRepo.GetByIDs(commentIDs)
+		if err != nil {
+			return nil, 0, errors.New(apperrors.ErrInternalServerError)
+		}
+		for _, comment := range found {
+			comments[comment.ID] = comment
+		}
+	}
+
+	items := make([]*ReportTriageItem, 0, len(targets))
+	byTarget := make(map[string]*ReportTriageItem, len(targets))
+	authorIDs := []uint{}
+	for _, target := range targets {
+		item := &ReportTriageItem{Target: target, Reports: []*models.Report{}}
+		if target.CommentID != nil {
+			item.TargetType = models.ReportTargetComment
+			item.TargetID = *target.CommentID
+			if comment, ok := comments[item.TargetID]; ok {
+				item.ArticleID = comment.ArticleID
+				item.AuthorID = comment.AuthorID
+				item.Excerpt = utils.Excerpt(utils.StripHTML(comment.Text), reportExcerptLength)
+				item.Hidden = comment.Hidden
+			}
+		} else if target.ArticleID != nil {
+			item.TargetType = models.ReportTargetArticle
+			item.TargetID = *target.ArticleID
+			item.ArticleID = item.TargetID
+			if article, ok := articles[item.TargetID]; ok {
+				item.AuthorID = article.AuthorID
+				item.Excerpt = article.Title
+				item.Hidden = article.Hidden
+			}
+		}
+		if item.AuthorID != 0 {
+			authorIDs = append(authorIDs, item.AuthorID)
+		}
+		items = append(items, item)
+		byTarget[reportTargetKey(item.TargetType, item.TargetID)] = item
+	}
+	for _, report := range reports {
+		if item, ok := byTarget[reportTargetKey(report.TargetType(), report.TargetID())]; ok {
+			item.Reports = append(item.Reports, report)
+		}
+	}
+
+	if err := s.fillAuthors(items, authorIDs); err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+// Resolve закрывает открытые жалобы на статью или комментарий решением модератора:
+// dismiss отклоняет жалобы и возвращает в выдачу контент, скрытый автоматически по этим жалобам
+// (скрытый прежним решением модератора контент остаётся скрытым), hide скрывает контент,
+// warn дополнительно выносит автору предупреждение, ban — блокирует автора
+// и отзывает его refresh-токены. Модераторов и администраторов заблокировать нельзя.
+// Автор комментария, удалённого с сохранением ветки, стёрт: warn и ban для такого комментария недоступны.
+func (s *ReportService) Resolve(targetType string, targetID uint, input dto.ReportResolutionInput, moderatorID uint) (*ReportResolution, error) {
+	authorID, err := s.targetAuthor(targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+	open, err := s.repo.CountOpen(targetType, targetID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	if open == 0 {
+		return nil, errors.New(apperrors.ErrNoOpenReports)
+	}
+	// У удалённого с сохранением ветки комментария автор стёрт: предупреждать и блокировать некого
+	if authorID == 0 && (input.Action == models.ReportActionWarn || input.Action == models.ReportActionBan) {
+		return nil, errors.New(apperrors.ErrReportAuthorDeleted)
+	}
+
+	resolution := &ReportResolution{TargetType: targetType, TargetID: targetID, Action: input.Action, Hidden: true}
+	decision := repositories.ReportDecision{
+		TargetType:  targetType,
+		TargetID:    targetID,
+		Status:      models.ReportStatusResolved,
+		Action:      input.Action,
+		ModeratorID: moderatorID,
+	}
+	switch input.Action {
+	case models.ReportActionDismiss:
+		decision.Status = models.ReportStatusDismissed
+		hidden, err := s.repo.HiddenByModerator(targetType, targetID)
+		if err != nil {
+			return nil, errors.New(apperrors.ErrInternalServerError)
+		}
+		resolution.Hidden = hidden
+	case models.ReportActionWarn:
+		decision.Warning = &models.UserWarning{
+			UserID:      authorID,
+			ModeratorID: moderatorID,
+			TargetType:  targetType,
+			TargetID:    targetID,
+			Note:        input.Note,
+		}
+	case models.ReportActionBan:
+		if err := s.checkBannable(authorID); err != nil {
+			return nil, err
+		}
+		decision.BanUserID = authorID
+		resolution.AuthorBanned = true
+	}
+	decision.Hidden = resolution.Hidden
+
+	resolved, err := s.repo.Resolve(decision)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	s.reindex(targetType, targetID)
+	resolution.Resolved = resolved
+	return resolution, nil
+}
+
+// submit проверяет и сохраняет жалобу на объект автора authorID. Если после новой жалобы
+// количество открытых жалоб достигло REPORT_AUTO_HIDE_THRESHOLD, объект скрывается
+// до решения модератора.
+func (s *ReportService) submit(report *models.Report, authorID uint, hidden bool) (*models.Report, bool, error) {
+	if !s.cfg.IsSupported(report.Reason) {
+		return nil, false, errors.New(apperrors.ErrUnsupportedReportReason)
+	}
+	if authorID == report.ReporterID {
+		return nil, false, errors.New(apperrors.ErrCannotReportOwnContent)
+	}
+	saved, created, err := s.repo.Create(report)
+	if err != nil {
+		return nil, false, errors.New(apperrors.ErrInternalServerError)
+	}
+	if created && !hidden && s.cfg.AutoHideThreshold > 0 {
+		s.autoHide(report.TargetType(), report.TargetID())
+	}
+	return saved, created, nil
+}
+
+// autoHide скрывает объект, если количество открытых жалоб на него достигло порога.
+// Ошибки только записываются в журнал: жалоба уже сохранена.
+func (s *ReportService) autoHide(targetType string, targetID uint) {
+	open, err := s.repo.CountOpen(targetType, targetID)
+	if err != nil || open < int64(s.cfg.AutoHideThreshold) {
+		return
+	}
+	if err := s.setHidden(targetType, targetID, true); err != nil {
+		s.Logger.WithFields(map[string]interface{}{
+			"target_type": targetType,
+			"target_id":   targetID,
+		}).WithError(err).Error("Failed to auto-hide reported content")
+	}
+}
+
+// setHidden скрывает объект или возвращает его в выдачу и обновляет поисковый индекс.
+func (s *ReportService) setHidden(targetType string, targetID uint, hidden bool) error {
+	if targetType == models.ReportTargetComment {
+		if err := s.commentRepo.SetHidden(targetID, hidden); err != nil {
+			return err
+		}
+	} else if err := s.articleRepo.SetHidden(targetID, hidden); err != nil {
+		return err
+	}
+	s.reindex(targetType, targetID)
+	return nil
+}
+
+// reindex обновляет объект в поисковом индексе после изменения его видимости;
+// вместе со статьёй переиндексируются её комментарии.
+func (s *ReportService) reindex(targetType string, targetID uint) {
+	if targetType == models.ReportTargetComment {
+		if comment, err := s.commentRepo.GetByID(targetID); err == nil {
+			indexComment(s.indexer, s.articleRepo, comment)
+		}
+		return
+	}
+	if article, err := s.articleRepo.GetByID(targetID); err == nil {
+		s.indexer.IndexArticle(article)
+		reindexArticleComments(s.indexer, s.commentRepo, article)
+	}
+}
+
+// targetAuthor возвращает автора статьи или комментария.
+func (s *ReportService) targetAuthor(targetType string, targetID uint) (uint, error) {
+	if targetType == models.ReportTargetComment {
+		comment, err := s.commentRepo.GetByID(targetID)
+		if err != nil {
+			return 0, errors.New(apperrors.ErrCommentNotFound)
+		}
+		return comment.AuthorID, nil
+	}
+	article, err := s.articleRepo.GetByID(targetID)
+	if err != nil {
+		return 0, errors.New(apperrors.ErrArticleNotFound)
+	}
+	return article.AuthorID, nil
+}
+
+// checkBannable проверяет, что пользователя можно заблокировать:
+// модераторов и администраторов заблокировать нельзя.
+func (s *ReportService) checkBannable(userID uint) error {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return errors.New(apperrors.ErrUserNotFound)
+	}
+	if canModerateComments([]string{user.Role.Name}) {
+		return errors.New(apperrors.ErrAccessDenied)
+	}
+	return nil
+}
+
+// fillAuthors заполняет количество предупреждений и признак блокировки авторов.
+func (s *ReportService) fillAuthors(items []*ReportTriageItem, authorIDs []uint) error {
+	if len(authorIDs) == 0 {
+		return nil
+	}
+	warnings, err := s.repo.CountWarnings(authorIDs)
+	if err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	users, err := s.userRepo.GetByIDs(authorIDs)
+	if err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	banned := make(map[uint]bool, len(users))
+	for _, user := range users {
+		banned[user.ID] = user.BannedAt != nil
+	}
+	for _, item := range items {
+		item.AuthorWarnings = warnings[item.AuthorID]
+		item.AuthorBanned = banned[item.AuthorID]
+	}
+	return nil
+}
+
+// reportTargetKey возвращает ключ объекта жалобы для группировки.
+func reportTargetKey(targetType string, targetID uint) string {
+	return targetType + ":" + strconv.FormatUint(uint64(targetID), 10)
+}
+
+// checkNotBanned возвращает ошибку, если пользователь заблокирован модератором.
+func checkNotBanned(userRepo *repositories.UserRepository, userID uint) error {
+	user, err := userRepo.GetByID(userID)
+	if err != nil {
+		return errors.New(apperrors.ErrUserNotFound)
+	}
+	if user.BannedAt != nil {
+		return errors.New(apperrors.ErrUserBanned)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"github.com/AsterOzlob/content_managment_api/internal/search"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+)
+
+// SearchService предоставляет методы для полнотекстового поиска и переиндексации.
+type SearchService struct {
+	index       search.SearchIndex
+	articleRepo *repositories.ArticleRepository
+	commentRepo *repositories.CommentRepository
+	Logger      logger.Logger
+}
+
+// NewSearchService создаёт новый экземпляр SearchService.
+func NewSearchService(
+	index search.SearchIndex,
+	articleRepo *repositories.ArticleRepository,
+	commentRepo *repositories.CommentRepository,
+	logger logger.Logger,
+) *SearchService {
+	return &SearchService{
+		index:       index,
+		articleRepo: articleRepo,
+		commentRepo: commentRepo,
+		Logger:      logger,
+	}
+}
+
+// Search выполняет поиск по опубликованным статьям и комментариям.
+func (s *SearchService) Search(query string, limit, offset int) ([]search.Hit, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, errors.New(apperrors.ErrEmptySearchQuery)
+	}
+	hits, err := s.index.Search(query, limit, offset)
+	if err != nil {
+		s.Logger.WithError(err).WithField("query", query).Error("Failed to search index")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return hits, nil
+}
+
+// Reindex полностью перестраивает поисковый индекс по данным из БД.
+// Возвращает количество проиндексированных документов.
+func (s *SearchService) Reindex() (int, error) {
+	articles, err := s.articleRepo.GetAll(repositories.ArticleFilter{})
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch articles for reindex")
+		return 0, errors.New(apperrors.ErrReindexFailed)
+	}
+	comments, err := s.commentRepo.GetAll()
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch comments for reindex")
+		return 0, errors.New(apperrors.ErrReindexFailed)
+	}
+	if err := s.index.Reset(); err != nil {
+		s.Logger.WithError(err).Error("Failed to reset search index")
+		return 0, errors.New(apperrors.ErrReindexFailed)
+	}
+
+	indexed := 0
+	byID := make(map[uint]*models.Article, len(articles))
+	for _, article := range articles {
+		byID[article.ID] = article
+		if err := s.index.Index(search.ArticleDocument(article)); err != nil {
+			s.Logger.WithError(err).WithField("article_id", article.ID).Error("Failed to index article")
+			return indexed, errors.New(apperrors.ErrReindexFailed)
+		}
+		indexed++
+	}
+	for _, comment := range comments {
+		article, ok := byID[comment.ArticleID]
+		if !ok {
+			continue
+		}
+		if err := s.index.Index(search.CommentDocument(comment, article)); err != nil {
+			s.Logger.WithError(err).WithField("comment_id", comment.ID).Error("Failed to index comment")
+			return indexed, errors.New(apperrors.ErrReindexFailed)
+		}
+		indexed++
+	}
+	s.Logger.WithField("documents", indexed).Info("Search index rebuilt")
+	return indexed, nil
+}
@@ -0,0 +1,194 @@
+package services
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// SeriesService предоставляет методы для управления сериями статей.
+type SeriesService struct {
+	repo        *repositories.SeriesRepository
+	articleRepo *repositories.ArticleRepository
+	Logger      logger.Logger
+}
+
+// NewSeriesService создаёт новый экземпляр SeriesService.
+func NewSeriesService(
+	repo *repositories.SeriesRepository,
+	articleRepo *repositories.ArticleRepository,
+	logger logger.Logger,
+) *SeriesService {
+	return &SeriesService{
+		repo:        repo,
+		articleRepo: articleRepo,
+		Logger:      logger,
+	}
+}
+
+// CreateSeries создаёт новую серию с частями в порядке input.ArticleIDs.
+func (s *SeriesService) CreateSeries(input dto.SeriesInput, userID uint, userRoles []string) (*models.Series, error) {
+	slug := seriesSlug(input)
+	if slug == "" {
+		return nil, errors.New(apperrors.ErrInvalidSlug)
+	}
+	if existing, err := s.repo.GetBySlug(slug); err == nil && existing != nil {
+		return nil, errors.New(apperrors.ErrSeriesAlreadyExists)
+	}
+	if err := s.validateParts(0, input.ArticleIDs, userID, userRoles); err != nil {
+		return nil, err
+	}
+	series := &models.Series{
+		AuthorID:    userID,
+		Title:       input.Title,
+		Slug:        slug,
+		Description: input.Description,
+	}
+	for i, articleID := range input.ArticleIDs {
+		series.Parts = append(series.Parts, models.SeriesPart{ArticleID: articleID, Position: i + 1})
+	}
+	if err := s.repo.Create(series); err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return s.getSeries(series.ID)
+}
+
+// GetAllSeries возвращает все серии.
+func (s *SeriesService) GetAllSeries() ([]*models.Series, error) {
+	series, err := s.repo.GetAll()
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return series, nil
+}
+
+// GetSeriesBySlug возвращает серию по slug.
+func (s *SeriesService) GetSeriesBySlug(slug string) (*models.Series, error) {
+	series, err := s.repo.GetBySlug(slug)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrSeriesNotFound)
+	}
+	return series, nil
+}
+
+// UpdateSeries обновляет название, slug и описание серии.
+func (s *SeriesService) UpdateSeries(id uint, input dto.SeriesInput, userID uint, userRoles []string) (*models.Series, error) {
+	series, err := s.getManageableSeries(id, userID, userRoles)
+	if err != nil {
+		return nil, err
+	}
+	slug := seriesSlug(input)
+	if slug == "" {
+		return nil, errors.New(apperrors.ErrInvalidSlug)
+	}
+	if existing, err := s.repo.GetBySlug(slug); err == nil && existing.ID != id {
+		return nil, errors.New(apperrors.ErrSeriesAlreadyExists)
+	}
+	series.Title = input.Title
+	series.Slug = slug
+	series.Description = input.Description
+	if err := s.repo.Update(series); err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return s.getSeries(id)
+}
+
+// SetParts задаёт состав и порядок частей серии. Статьи, не вошедшие в список, исключаются из серии.
+func (s *SeriesService) SetParts(id uint, input dto.SeriesPartsInput, userID uint, userRoles []string) (*models.Series, error) {
+	if _, err := s.getManageableSeries(id, userID, userRoles); err != nil {
+		return nil, err
+	}
+	if err := s.validateParts(id, input.ArticleIDs, userID, userRoles); err != nil {
+		return nil, err
+	}
+	if err := s.repo.ReplaceParts(id, input.ArticleIDs); err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return s.getSeries(id)
+}
+
+// DeleteSeries удаляет серию. Статьи серии не удаляются.
+func (s *SeriesService) DeleteSeries(id uint, userID uint, userRoles []string) error {
+	if _, err := s.getManageableSeries(id, userID, userRoles); err != nil {
+		return err
+	}
+	if err := s.repo.Delete(id); err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	return nil
+}
+
+// validateParts проверяет, что статьи существуют, не повторяются, не входят в другие серии
+// и принадлежат пользователю (редакторы, модераторы и администраторы могут добавлять любые статьи).
+func (s *SeriesService) validateParts(seriesID uint, articleIDs []uint, userID uint, userRoles []string) error {
+	if len(articleIDs) == 0 {
+		return nil
+	}
+	unique := slices.Compact(slices.Sorted(slices.Values(articleIDs)))
+	if len(unique) != len(articleIDs) {
+		return errors.New(apperrors.ErrDuplicateSeriesPart)
+	}
+	articles, err := s.articleRepo.GetAll(repositories.ArticleFilter{IDs: unique})
+	if err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	if len(articles) != len(unique) {
+		return errors.New(apperrors.ErrArticleNotFound)
+	}
+	for _, article := range articles {
+		if !canManageSeries(article.AuthorID, userID, userRoles) {
+			return errors.New(apperrors.ErrAccessDenied)
+		}
+	}
+	parts, err := s.repo.GetPartsByArticleIDs(unique)
+	if err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	for _, part := range parts {
+		if part.SeriesID != seriesID {
+			return errors.New(apperrors.ErrArticleInOtherSeries)
+		}
+	}
+	return nil
+}
+
+// getManageableSeries возвращает серию, если пользователь может ею управлять.
+func (s *SeriesService) getManageableSeries(id uint, userID uint, userRoles []string) (*models.Series, error) {
+	series, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrSeriesNotFound)
+	}
+	if !canManageSeries(series.AuthorID, userID, userRoles) {
+		return nil, errors.New(apperrors.ErrAccessDenied)
+	}
+	return series, nil
+}
+
+// getSeries перечитывает серию вместе с частями.
+func (s *SeriesService) getSeries(id uint) (*models.Series, error) {
+	series, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return series, nil
+}
+
+// canManageSeries сообщает, может ли пользователь управлять ресурсом серии:
+// его автор, редактор, модератор или администратор.
+func canManageSeries(ownerID, userID uint, userRoles []string) bool {
+	return utils.IsOwner(ownerID, userID, userRoles) || slices.Contains(userRoles, "editor")
+}
+
+// seriesSlug возвращает slug серии: заданный явно или сформированный из названия.
+func seriesSlug(input dto.SeriesInput) string {
+	if input.Slug != "" {
+		return utils.Slugify(input.Slug)
+	}
+	return utils.Slugify(input.Title)
+}
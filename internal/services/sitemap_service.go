@@ -0,0 +1,167 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"github.com/AsterOzlob/content_managment_api/internal/sitemap"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+)
+
+// SitemapService формирует карту сайта и robots.txt.
+type SitemapService struct {
+	articleRepo *repositories.ArticleRepository
+	site        *config.SiteConfig
+	seo         *config.SEOConfig
+	Logger      logger.Logger
+
+	mu       sync.Mutex    // Защищает кэш карты сайта.
+	cached   []sitemap.URL // Адреса карты сайта, сформированные при последнем обращении.
+	cachedAt time.Time     // Время формирования кэша.
+}
+
+// NewSitemapService создаёт новый экземпляр SitemapService.
+func NewSitemapService(
+	articleRepo *repositories.ArticleRepository,
+	site *config.SiteConfig,
+	seo *config.SEOConfig,
+	logger logger.Logger,
+) *SitemapService {
+	return &SitemapService{
+		articleRepo: articleRepo,
+		site:        site,
+		seo:         seo,
+		Logger:      logger,
+	}
+}
+
+// URLs возвращает все адреса карты сайта. Результат кэшируется на SITEMAP_CACHE_TTL секунд,
+// чтобы запросы роботов к индексу и страницам карты не перечитывали все статьи.
+func (s *SitemapService) URLs() ([]sitemap.URL, error) {
+	ttl := time.Duration(s.seo.SitemapCacheTTL) * time.Second
+	if ttl <= 0 {
+		return s.buildURLs()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cached != nil && time.Since(s.cachedAt) < ttl {
+		return s.cached, nil
+	}
+	urls, err := s.buildURLs()
+	if err != nil {
+		return nil, err
+	}
+	s.cached, s.cachedAt = urls, time.Now()
+	return urls, nil
+}
+
+// buildURLs формирует адреса карты сайта: опубликованные статьи, страницы авторов,
+// тегов и рубрик, у которых есть опубликованные статьи.
+// lastmod страниц авторов и таксономий — время изменения их последней статьи.
+func (s *SitemapService) buildURLs() ([]sitemap.URL, error) {
+	articles, err := s.articleRepo.GetPublishedForSitemap()
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch articles for sitemap from repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+
+	urls := make([]sitemap.URL, 0, len(articles))
+	authors := make(map[uint]time.Time)
+	tags := make(map[string]time.Time)
+	categories := make(map[string]time.Time)
+	var authorOrder []uint
+	var tagOrder, categoryOrder []string
+
+	for _, article := range articles {
+		entry := sitemap.URL{
+			Loc:     s.site.URL(fmt.Sprintf("/articles/%d", article.ID)),
+			LastMod: article.UpdatedAt,
+		}
+		if s.seo.SitemapImages {
+			for _, media := range article.Media {
+				if strings.HasPrefix(media.FileType, "image/") {
+					entry.Images = append(entry.Images, s.site.URL(media.FilePath))
+				}
+			}
+		}
+		urls = append(urls, entry)
+
+		if _, ok := authors[article.AuthorID]; !ok {
+			authorOrder = append(authorOrder, article.AuthorID)
+		}
+		authors[article.AuthorID] = latest(authors[article.AuthorID], article.UpdatedAt)
+		for _, tag := range article.Tags {
+			if _, ok := tags[tag.Slug]; !ok {
+				tagOrder = append(tagOrder, tag.Slug)
+			}
+			tags[tag.Slug] = latest(tags[tag.Slug], article.UpdatedAt)
+		}
+		if article.Category != nil {
+			if _, ok := categories[article.Category.Slug]; !ok {
+				categoryOrder = append(categoryOrder, article.Category.Slug)
+			}
+			categories[article.Category.Slug] = latest(categories[article.Category.Slug], article.UpdatedAt)
+		}
+	}
+
+	for _, id := range authorOrder {
+		urls = append(urls, sitemap.URL{Loc: s.site.URL(fmt.Sprintf("/articles?author=%d", id)), LastMod: authors[id]})
+	}
+	for _, slug := range tagOrder {
+		urls = append(urls, sitemap.URL{Loc: s.site.URL("/articles?tag=" + url.QueryEscape(slug)), LastMod: tags[slug]})
+	}
+	for _, slug := range categoryOrder {
+		urls = append(urls, sitemap.URL{Loc: s.site.URL("/articles?category=" + url.QueryEscape(slug)), LastMod: categories[slug]})
+	}
+	return urls, nil
+}
+
+// IndexEntries возвращает записи индекса карты сайта для разбиения на несколько файлов.
+func (s *SitemapService) IndexEntries(urls []sitemap.URL) []sitemap.IndexEntry {
+	pages := sitemap.Pages(len(urls))
+	entries := make([]sitemap.IndexEntry, 0, pages)
+	for page := 1; page <= pages; page++ {
+		entries = append(entries, sitemap.IndexEntry{
+			Loc:     s.site.URL(fmt.Sprintf("/sitemaps/sitemap-%d.xml", page)),
+			LastMod: sitemap.LatestMod(sitemap.Page(urls, page)),
+		})
+	}
+	return entries
+}
+
+// Robots формирует содержимое robots.txt по настройкам.
+func (s *SitemapService) Robots() string {
+	var b strings.Builder
+	b.WriteString("User-agent: *\n")
+	if s.seo.RobotsBlockAll {
+		b.WriteString("Disallow: /\n")
+		return b.String()
+	}
+	for _, path := range s.seo.RobotsAllow {
+		if path = strings.TrimSpace(path); path != "" {
+			fmt.Fprintf(&b, "Allow: %s\n", path)
+		}
+	}
+	for _, path := range s.seo.RobotsDisallow {
+		if path = strings.TrimSpace(path); path != "" {
+			fmt.Fprintf(&b, "Disallow: %s\n", path)
+		}
+	}
+	fmt.Fprintf(&b, "\nSitemap: %s\n", s.site.URL("/sitemap.xml"))
+	return b.String()
+}
+
+// latest возвращает более позднее из двух времён.
+func latest(a, b time.Time) time.Time {
+	if b.After(a) {
+		return b
+	}
+	return a
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// tagAutocompleteLimit — максимальное количество подсказок при автодополнении тегов.
+const tagAutocompleteLimit = 10
+
+// TagService предоставляет методы для управления тегами.
+type TagService struct {
+	repo   *repositories.TagRepository
+	Logger logger.Logger
+}
+
+// NewTagService создаёт новый экземпляр TagService.
+func NewTagService(repo *repositories.TagRepository, logger logger.Logger) *TagService {
+	return &TagService{repo: repo, Logger: logger}
+}
+
+// CreateTag создаёт новый тег.
+func (s *TagService) CreateTag(input dto.TagInput) (*models.Tag, error) {
+	slug := tagSlug(input)
+	if slug == "" {
+		return nil, errors.New(apperrors.ErrInvalidSlug)
+	}
+	if existing, err := s.repo.GetBySlug(slug); err == nil && existing != nil {
+		return nil, errors.New(apperrors.ErrTagAlreadyExists)
+	}
+	if existing, err := s.repo.GetByName(utils.Sanitize(input.Name)); err == nil && existing != nil {
+		return nil, errors.New(apperrors.ErrTagNameAlreadyExists)
+	}
+	tag := &models.Tag{Name: input.Name, Slug: slug}
+	if err := s.repo.Create(tag); err != nil {
+		s.Logger.WithError(err).Error("Failed to create tag in repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return tag, nil
+}
+
+// GetAllTags возвращает все теги с количеством опубликованных статей.
+func (s *TagService) GetAllTags() ([]*repositories.TagWithCount, error) {
+	tags, err := s.repo.GetAllWithCounts()
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch tags from repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return tags, nil
+}
+
+// GetTagByID возвращает тег по ID.
+func (s *TagService) GetTagByID(id uint) (*models.Tag, error) {
+	tag, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrTagNotFound)
+	}
+	return tag, nil
+}
+
+// Autocomplete возвращает теги, начинающиеся с введённой строки.
+func (s *TagService) Autocomplete(prefix string) ([]*models.Tag, error) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return []*models.Tag{}, nil
+	}
+	tags, err := s.repo.Autocomplete(prefix, tagAutocompleteLimit)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to autocomplete tags in repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return tags, nil
+}
+
+// UpdateTag обновляет существующий тег.
+func (s *TagService) UpdateTag(id uint, input dto.TagInput) (*models.Tag, error) {
+	tag, err := s.repo.GetByID(id)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrTagNotFound)
+	}
+	slug := tagSlug(input)
+	if slug == "" {
+		return nil, errors.New(apperrors.ErrInvalidSlug)
+	}
+	if existing, err := s.repo.GetBySlug(slug); err == nil && existing.ID != tag.ID {
+		return nil, errors.New(apperrors.ErrTagAlreadyExists)
+	}
+	if existing, err := s.repo.GetByName(utils.Sanitize(input.Name)); err == nil && existing.ID != tag.ID {
+		return nil, errors.New(apperrors.ErrTagNameAlreadyExists)
+	}
+	tag.Name = input.Name
+	tag.Slug = slug
+	if err := s.repo.Update(tag); err != nil {
+		s.Logger.WithError(err).Error("Failed to update tag in repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return tag, nil
+}
+
+// DeleteTag удаляет тег по ID.
+func (s *TagService) DeleteTag(id uint) error {
+	if _, err := s.repo.GetByID(id); err != nil {
+		return errors.New(apperrors.ErrTagNotFound)
+	}
+	if err := s.repo.Delete(id); err != nil {
+		s.Logger.WithError(err).Error("Failed to delete tag from repository")
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	return nil
+}
+
+// tagSlug возвращает slug тега из входных данных или формирует его из названия.
+func tagSlug(input dto.TagInput) string {
+	if input.Slug != "" {
+		return utils.Slugify(input.Slug)
+	}
+	return utils.Slugify(input.Name)
+}
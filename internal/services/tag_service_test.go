@@ -0,0 +1,25 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+)
+
+func TestTagSlugPrefersExplicitSlug(t *testing.T) {
+	if got := tagSlug(dto.TagInput{Name: "Go", Slug: "Golang Tips!"}); got != "golang-tips" {
+		t.Errorf("tagSlug() = %q, want %q", got, "golang-tips")
+	}
+	if got := tagSlug(dto.TagInput{Name: "Веб-разработка"}); got != "веб-разработка" {
+		t.Errorf("tagSlug() without slug = %q, want %q", got, "веб-разработка")
+	}
+}
+
+func TestCategorySlugPrefersExplicitSlug(t *testing.T) {
+	if got := categorySlug(dto.CategoryInput{Name: "Наука", Slug: "Science"}); got != "science" {
+		t.Errorf("categorySlug() = %q, want %q", got, "science")
+	}
+	if got := categorySlug(dto.CategoryInput{Name: "Наука и техника"}); got != "наука-и-техника" {
+		t.Errorf("categorySlug() without slug = %q, want %q", got, "наука-и-техника")
+	}
+}
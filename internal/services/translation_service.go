@@ -0,0 +1,151 @@
+package services
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/dto"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// MissingTranslations описывает статью и локали, на которые она ещё не переведена.
+type MissingTranslations struct {
+	Article *models.Article
+	Locales []string
+}
+
+// TranslationService предоставляет методы для управления переводами статей.
+type TranslationService struct {
+	repo        *repositories.ArticleTranslationRepository
+	articleRepo *repositories.ArticleRepository
+	locales     *config.LocaleConfig
+	Logger      logger.Logger
+}
+
+// NewTranslationService создаёт новый экземпляр TranslationService.
+func NewTranslationService(
+	repo *repositories.ArticleTranslationRepository,
+	articleRepo *repositories.ArticleRepository,
+	locales *config.LocaleConfig,
+	logger logger.Logger,
+) *TranslationService {
+	return &TranslationService{
+		repo:        repo,
+		articleRepo: articleRepo,
+		locales:     locales,
+		Logger:      logger,
+	}
+}
+
+// GetTranslations возвращает все переводы статьи, включая неопубликованные.
+func (s *TranslationService) GetTranslations(articleID uint, userID uint, userRoles []string) ([]*models.ArticleTranslation, error) {
+	if _, err := s.getEditableArticle(articleID, userID, userRoles); err != nil {
+		return nil, err
+	}
+	translations, err := s.repo.GetByArticleID(articleID)
+	if err != nil {
+		s.Logger.WithError(err).Error("Failed to fetch article translations from repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return translations, nil
+}
+
+// SaveTranslation создаёт или обновляет перевод статьи на указанную локаль.
+func (s *TranslationService) SaveTranslation(articleID uint, locale string, input dto.TranslationInput, userID uint, userRoles []string) (*models.ArticleTranslation, error) {
+	locale = utils.NormalizeLocale(locale)
+	if !s.locales.IsSupported(locale) {
+		return nil, errors.New(apperrors.ErrUnsupportedLocale)
+	}
+	article, err := s.getEditableArticle(articleID, userID, userRoles)
+	if err != nil {
+		return nil, err
+	}
+	if article.Locale == locale {
+		return nil, errors.New(apperrors.ErrTranslationSameLocale)
+	}
+	translation, err := s.repo.GetByArticleAndLocale(articleID, locale)
+	if err != nil {
+		translation = &models.ArticleTranslation{ArticleID: articleID, Locale: locale}
+	}
+	translation.Title = input.Title
+	translation.Text = input.Text
+	translation.Published = input.Published
+	if err := s.repo.Save(translation); err != nil {
+		s.Logger.WithError(err).Error("Failed to save article translation in repository")
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return translation, nil
+}
+
+// DeleteTranslation удаляет перевод статьи на указанную локаль.
+func (s *TranslationService) DeleteTranslation(articleID uint, locale string, userID uint, userRoles []string) error {
+	if _, err := s.getEditableArticle(articleID, userID, userRoles); err != nil {
+		return err
+	}
+	translation, err := s.repo.GetByArticleAndLocale(articleID, utils.NormalizeLocale(locale))
+	if err != nil {
+		return errors.New(apperrors.ErrTranslationNotFound)
+	}
+	if err := s.repo.Delete(translation.ID); err != nil {
+		s.Logger.WithError(err).Error("Failed to delete article translation from repository")
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	return nil
+}
+
+// GetMissingTranslations возвращает статьи без перевода на указанную локаль.
+// Если локаль не указана, проверяются все поддерживаемые локали.
+func (s *TranslationService) GetMissingTranslations(locale string) ([]MissingTranslations, error) {
+	locales := s.locales.Supported
+	if locale != "" {
+		locale = utils.NormalizeLocale(locale)
+		if !s.locales.IsSupported(locale) {
+			return nil, errors.New(apperrors.ErrUnsupportedLocale)
+		}
+		locales = []string{locale}
+	}
+
+	byArticle := make(map[uint]*MissingTranslations)
+	var order []uint
+	for _, l := range locales {
+		articles, err := s.repo.GetArticlesMissingLocale(l)
+		if err != nil {
+			s.Logger.WithError(err).Error("Failed to fetch articles missing translation from repository")
+			return nil, errors.New(apperrors.ErrInternalServerError)
+		}
+		for _, article := range articles {
+			entry, ok := byArticle[article.ID]
+			if !ok {
+				entry = &MissingTranslations{Article: article}
+				byArticle[article.ID] = entry
+				order = append(order, article.ID)
+			}
+			entry.Locales = append(entry.Locales, l)
+		}
+	}
+
+	slices.Sort(order)
+	result := make([]MissingTranslations, 0, len(order))
+	for _, id := range order {
+		result = append(result, *byArticle[id])
+	}
+	return result, nil
+}
+
+// getEditableArticle возвращает статью, если пользователь может управлять её переводами:
+// автор статьи, редактор, модератор или администратор.
+func (s *TranslationService) getEditableArticle(articleID uint, userID uint, userRoles []string) (*models.Article, error) {
+	article, err := s.articleRepo.GetByID(articleID)
+	if err != nil {
+		return nil, errors.New(apperrors.ErrArticleNotFound)
+	}
+	if !utils.IsOwner(article.AuthorID, userID, userRoles) && !slices.Contains(userRoles, "editor") {
+		return nil, errors.New(apperrors.ErrAccessDenied)
+	}
+	return article, nil
+}
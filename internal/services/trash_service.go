@@ -0,0 +1,179 @@
+package services
+
+import (
+	"errors"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	logger "github.com/AsterOzlob/content_managment_api/internal/logger"
+	"github.com/AsterOzlob/content_managment_api/internal/search"
+	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// TrashService предоставляет методы для просмотра корзины, восстановления
+// и окончательного удаления статей, комментариев и медиафайлов.
+type TrashService struct {
+	articleRepo *repositories.ArticleRepository
+	commentRepo *repositories.CommentRepository
+	mediaRepo   *repositories.MediaRepository
+	indexer     *search.Indexer
+	cfg         *config.TrashConfig
+	Logger      logger.Logger
+}
+
+// NewTrashService создаёт новый экземпляр TrashService.
+func NewTrashService(
+	articleRepo *repositories.ArticleRepository,
+	commentRepo *repositories.CommentRepository,
+	mediaRepo *repositories.MediaRepository,
+	indexer *search.Indexer,
+	cfg *config.TrashConfig,
+	logger logger.Logger,
+) *TrashService {
+	return &TrashService{
+		articleRepo: articleRepo,
+		commentRepo: commentRepo,
+		mediaRepo:   mediaRepo,
+		indexer:     indexer,
+		cfg:         cfg,
+		Logger:      logger,
+	}
+}
+
+// Retention возвращает срок хранения записей в корзине.
+func (s *TrashService) Retention() time.Duration {
+	return s.cfg.Retention()
+}
+
+// GetDeletedArticles возвращает статьи из корзины: модераторам и администраторам — все,
+// остальным — только собственные.
+func (s *TrashService) GetDeletedArticles(userID uint, userRoles []string) ([]*models.Article, error) {
+	articles, err := s.articleRepo.GetDeleted(trashOwnerFilter(userID, userRoles))
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return articles, nil
+}
+
+// GetDeletedComments возвращает комментарии из корзины с теми же правилами доступа, что и для статей.
+func (s *TrashService) GetDeletedComments(userID uint, userRoles []string) ([]*models.Comment, error) {
+	comments, err := s.commentRepo.GetDeleted(trashOwnerFilter(userID, userRoles))
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return comments, nil
+}
+
+// GetDeletedMedia возвращает медиафайлы из корзины с теми же правилами доступа, что и для статей.
+func (s *TrashService) GetDeletedMedia(userID uint, userRoles []string) ([]*models.Media, error) {
+	media, err := s.mediaRepo.GetDeleted(trashOwnerFilter(userID, userRoles))
+	if err != nil {
+		return nil, errors.New(apperrors.ErrInternalServerError)
+	}
+	return media, nil
+}
+
+// RestoreArticle возвращает статью из корзины и заново индексирует её вместе с комментариями.
+func (s *TrashService) RestoreArticle(id uint, userID uint, userRoles []string) error {
+	deleted, err := s.articleRepo.GetDeletedByID(id)
+	if err != nil {
+		return errors.New(apperrors.ErrTrashItemNotFound)
+	}
+	if !utils.IsOwner(deleted.AuthorID, userID, userRoles) {
+		return errors.New(apperrors.ErrAccessDenied)
+	}
+	if err := s.articleRepo.Restore(id); err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	article, err := s.articleRepo.GetByID(id)
+	if err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	s.indexer.IndexArticle(article)
+	reindexArticleComments(s.indexer, s.commentRepo, article)
+	s.Logger.WithFields(map[string]interface{}{"article_id": id, "user_id": userID}).Info("Article restored from trash")
+	return nil
+}
+
+// RestoreComment возвращает комментарий из корзины.
+func (s *TrashService) RestoreComment(id uint, userID uint, userRoles []string) error {
+	deleted, err := s.commentRepo.GetDeletedByID(id)
+	if err != nil {
+		return errors.New(apperrors.ErrTrashItemNotFound)
+	}
+	if !utils.IsOwner(deleted.AuthorID, userID, userRoles) {
+		return errors.New(apperrors.ErrAccessDenied)
+	}
+	if err := s.commentRepo.Restore(id); err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	comment, err := s.commentRepo.GetByID(id)
+	if err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	indexComment(s.indexer, s.articleRepo, comment)
+	s.Logger.WithFields(map[string]interface{}{"comment_id": id, "user_id": userID}).Info("Comment restored from trash")
+	return nil
+}
+
+// RestoreMedia возвращает медиафайл из корзины.
+func (s *TrashService) RestoreMedia(id uint, userID uint, userRoles []string) error {
+	deleted, err := s.mediaRepo.GetDeletedByID(id)
+	if err != nil {
+		return errors.New(apperrors.ErrTrashItemNotFound)
+	}
+	if !utils.IsOwner(deleted.AuthorID, userID, userRoles) {
+		return errors.New(apperrors.ErrAccessDenied)
+	}
+	if err := s.mediaRepo.Restore(id); err != nil {
+		return errors.New(apperrors.ErrInternalServerError)
+	}
+	s.Logger.WithFields(map[string]interface{}{"media_id": id, "user_id": userID}).Info("Media restored from trash")
+	return nil
+}
+
+// Purge окончательно удаляет записи, пролежавшие в корзине дольше срока хранения,
+// и файлы удалённых медиафайлов.
+func (s *TrashService) Purge() error {
+	cutoff := time.Now().Add(-s.cfg.Retention())
+
+	articles, articleFiles, err := s.articleRepo.PurgeDeletedBefore(cutoff)
+	if err != nil {
+		return err
+	}
+	comments, err := s.commentRepo.PurgeDeletedBefore(cutoff)
+	if err != nil {
+		return err
+	}
+	mediaFiles, err := s.mediaRepo.PurgeDeletedBefore(cutoff)
+	if err != nil {
+		return err
+	}
+
+	files := append(articleFiles, mediaFiles...)
+	for _, path := range files {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			s.Logger.WithError(err).WithField("file_path", path).Warn("Failed to delete purged media file from filesystem")
+		}
+	}
+	s.Logger.WithFields(map[string]interface{}{
+		"articles": articles,
+		"comments": comments,
+		"media":    len(files),
+	}).Info("Trash purged")
+	return nil
+}
+
+// trashOwnerFilter возвращает автора, корзина которого доступна пользователю;
+// 0 означает доступ ко всей корзине (модераторы и администраторы).
+func trashOwnerFilter(userID uint, userRoles []string) uint {
+	if slices.Contains(userRoles, "moderator") || slices.Contains(userRoles, "admin") {
+		return 0
+	}
+	return userID
+}
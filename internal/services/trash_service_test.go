@@ -0,0 +1,21 @@
+package services
+
+import "testing"
+
+func TestTrashOwnerFilter(t *testing.T) {
+	cases := []struct {
+		roles []string
+		want  uint
+	}{
+		{roles: []string{"user"}, want: 7},
+		{roles: []string{"editor"}, want: 7},
+		{roles: []string{"author", "moderator"}, want: 0},
+		{roles: []string{"admin"}, want: 0},
+		{roles: nil, want: 7},
+	}
+	for _, c := range cases {
+		if got := trashOwnerFilter(7, c.roles); got != c.want {
+			t.Errorf("trashOwnerFilter(7, %v) = %d, want %d", c.roles, got, c.want)
+		}
+	}
+}
@@ -9,15 +9,22 @@ import (
 	apperrors "github.com/AsterOzlob/content_managment_api/pkg/errors"
 )
 
+// FollowCounts содержит количество подписчиков пользователя и авторов, на которых он подписан.
+type FollowCounts struct {
+	Followers int64
+	Following int64
+}
+
 // UserService предоставляет методы для работы с пользователями.
 type UserService struct {
-	repo   *repositories.UserRepository
-	Logger logger.Logger
+	repo       *repositories.UserRepository
+	followRepo *repositories.FollowRepository
+	Logger     logger.Logger
 }
 
 // NewUserService создаёт новый экземпляр UserService.
-func NewUserService(repo *repositories.UserRepository, logger logger.Logger) *UserService {
-	return &UserService{repo: repo, Logger: logger}
+func NewUserService(repo *repositories.UserRepository, followRepo *repositories.FollowRepository, logger logger.Logger) *UserService {
+	return &UserService{repo: repo, followRepo: followRepo, Logger: logger}
 }
 
 // GetAllUsers возвращает список всех пользователей.
@@ -61,3 +68,31 @@ func (s *UserService) AssignRole(targetUserID uint, roleName string) error {
 	}
 	return nil
 }
+
+// GetFollowCounts возвращает количество подписчиков и подписок для каждого из пользователей userIDs.
+// Счётчики вычисляются при чтении; при ошибке БД возвращаются нулевые значения.
+func (s *UserService) GetFollowCounts(userIDs []uint) map[uint]FollowCounts {
+	counts := make(map[uint]FollowCounts, len(userIDs))
+	if len(userIDs) == 0 {
+		return counts
+	}
+	followers, err := s.followRepo.CountFollowers(userIDs)
+	if err != nil {
+		return counts
+	}
+	following, err := s.followRepo.CountFollowing(userIDs)
+	if err != nil {
+		return counts
+	}
+	for _, count := range followers {
+		c := counts[count.UserID]
+		c.Followers = count.Count
+		counts[count.UserID] = c
+	}
+	for _, count := range following {
+		c := counts[count.UserID]
+		c.Following = count.Count
+		counts[count.UserID] = c
+	}
+	return counts
+}
@@ -0,0 +1,128 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"time"
+)
+
+// MaxURLsPerFile — ограничение протокола Sitemaps на количество URL в одном файле.
+const MaxURLsPerFile = 50000
+
+// ContentType — Content-Type XML-карт сайта.
+const ContentType = "application/xml; charset=utf-8"
+
+// URL описывает адрес в карте сайта.
+type URL struct {
+	Loc     string    // Абсолютный адрес страницы.
+	LastMod time.Time // Время последнего изменения страницы.
+	Images  []string  // Абсолютные адреса изображений на странице.
+}
+
+// IndexEntry описывает файл карты сайта в индексе.
+type IndexEntry struct {
+	Loc     string    // Абсолютный адрес файла карты сайта.
+	LastMod time.Time // Время последнего изменения страниц в файле.
+}
+
+type urlSet struct {
+	XMLName xml.Name   `xml:"urlset"`
+	XMLNS   string     `xml:"xmlns,attr"`
+	ImageNS string     `xml:"xmlns:image,attr,omitempty"`
+	URLs    []urlEntry `xml:"url"`
+}
+
+type urlEntry struct {
+	Loc     string       `xml:"loc"`
+	LastMod string       `xml:"lastmod,omitempty"`
+	Images  []imageEntry `xml:"image:image"`
+}
+
+type imageEntry struct {
+	Loc string `xml:"image:loc"`
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name       `xml:"sitemapindex"`
+	XMLNS    string         `xml:"xmlns,attr"`
+	Sitemaps []sitemapEntry `xml:"sitemap"`
+}
+
+type sitemapEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// RenderURLSet сериализует список адресов в файл карты сайта.
+func RenderURLSet(urls []URL) ([]byte, error) {
+	set := urlSet{
+		XMLNS: "http://www.sitemaps.org/schemas/sitemap/0.9",
+		URLs:  make([]urlEntry, 0, len(urls)),
+	}
+	for _, u := range urls {
+		entry := urlEntry{Loc: u.Loc, LastMod: formatLastMod(u.LastMod)}
+		for _, image := range u.Images {
+			entry.Images = append(entry.Images, imageEntry{Loc: image})
+		}
+		if len(entry.Images) > 0 {
+			set.ImageNS = "http://www.google.com/schemas/sitemap-image/1.1"
+		}
+		set.URLs = append(set.URLs, entry)
+	}
+	return marshal(set)
+}
+
+// RenderIndex сериализует индекс файлов карты сайта.
+func RenderIndex(entries []IndexEntry) ([]byte, error) {
+	index := sitemapIndex{
+		XMLNS:    "http://www.sitemaps.org/schemas/sitemap/0.9",
+		Sitemaps: make([]sitemapEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		index.Sitemaps = append(index.Sitemaps, sitemapEntry{Loc: e.Loc, LastMod: formatLastMod(e.LastMod)})
+	}
+	return marshal(index)
+}
+
+// Pages возвращает количество файлов, на которые нужно разбить указанное число адресов.
+func Pages(total int) int {
+	if total == 0 {
+		return 1
+	}
+	return (total + MaxURLsPerFile - 1) / MaxURLsPerFile
+}
+
+// Page возвращает адреса страницы карты сайта с номером page (начиная с 1).
+func Page(urls []URL, page int) []URL {
+	start := (page - 1) * MaxURLsPerFile
+	if page < 1 || start >= len(urls) {
+		return nil
+	}
+	end := min(start+MaxURLsPerFile, len(urls))
+	return urls[start:end]
+}
+
+// LatestMod возвращает наибольшее время изменения среди адресов.
+func LatestMod(urls []URL) time.Time {
+	var latest time.Time
+	for _, u := range urls {
+		if u.LastMod.After(latest) {
+			latest = u.LastMod
+		}
+	}
+	return latest
+}
+
+func formatLastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func marshal(doc interface{}) ([]byte, error) {
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
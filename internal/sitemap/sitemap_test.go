@@ -0,0 +1,119 @@
+package sitemap
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPagesSplitsAtProtocolLimit(t *testing.T) {
+	for total, want := range map[int]int{
+		0:                  1,
+		1:                  1,
+		MaxURLsPerFile:     1,
+		MaxURLsPerFile + 1: 2,
+		3 * MaxURLsPerFile: 3,
+	} {
+		if got := Pages(total); got != want {
+			t.Errorf("Pages(%d) = %d, want %d", total, got, want)
+		}
+	}
+}
+
+func TestPage(t *testing.T) {
+	urls := make([]URL, MaxURLsPerFile+10)
+
+	first := Page(urls, 1)
+	if len(first) != MaxURLsPerFile || &first[0] != &urls[0] {
+		t.Errorf("Page(1) has %d URLs, want the first %d", len(first), MaxURLsPerFile)
+	}
+	if last := Page(urls, 2); len(last) != 10 || &last[0] != &urls[MaxURLsPerFile] {
+		t.Errorf("Page(2) has %d URLs, want the remaining 10", len(last))
+	}
+	for _, page := range []int{0, -1, 3} {
+		if got := Page(urls, page); got != nil {
+			t.Errorf("Page(%d) = %d URLs, want nil", page, len(got))
+		}
+	}
+	if got := Page(nil, 1); got != nil {
+		t.Errorf("Page(nil, 1) = %v, want nil", got)
+	}
+}
+
+func TestLatestMod(t *testing.T) {
+	if got := LatestMod(nil); !got.IsZero() {
+		t.Errorf("LatestMod(nil) = %v, want zero time", got)
+	}
+	newest := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	urls := []URL{{LastMod: newest.Add(-time.Hour)}, {}, {LastMod: newest}, {LastMod: newest.AddDate(0, -1, 0)}}
+	if got := LatestMod(urls); !got.Equal(newest) {
+		t.Errorf("LatestMod() = %v, want %v", got, newest)
+	}
+}
+
+func TestRenderURLSet(t *testing.T) {
+	body, err := RenderURLSet([]URL{
+		{Loc: "https://example.com/articles/1?a=1&b=2", LastMod: time.Date(2024, 3, 1, 13, 0, 0, 0, time.FixedZone("MSK", 3*60*60))},
+		{Loc: "https://example.com/articles/2", Images: []string{"https://example.com/media/1.png", "https://example.com/media/2.png"}},
+	})
+	if err != nil {
+		t.Fatalf("RenderURLSet() error = %v", err)
+	}
+	out := string(body)
+	if !strings.HasPrefix(out, xml.Header) {
+		t.Error("sitemap has no XML declaration")
+	}
+	for _, want := range []string{
+		`<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9" xmlns:image="http://www.google.com/schemas/sitemap-image/1.1">`,
+		"<loc>https://example.com/articles/1?a=1&amp;b=2</loc>",
+		"<lastmod>2024-03-01T10:00:00Z</lastmod>",
+		"<image:loc>https://example.com/media/2.png</image:loc>",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("sitemap is missing %s\n%s", want, out)
+		}
+	}
+	if strings.Count(out, "<lastmod>") != 1 {
+		t.Errorf("URL without a modification time must have no lastmod:\n%s", out)
+	}
+}
+
+func TestRenderURLSetWithoutImages(t *testing.T) {
+	body, err := RenderURLSet(nil)
+	if err != nil {
+		t.Fatalf("RenderURLSet() error = %v", err)
+	}
+	if strings.Contains(string(body), "xmlns:image") {
+		t.Errorf("sitemap without images declares the image namespace:\n%s", body)
+	}
+	var set struct {
+		URLs []struct{} `xml:"url"`
+	}
+	if err := xml.Unmarshal(body, &set); err != nil || len(set.URLs) != 0 {
+		t.Errorf("empty sitemap = %s, err = %v", body, err)
+	}
+}
+
+func TestRenderIndex(t *testing.T) {
+	body, err := RenderIndex([]IndexEntry{
+		{Loc: "https://example.com/sitemaps/articles-1.xml", LastMod: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{Loc: "https://example.com/sitemaps/pages.xml"},
+	})
+	if err != nil {
+		t.Fatalf("RenderIndex() error = %v", err)
+	}
+	var index struct {
+		XMLName  xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 sitemapindex"`
+		Sitemaps []struct {
+			Loc     string `xml:"loc"`
+			LastMod string `xml:"lastmod"`
+		} `xml:"sitemap"`
+	}
+	if err := xml.Unmarshal(body, &index); err != nil {
+		t.Fatalf("index is not valid XML: %v\n%s", err, body)
+	}
+	if len(index.Sitemaps) != 2 || index.Sitemaps[0].LastMod != "2024-03-01T00:00:00Z" || index.Sitemaps[1].LastMod != "" {
+		t.Errorf("index = %+v", index.Sitemaps)
+	}
+}
@@ -0,0 +1,27 @@
+package spam
+
+import (
+	"math"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+)
+
+// Probability вычисляет наивным байесовским классификатором вероятность того,
+// что комментарий с указанными токенами является спамом.
+// Правдоподобия оцениваются по доле обучающих комментариев каждой метки, содержащих токен,
+// со сглаживанием Лапласа; токены, не встречавшиеся при обучении, не учитываются.
+// spamDocs и hamDocs должны быть положительными.
+func Probability(tokens []string, counts map[string]models.SpamToken, spamDocs, hamDocs int64) float64 {
+	total := float64(spamDocs + hamDocs)
+	logSpam := math.Log(float64(spamDocs) / total)
+	logHam := math.Log(float64(hamDocs) / total)
+	for _, token := range tokens {
+		count, ok := counts[token]
+		if !ok {
+			continue
+		}
+		logSpam += math.Log((float64(count.SpamCount) + 1) / (float64(spamDocs) + 2))
+		logHam += math.Log((float64(count.HamCount) + 1) / (float64(hamDocs) + 2))
+	}
+	return 1 / (1 + math.Exp(logHam-logSpam))
+}
@@ -0,0 +1,51 @@
+package spam
+
+import (
+	"math"
+	"testing"
+
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+)
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestProbability(t *testing.T) {
+	counts := map[string]models.SpamToken{
+		"casino":           {SpamCount: 9, HamCount: 0},
+		"link:bet.example": {SpamCount: 8, HamCount: 1},
+		"спасибо":          {SpamCount: 0, HamCount: 9},
+		"статья":           {SpamCount: 5, HamCount: 5},
+	}
+
+	if p := Probability(nil, counts, 10, 10); !near(p, 0.5) {
+		t.Errorf("no tokens with balanced training: p = %v, want the prior 0.5", p)
+	}
+	if p := Probability([]string{"unknown", "words"}, counts, 10, 30); !near(p, 0.25) {
+		t.Errorf("unknown tokens: p = %v, want the prior 0.25", p)
+	}
+	if p := Probability([]string{"casino", "link:bet.example"}, counts, 10, 10); p < 0.95 {
+		t.Errorf("spam tokens: p = %v, want close to 1", p)
+	}
+	if p := Probability([]string{"спасибо", "статья"}, counts, 10, 10); p > 0.1 {
+		t.Errorf("ham tokens: p = %v, want close to 0", p)
+	}
+	if p := Probability([]string{"статья"}, counts, 10, 10); !near(p, 0.5) {
+		t.Errorf("neutral token: p = %v, want 0.5", p)
+	}
+}
+
+func TestProbabilityDoesNotSaturate(t *testing.T) {
+	counts := map[string]models.SpamToken{}
+	tokens := make([]string, 0, maxTokens)
+	for i := 0; i < maxTokens; i++ {
+		token := string(rune('a'+i%26)) + string(rune('a'+i/26))
+		counts[token] = models.SpamToken{SpamCount: 1000}
+		tokens = append(tokens, token)
+	}
+	p := Probability(tokens, counts, 1000, 1000)
+	if math.IsNaN(p) || p < 0.99 || p > 1 {
+		t.Errorf("p = %v, want a finite probability close to 1", p)
+	}
+}
@@ -0,0 +1,173 @@
+// Package spam оценивает новые комментарии на спам: эвристики (количество ссылок,
+// повторяющийся текст, частота комментариев пользователя, возраст учётной записи)
+// объединяются с наивным байесовским классификатором, который обучается
+// на решениях модераторов.
+package spam
+
+import (
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/database/models"
+	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
+	"github.com/AsterOzlob/content_managment_api/internal/logger"
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// Признаки спама, перечисляемые в причинах оценки.
+const (
+	ReasonLinks      = "links"       // Слишком много ссылок.
+	ReasonDuplicate  = "duplicate"   // Такой же текст недавно уже публиковался.
+	ReasonVelocity   = "velocity"    // Пользователь слишком часто оставляет комментарии.
+	ReasonNewAccount = "new_account" // Учётная запись создана недавно.
+	ReasonClassifier = "classifier"  // Классификатор считает текст похожим на спам.
+)
+
+// Решения спам-фильтра.
+const (
+	ActionAllow  = "allow"  // Комментарий не похож на спам.
+	ActionQueue  = "queue"  // Комментарий нужно проверить модератору.
+	ActionReject = "reject" // Комментарий отклоняется как спам.
+)
+
+// Веса эвристик в оценке. Возраст учётной записи сам по себе не отправляет
+// комментарий на модерацию, а лишь усиливает другие признаки.
+const (
+	linkWeight         = 0.25 // За каждую ссылку сверх SPAM_MAX_LINKS.
+	maxLinkWeight      = 0.75
+	duplicateWeight    = 0.5
+	velocityWeight     = 0.4
+	newAccountWeight   = 0.15
+	minDuplicateLength = 30 // Более короткие тексты («Спасибо!») не считаются повтором.
+)
+
+// Verdict описывает результат проверки комментария.
+type Verdict struct {
+	Score   float64  // Оценка от 0 (не спам) до 1 (спам).
+	Reasons []string // Сработавшие признаки.
+	Action  string   // Решение: ActionAllow, ActionQueue или ActionReject.
+}
+
+// Filter проверяет новые комментарии на спам и обучается на решениях модераторов.
+type Filter struct {
+	repo        *repositories.SpamRepository
+	commentRepo *repositories.CommentRepository
+	userRepo    *repositories.UserRepository
+	cfg         *config.SpamConfig
+	Logger      logger.Logger
+}
+
+// NewFilter создаёт новый экземпляр Filter.
+func NewFilter(
+	repo *repositories.SpamRepository,
+	commentRepo *repositories.CommentRepository,
+	userRepo *repositories.UserRepository,
+	cfg *config.SpamConfig,
+	logger logger.Logger,
+) *Filter {
+	return &Filter{
+		repo:        repo,
+		commentRepo: commentRepo,
+		userRepo:    userRepo,
+		cfg:         cfg,
+		Logger:      logger,
+	}
+}
+
+// Check оценивает текст нового комментария пользователя authorID.
+// Эвристики складываются в оценку от 0 до 1, которая объединяется с вероятностью
+// классификатора как независимые свидетельства: 1 - (1 - эвристики) * (1 - классификатор).
+// Пока модераторы не разметили достаточно комментариев, классификатор не учитывается.
+func (f *Filter) Check(authorID uint, text string) (*Verdict, error) {
+	if !f.cfg.Enabled {
+		return &Verdict{Action: ActionAllow}, nil
+	}
+	now := time.Now()
+	verdict := &Verdict{Reasons: []string{}}
+	var heuristic float64
+
+	if links := CountLinks(text); links > f.cfg.MaxLinks {
+		heuristic += math.Min(float64(links-f.cfg.MaxLinks)*linkWeight, maxLinkWeight)
+		verdict.Reasons = append(verdict.Reasons, ReasonLinks)
+	}
+
+	if sanitized := utils.Sanitize(text); utf8.RuneCountInString(sanitized) >= minDuplicateLength {
+		duplicates, err := f.commentRepo.CountByTextSince(sanitized, now.Add(-f.cfg.Duplicates()))
+		if err != nil {
+			return nil, err
+		}
+		if duplicates > 0 {
+			heuristic += duplicateWeight
+			verdict.Reasons = append(verdict.Reasons, ReasonDuplicate)
+		}
+	}
+
+	recent, err := f.commentRepo.CountByAuthorSince(authorID, now.Add(-f.cfg.Velocity()))
+	if err != nil {
+		return nil, err
+	}
+	if recent >= int64(f.cfg.VelocityLimit) {
+		heuristic += velocityWeight
+		verdict.Reasons = append(verdict.Reasons, ReasonVelocity)
+	}
+
+	if f.cfg.NewAccountHours > 0 {
+		user, err := f.userRepo.GetByID(authorID)
+		if err != nil {
+			return nil, err
+		}
+		if now.Sub(user.CreatedAt) < f.cfg.NewAccount() {
+			heuristic += newAccountWeight
+			verdict.Reasons = append(verdict.Reasons, ReasonNewAccount)
+		}
+	}
+
+	probability, err := f.classify(Tokenize(text))
+	if err != nil {
+		return nil, err
+	}
+	if probability >= f.cfg.QueueThreshold {
+		verdict.Reasons = append(verdict.Reasons, ReasonClassifier)
+	}
+
+	verdict.Score = 1 - (1-math.Min(heuristic, 1))*(1-probability)
+	switch {
+	case verdict.Score >= f.cfg.RejectThreshold:
+		verdict.Action = ActionReject
+	case verdict.Score >= f.cfg.QueueThreshold:
+		verdict.Action = ActionQueue
+	default:
+		verdict.Action = ActionAllow
+	}
+	return verdict, nil
+}
+
+// Train обучает классификатор на решении модератора: label — models.SpamLabelSpam
+// или models.SpamLabelHam. Повторное решение по тому же комментарию заменяет прежнее.
+func (f *Filter) Train(comment *models.Comment, label string) error {
+	return f.repo.Train(&models.SpamDocument{
+		CommentID: comment.ID,
+		Label:     label,
+		Tokens:    strings.Join(Tokenize(comment.Text), " "),
+	})
+}
+
+// classify возвращает вероятность спама по мнению классификатора
+// или 0, если обучающих комментариев какой-либо метки меньше SPAM_MIN_TRAINING_COUNT.
+func (f *Filter) classify(tokens []string) (float64, error) {
+	spamDocs, hamDocs, err := f.repo.CountDocuments()
+	if err != nil {
+		return 0, err
+	}
+	if spamDocs < int64(f.cfg.MinTrainingCount) || hamDocs < int64(f.cfg.MinTrainingCount) {
+		return 0, nil
+	}
+	counts, err := f.repo.GetTokenCounts(tokens)
+	if err != nil {
+		return 0, err
+	}
+	return Probability(tokens, counts, spamDocs, hamDocs), nil
+}
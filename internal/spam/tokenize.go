@@ -0,0 +1,84 @@
+package spam
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/AsterOzlob/content_managment_api/pkg/utils"
+)
+
+// Ограничения токенизации текста комментария.
+const (
+	minTokenLength = 2   // Минимальная длина слова в символах.
+	maxTokenLength = 32  // Максимальная длина слова в символах.
+	maxTokens      = 200 // Максимальное количество токенов одного комментария.
+	maxLinkToken   = 64  // Максимальная длина токена ссылки (совпадает с размером колонки spam_tokens.token).
+	linkPrefix     = "link:"
+)
+
+// linkPattern находит ссылки в тексте, в том числе в атрибутах HTML-разметки.
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s"'<>()]+`)
+
+// findLinks возвращает ссылки в тексте без завершающих знаков препинания.
+func findLinks(text string) []string {
+	links := linkPattern.FindAllString(text, -1)
+	for i, link := range links {
+		links[i] = strings.TrimRight(link, ".,;:!?")
+	}
+	return links
+}
+
+// CountLinks возвращает количество различных ссылок в тексте.
+func CountLinks(text string) int {
+	links := make(map[string]struct{})
+	for _, link := range findLinks(text) {
+		links[strings.ToLower(link)] = struct{}{}
+	}
+	return len(links)
+}
+
+// Tokenize разбивает текст комментария на уникальные токены: слова в нижнем регистре
+// (без текста ссылок) и домены ссылок с префиксом "link:". Количество токенов ограничено maxTokens.
+func Tokenize(text string) []string {
+	seen := make(map[string]struct{})
+	tokens := []string{}
+	add := func(token string) {
+		if _, ok := seen[token]; ok || len(tokens) >= maxTokens {
+			return
+		}
+		seen[token] = struct{}{}
+		tokens = append(tokens, token)
+	}
+
+	for _, link := range findLinks(text) {
+		host := linkHost(link)
+		if host != "" && utf8.RuneCountInString(linkPrefix+host) <= maxLinkToken {
+			add(linkPrefix + host)
+		}
+	}
+	plain := linkPattern.ReplaceAllString(utils.StripHTML(text), " ")
+	words := strings.FieldsFunc(strings.ToLower(plain), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if length := utf8.RuneCountInString(word); length >= minTokenLength && length <= maxTokenLength {
+			add(word)
+		}
+	}
+	return tokens
+}
+
+// linkHost возвращает домен ссылки без префикса "www.".
+func linkHost(link string) string {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+}
@@ -0,0 +1,52 @@
+package spam
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTokenizeSeparatesLinksFromWords(t *testing.T) {
+	got := Tokenize(`Купить дёшево: <a href="https://WWW.Shop.example/buy?id=1">тут</a>, www.shop.example/sale! ` +
+		`И ещё http://other.example/x.`)
+	want := []string{"link:shop.example", "link:other.example", "купить", "дёшево", "тут", "ещё"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Tokenize() = %q\nwant %q", got, want)
+	}
+}
+
+func TestTokenizeFoldsCaseAndSkipsShortWords(t *testing.T) {
+	got := Tokenize("<p>Go GO go — a я Tom&amp;Jerry 42</p>")
+	want := []string{"go", "tom", "jerry", "42"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Tokenize() = %q, want %q", got, want)
+	}
+}
+
+func TestTokenizeLimits(t *testing.T) {
+	long := strings.Repeat("a", maxTokenLength+1)
+	host := strings.Repeat("h", maxLinkToken) + ".example"
+	got := Tokenize(long + " https://" + host + "/ ok")
+	if !reflect.DeepEqual(got, []string{"ok"}) {
+		t.Errorf("Tokenize() = %q, want over-long words and link hosts skipped", got)
+	}
+
+	words := make([]string, maxTokens+50)
+	for i := range words {
+		words[i] = fmt.Sprintf("w%d", i)
+	}
+	if got := Tokenize(strings.Join(words, " ")); len(got) != maxTokens {
+		t.Errorf("Tokenize() returned %d tokens, want at most %d", len(got), maxTokens)
+	}
+}
+
+func TestCountLinks(t *testing.T) {
+	text := "https://a.example/x, HTTPS://A.EXAMPLE/x. www.b.example (https://c.example/) mailto:x@d.example"
+	if got := CountLinks(text); got != 3 {
+		t.Errorf("CountLinks() = %d, want 3 distinct links", got)
+	}
+	if got := CountLinks("нет ссылок"); got != 0 {
+		t.Errorf("CountLinks() = %d, want 0", got)
+	}
+}
@@ -0,0 +1,207 @@
+// Package stats вычисляет статистику текста статьи: количество слов и символов,
+// оценку времени чтения, количество изображений и оглавление по заголовкам.
+package stats
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// Скорость чтения по умолчанию и дополнительное время на изображение.
+const (
+	DefaultWordsPerMinute = 200
+	SecondsPerImage       = 10
+)
+
+// wordsPerMinute — средняя скорость чтения в словах в минуту для языков с разделением слов пробелами.
+var wordsPerMinute = map[string]int{
+	"ru": 180,
+	"uk": 180,
+	"en": 230,
+	"de": 200,
+	"fr": 210,
+	"es": 220,
+	"it": 215,
+	"pt": 210,
+}
+
+// charsPerMinute — скорость чтения в знаках в минуту для языков без пробелов между словами.
+var charsPerMinute = map[string]int{
+	"zh": 260,
+	"ja": 400,
+	"ko": 500,
+}
+
+// Stats содержит статистику текста, сохраняемую вместе со статьёй или переводом.
+type Stats struct {
+	WordCount   int     `json:"word_count" gorm:"not null;default:0;index"`   // Количество слов.
+	CharCount   int     `json:"char_count" gorm:"not null;default:0"`         // Количество символов с пробелами.
+	ReadingTime int     `json:"reading_time" gorm:"not null;default:0;index"` // Оценка времени чтения в минутах.
+	ImageCount  int     `json:"image_count" gorm:"not null;default:0"`        // Количество изображений.
+	Outline     Outline `json:"outline" gorm:"type:jsonb"`                    // Оглавление по заголовкам.
+}
+
+// Heading описывает заголовок в оглавлении.
+type Heading struct {
+	Level  int    `json:"level"`        // Уровень заголовка (1–6).
+	Text   string `json:"text"`         // Текст заголовка.
+	Anchor string `json:"id,omitempty"` // Якорь заголовка в HTML.
+}
+
+// Outline — оглавление текста в порядке следования заголовков.
+type Outline []Heading
+
+// Value сериализует оглавление в JSON для записи в БД.
+func (o Outline) Value() (driver.Value, error) {
+	if o == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// Scan десериализует оглавление из JSON, прочитанного из БД.
+func (o *Outline) Scan(value interface{}) error {
+	if value == nil {
+		*o = nil
+		return nil
+	}
+	var data []byte
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported type for stats outline: %T", value)
+	}
+	return json.Unmarshal(data, o)
+}
+
+// Compute вычисляет статистику по отрендеренному HTML текста.
+// Время чтения оценивается по скорости чтения для локали и округляется вверх до минуты.
+func Compute(textHTML, locale string) Stats {
+	var result Stats
+	var text strings.Builder
+	var heading *Heading
+	var headingText strings.Builder
+
+	tokenizer := html.NewTokenizer(strings.NewReader(textHTML))
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			break
+		}
+		token := tokenizer.Token()
+		switch tokenType {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if token.Data == "img" {
+				result.ImageCount++
+			}
+			if level := headingLevel(token.Data); level > 0 && tokenType == html.StartTagToken {
+				heading = &Heading{Level: level, Anchor: attr(token, "id")}
+				headingText.Reset()
+			}
+			if isBlockElement(token.Data) {
+				text.WriteByte(' ')
+			}
+		case html.EndTagToken:
+			if heading != nil && headingLevel(token.Data) == heading.Level {
+				heading.Text = strings.Join(strings.Fields(headingText.String()), " ")
+				if heading.Text != "" {
+					result.Outline = append(result.Outline, *heading)
+				}
+				heading = nil
+			}
+			if isBlockElement(token.Data) {
+				text.WriteByte(' ')
+			}
+		case html.TextToken:
+			text.WriteString(token.Data)
+			if heading != nil {
+				headingText.WriteString(token.Data)
+			}
+		}
+	}
+
+	words := strings.Fields(text.String())
+	for _, word := range words {
+		if strings.IndexFunc(word, isWordRune) >= 0 {
+			result.WordCount++
+		}
+	}
+	result.CharCount = utf8.RuneCountInString(strings.Join(words, " "))
+	result.ReadingTime = readingTime(words, result.WordCount, result.ImageCount, locale)
+	if result.Outline == nil {
+		result.Outline = Outline{}
+	}
+	return result
+}
+
+// readingTime оценивает время чтения в минутах. Для китайского, японского и корейского
+// скорость считается в знаках, для остальных языков — в словах.
+func readingTime(words []string, wordCount, imageCount int, locale string) int {
+	if wordCount == 0 && imageCount == 0 {
+		return 0
+	}
+	language, _, _ := strings.Cut(locale, "-")
+	var seconds float64
+	if cpm, ok := charsPerMinute[language]; ok {
+		chars := 0
+		for _, word := range words {
+			chars += utf8.RuneCountInString(word)
+		}
+		seconds = float64(chars) * 60 / float64(cpm)
+	} else {
+		wpm, ok := wordsPerMinute[language]
+		if !ok {
+			wpm = DefaultWordsPerMinute
+		}
+		seconds = float64(wordCount) * 60 / float64(wpm)
+	}
+	seconds += float64(imageCount * SecondsPerImage)
+	return max(1, int(math.Ceil(seconds/60)))
+}
+
+// headingLevel возвращает уровень заголовка для тегов h1–h6 и 0 для остальных тегов.
+func headingLevel(tag string) int {
+	if len(tag) == 2 && tag[0] == 'h' && tag[1] >= '1' && tag[1] <= '6' {
+		return int(tag[1] - '0')
+	}
+	return 0
+}
+
+// isBlockElement сообщает, разделяет ли тег текст на отдельные слова.
+func isBlockElement(tag string) bool {
+	switch tag {
+	case "p", "div", "br", "li", "ul", "ol", "blockquote", "pre", "figure", "figcaption",
+		"table", "tr", "td", "th", "h1", "h2", "h3", "h4", "h5", "h6", "hr":
+		return true
+	}
+	return false
+}
+
+// isWordRune сообщает, может ли символ входить в слово.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// attr возвращает значение атрибута тега или пустую строку.
+func attr(token html.Token, name string) string {
+	for _, a := range token.Attr {
+		if a.Key == name {
+			return a.Val
+		}
+	}
+	return ""
+}
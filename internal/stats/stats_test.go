@@ -0,0 +1,99 @@
+package stats
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestComputeSeparatesWordsAtBlockBoundaries(t *testing.T) {
+	got := Compute("<p>один</p><p>два</p><ul><li>три</li><li>четыре</li></ul>", "ru")
+	if got.WordCount != 4 {
+		t.Errorf("WordCount = %d, want 4", got.WordCount)
+	}
+	if got.CharCount != len([]rune("один два три четыре")) {
+		t.Errorf("CharCount = %d, want characters of the words joined by single spaces", got.CharCount)
+	}
+
+	inline := Compute("<p>Java<b>Script</b> — язык</p>", "ru")
+	if inline.WordCount != 2 {
+		t.Errorf("inline markup and dashes: WordCount = %d, want 2", inline.WordCount)
+	}
+}
+
+func TestComputeDecodesEntities(t *testing.T) {
+	got := Compute("<p>Tom&nbsp;&amp;&nbsp;Jerry</p>", "en")
+	if got.WordCount != 2 {
+		t.Errorf("WordCount = %d, want 2: a lone ampersand is not a word", got.WordCount)
+	}
+	if got.CharCount != len([]rune("Tom & Jerry")) {
+		t.Errorf("CharCount = %d, want entities counted as single characters", got.CharCount)
+	}
+}
+
+func TestComputeReadingTime(t *testing.T) {
+	words := func(n int) string {
+		return "<p>" + strings.TrimSpace(strings.Repeat("слово ", n)) + "</p>"
+	}
+	for _, tt := range []struct {
+		html   string
+		locale string
+		want   int
+	}{
+		{"", "ru", 0},
+		{"<p> — </p>", "ru", 0},
+		{words(1), "ru", 1},
+		{words(180), "ru", 1},
+		{words(181), "ru", 2},
+		{words(230), "en-US", 1},
+		{words(231), "en-US", 2},
+		{words(201), "xx", 2},
+		{`<img src="1.png">`, "ru", 1},
+		{words(180) + strings.Repeat(`<img src="1.png">`, 6), "ru", 2},
+		{"<p>" + strings.Repeat("字", 260) + "</p>", "zh", 1},
+		{"<p>" + strings.Repeat("字", 261) + "</p>", "zh-Hans", 2},
+	} {
+		if got := Compute(tt.html, tt.locale).ReadingTime; got != tt.want {
+			t.Errorf("Compute(%.30q, %q).ReadingTime = %d, want %d", tt.html, tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestComputeOutline(t *testing.T) {
+	got := Compute(`<h1>Title</h1>
+		<p>Intro</p>
+		<h2 id="setup">Setup <code>go</code>   tools</h2>
+		<h3 id="empty">  </h3>
+		<h3>Details</h3>
+		<figure><img src="a.png"><img src="b.png"/></figure>`, "en")
+
+	want := Outline{
+		{Level: 1, Text: "Title"},
+		{Level: 2, Text: "Setup go tools", Anchor: "setup"},
+		{Level: 3, Text: "Details"},
+	}
+	if !reflect.DeepEqual(got.Outline, want) {
+		t.Errorf("Outline = %+v, want %+v", got.Outline, want)
+	}
+	if got.ImageCount != 2 {
+		t.Errorf("ImageCount = %d, want 2", got.ImageCount)
+	}
+}
+
+func TestOutlineIsNeverNull(t *testing.T) {
+	got := Compute("<p>no headings</p>", "ru")
+	if got.Outline == nil {
+		t.Fatal("Outline = nil, want an empty outline")
+	}
+	if value, err := Outline(nil).Value(); value != "[]" || err != nil {
+		t.Errorf("Outline(nil).Value() = %v, %v; want []", value, err)
+	}
+
+	var scanned Outline
+	if err := scanned.Scan([]byte(`[{"level":2,"text":"A","id":"a"}]`)); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if len(scanned) != 1 || scanned[0].Anchor != "a" {
+		t.Errorf("Scan() = %+v", scanned)
+	}
+}
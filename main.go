@@ -1,7 +1,14 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/AsterOzlob/content_managment_api/api/routes"
 	_ "github.com/AsterOzlob/content_managment_api/docs"
@@ -48,20 +55,60 @@ func main() {
 	}
 
 	// Настройка зависимостей: репозитории, сервисы, контроллеры.
-	deps := appinit.SetupDependencies(dbConn, cfg)
+	deps, err := appinit.SetupDependencies(dbConn, cfg)
+	if err != nil {
+		appLogger.WithError(err).Error("Failed to set up dependencies")
+		return
+	}
+	defer deps.SearchIndex.Close()
+
+	// Команда "reindex" перестраивает поисковый индекс и завершает работу.
+	if len(os.Args) > 1 && os.Args[1] == "reindex" {
+		indexed, err := deps.Services.SearchService.Reindex()
+		deps.Recorder.Stop()
+		deps.Indexer.Stop()
+		if err != nil {
+			appLogger.WithError(err).Error("Failed to rebuild search index")
+			return
+		}
+		log.Printf("Поисковый индекс перестроен, документов: %d", indexed)
+		return
+	}
 
 	// Запуск планировщика для очистки истекших токенов.
 	scheduler.StartTokenCleanupScheduler(deps.Repositories.RefreshTokenRepo, appLogger)
 
+	// Запуск планировщика окончательной очистки корзины.
+	scheduler.StartTrashPurgeScheduler(deps.Services.TrashService, cfg.TrashConfig.Interval(), appLogger)
+
 	// Настройка маршрутизатора и эндпоинтов API.
 	r := routes.SetupRouter(deps, appLogger)
 
 	// Определяем адрес сервера и запускаем HTTP-сервер.
 	serverAddress := ":8080"
-	if err := r.Run(serverAddress); err != nil {
-		appLogger.WithError(err).Error("Failed to start HTTP server")
-		return
+	server := &http.Server{Addr: serverAddress, Handler: r}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			appLogger.WithError(err).Error("Failed to start HTTP server")
+			os.Exit(1)
+		}
+	}()
+	appLogger.Info("Application started successfully!")
+
+	// Ожидаем сигнал завершения и останавливаем сервер, дожидаясь текущих запросов.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+	appLogger.Info("Shutting down application")
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		appLogger.WithError(err).Error("Failed to shut down HTTP server gracefully")
 	}
 
-	appLogger.Info("Application started successfully!")
+	// Сохраняем накопленную статистику просмотров и применяем оставшиеся изменения поискового индекса
+	// до его закрытия.
+	deps.Recorder.Stop()
+	deps.Indexer.Stop()
 }
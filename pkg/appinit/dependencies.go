@@ -3,20 +3,27 @@ package appinit
 import (
 	"github.com/AsterOzlob/content_managment_api/api/controllers"
 	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/AsterOzlob/content_managment_api/internal/analytics"
 	"github.com/AsterOzlob/content_managment_api/internal/database/repositories"
 	"github.com/AsterOzlob/content_managment_api/internal/logger"
+	"github.com/AsterOzlob/content_managment_api/internal/search"
 	"github.com/AsterOzlob/content_managment_api/internal/services"
+	"github.com/AsterOzlob/content_managment_api/internal/spam"
 	"gorm.io/gorm"
 )
 
 // Loggers содержит все логгеры проекта
 type Loggers struct {
-	AuthLogger    logger.Logger
-	UserLogger    logger.Logger
-	ArticleLogger logger.Logger
-	CommentLogger logger.Logger
-	MediaLogger   logger.Logger
-	RoleLogger    logger.Logger
+	AuthLogger      logger.Logger
+	UserLogger      logger.Logger
+	ArticleLogger   logger.Logger
+	CommentLogger   logger.Logger
+	MediaLogger     logger.Logger
+	RoleLogger      logger.Logger
+	SearchLogger    logger.Logger
+	TaxonomyLogger  logger.Logger
+	ContentLogger   logger.Logger
+	AnalyticsLogger logger.Logger
 }
 
 // Repositories содержит все репозитории проекта
@@ -27,26 +34,74 @@ type Repositories struct {
 	MediaRepo        *repositories.MediaRepository
 	RefreshTokenRepo *repositories.RefreshTokenRepository
 	RoleRepo         *repositories.RoleRepository
+	TagRepo          *repositories.TagRepository
+	CategoryRepo     *repositories.CategoryRepository
+	ContentTypeRepo  *repositories.ContentTypeRepository
+	EntryRepo        *repositories.EntryRepository
+	TranslationRepo  *repositories.ArticleTranslationRepository
+	PreviewTokenRepo *repositories.PreviewTokenRepository
+	SeriesRepo       *repositories.SeriesRepository
+	AnalyticsRepo    *repositories.AnalyticsRepository
+	ReactionRepo     *repositories.ReactionRepository
+	ReadingListRepo  *repositories.ReadingListRepository
+	FollowRepo       *repositories.FollowRepository
+	SpamRepo         *repositories.SpamRepository
+	ReportRepo       *repositories.ReportRepository
+	NotificationRepo *repositories.NotificationRepository
 }
 
 // Services содержит все сервисы проекта
 type Services struct {
-	AuthService    *services.AuthService
-	UserService    *services.UserService
-	ArticleService *services.ArticleService
-	CommentService *services.CommentService
-	MediaService   *services.MediaService
-	RoleService    *services.RoleService
+	AuthService         *services.AuthService
+	UserService         *services.UserService
+	ArticleService      *services.ArticleService
+	CommentService      *services.CommentService
+	MediaService        *services.MediaService
+	RoleService         *services.RoleService
+	SearchService       *services.SearchService
+	TagService          *services.TagService
+	CategoryService     *services.CategoryService
+	ContentTypeService  *services.ContentTypeService
+	EntryService        *services.EntryService
+	TranslationService  *services.TranslationService
+	FeedService         *services.FeedService
+	SitemapService      *services.SitemapService
+	PreviewService      *services.PreviewService
+	TrashService        *services.TrashService
+	SeriesService       *services.SeriesService
+	AnalyticsService    *services.AnalyticsService
+	ReactionService     *services.ReactionService
+	ReadingListService  *services.ReadingListService
+	FollowService       *services.FollowService
+	ReportService       *services.ReportService
+	NotificationService *services.NotificationService
 }
 
 // Controllers содержит все контроллеры проекта
 type Controllers struct {
-	AuthCtrl    *controllers.AuthController
-	UserCtrl    *controllers.UserController
-	ArticleCtrl *controllers.ArticleController
-	CommentCtrl *controllers.CommentController
-	MediaCtrl   *controllers.MediaController
-	RoleCtrl    *controllers.RoleController
+	AuthCtrl         *controllers.AuthController
+	UserCtrl         *controllers.UserController
+	ArticleCtrl      *controllers.ArticleController
+	CommentCtrl      *controllers.CommentController
+	MediaCtrl        *controllers.MediaController
+	RoleCtrl         *controllers.RoleController
+	SearchCtrl       *controllers.SearchController
+	TagCtrl          *controllers.TagController
+	CategoryCtrl     *controllers.CategoryController
+	ContentTypeCtrl  *controllers.ContentTypeController
+	EntryCtrl        *controllers.EntryController
+	TranslationCtrl  *controllers.TranslationController
+	FeedCtrl         *controllers.FeedController
+	SitemapCtrl      *controllers.SitemapController
+	PreviewCtrl      *controllers.PreviewController
+	TrashCtrl        *controllers.TrashController
+	SeriesCtrl       *controllers.SeriesController
+	AnalyticsCtrl    *controllers.AnalyticsController
+	ReactionCtrl     *controllers.ReactionController
+	ReadingListCtrl  *controllers.ReadingListController
+	FollowCtrl       *controllers.FollowController
+	ReportCtrl       *controllers.ReportController
+	NotificationCtrl *controllers.NotificationController
 }
 
 // Dependencies содержит все зависимости проекта
@@ -57,19 +112,39 @@ type Dependencies struct {
 	Loggers      *Loggers
 	JWTConfig    *config.JWTConfig
 	MediaConfig  *config.MediaConfig
+	SearchIndex  search.SearchIndex
+	Indexer      *search.Indexer
+	Recorder     *analytics.Recorder
 }
 
 // SetupDependencies настраивает зависимости приложения:
 // логгеры, репозитории, сервисы и контроллеры.
-func SetupDependencies(dbConn *gorm.DB, cfg *config.Config) *Dependencies {
+// Возвращает ошибку, если не удалось открыть поисковый индекс.
+func SetupDependencies(dbConn *gorm.DB, cfg *config.Config) (*Dependencies, error) {
 	// Инициализация логгеров
 	loggers := setupLoggers()
 
 	// Инициализация репозиториев
 	repos := setupRepositories(dbConn, loggers)
 
+	// Инициализация поискового индекса и асинхронного индексатора
+	searchIndex, err := setupSearchIndex(dbConn, cfg, loggers)
+	if err != nil {
+		return nil, err
+	}
+	indexer := search.NewIndexer(searchIndex, cfg.SearchConfig.QueueSize, loggers.SearchLogger)
+
+	// Инициализация буфера просмотров статей с пакетной записью агрегатов
+	recorder := analytics.NewRecorder(
+		repos.AnalyticsRepo,
+		cfg.AnalyticsConfig.QueueSize,
+		cfg.AnalyticsConfig.BatchSize,
+		cfg.AnalyticsConfig.Interval(),
+		loggers.AnalyticsLogger,
+	)
+
 	// Инициализация сервисов
-	services := setupServices(repos, cfg, loggers)
+	services := setupServices(repos, searchIndex, indexer, recorder, cfg, loggers)
 
 	// Инициализация контроллеров
 	controllers := setupControllers(services, cfg)
@@ -82,21 +157,39 @@ func SetupDependencies(dbConn *gorm.DB, cfg *config.Config) *Dependencies {
 		Loggers:      loggers,
 		JWTConfig:    cfg.JWTConfig,
 		MediaConfig:  cfg.MediaConfig,
-	}
+		SearchIndex:  searchIndex,
+		Indexer:      indexer,
+		Recorder:     recorder,
+	}, nil
 }
 
 // setupLoggers создает логгеры для каждой области
 func setupLoggers() *Loggers {
 	return &Loggers{
-		AuthLogger:    logger.NewLogger("logs/auth.log"),
-		UserLogger:    logger.NewLogger("logs/users.log"),
-		ArticleLogger: logger.NewLogger("logs/articles.log"),
-		CommentLogger: logger.NewLogger("logs/comments.log"),
-		MediaLogger:   logger.NewLogger("logs/media.log"),
-		RoleLogger:    logger.NewLogger("logs/roles.log"),
+		AuthLogger:      logger.NewLogger("logs/auth.log"),
+		UserLogger:      logger.NewLogger("logs/users.log"),
+		ArticleLogger:   logger.NewLogger("logs/articles.log"),
+		CommentLogger:   logger.NewLogger("logs/comments.log"),
+		MediaLogger:     logger.NewLogger("logs/media.log"),
+		RoleLogger:      logger.NewLogger("logs/roles.log"),
+		SearchLogger:    logger.NewLogger("logs/search.log"),
+		TaxonomyLogger:  logger.NewLogger("logs/taxonomy.log"),
+		ContentLogger:   logger.NewLogger("logs/content.log"),
+		AnalyticsLogger: logger.NewLogger("logs/analytics.log"),
 	}
 }
 
+// setupSearchIndex создаёт поисковый индекс выбранного бэкенда.
+func setupSearchIndex(dbConn *gorm.DB, cfg *config.Config, loggers *Loggers) (search.SearchIndex, error) {
+	index, err := search.NewIndex(cfg.SearchConfig, dbConn)
+	if err != nil {
+		loggers.SearchLogger.WithError(err).WithField("backend", cfg.SearchConfig.Backend).
+			Error("Failed to initialize search index")
+		return nil, err
+	}
+	return index, nil
+}
+
 // setupRepositories инициализирует репозитории
 func setupRepositories(dbConn *gorm.DB, loggers *Loggers) *Repositories {
 	return &Repositories{
@@ -106,11 +199,38 @@ func setupRepositories(dbConn *gorm.DB, loggers *Loggers) *Repositories {
 		MediaRepo:        repositories.NewMediaRepository(dbConn, loggers.MediaLogger),
 		RefreshTokenRepo: repositories.NewRefreshTokenRepository(dbConn, loggers.AuthLogger),
 		RoleRepo:         repositories.NewRoleRepository(dbConn, loggers.RoleLogger),
+		TagRepo:          repositories.NewTagRepository(dbConn, loggers.TaxonomyLogger),
+		CategoryRepo:     repositories.NewCategoryRepository(dbConn, loggers.TaxonomyLogger),
+		ContentTypeRepo:  repositories.NewContentTypeRepository(dbConn, loggers.ContentLogger),
+		EntryRepo:        repositories.NewEntryRepository(dbConn, loggers.ContentLogger),
+		TranslationRepo:  repositories.NewArticleTranslationRepository(dbConn, loggers.ArticleLogger),
+		PreviewTokenRepo: repositories.NewPreviewTokenRepository(dbConn, loggers.ArticleLogger),
+		SeriesRepo:       repositories.NewSeriesRepository(dbConn, loggers.ArticleLogger),
+		AnalyticsRepo:    repositories.NewAnalyticsRepository(dbConn, loggers.AnalyticsLogger),
+		ReactionRepo:     repositories.NewReactionRepository(dbConn, loggers.CommentLogger),
+		ReadingListRepo:  repositories.NewReadingListRepository(dbConn, loggers.UserLogger),
+		FollowRepo:       repositories.NewFollowRepository(dbConn, loggers.UserLogger),
+		SpamRepo:         repositories.NewSpamRepository(dbConn, loggers.CommentLogger),
+		ReportRepo:       repositories.NewReportRepository(dbConn, loggers.CommentLogger),
+		NotificationRepo: repositories.NewNotificationRepository(dbConn, loggers.UserLogger),
 	}
 }
 
 // setupServices инициализирует сервисы
-func setupServices(repos *Repositories, cfg *config.Config, loggers *Loggers) *Services {
+func setupServices(
+	repos *Repositories,
+	searchIndex search.SearchIndex,
+	indexer *search.Indexer,
+	recorder *analytics.Recorder,
+	cfg *config.Config,
+	loggers *Loggers,
+) *Services {
+	notificationService := services.NewNotificationService(
+		repos.NotificationRepo,
+		repos.CommentRepo,
+		loggers.UserLogger,
+	)
+
 	return &Services{
 		AuthService: services.NewAuthService(
 			repos.UserRepo,
@@ -120,14 +240,33 @@ func setupServices(repos *Repositories, cfg *config.Config, loggers *Loggers) *S
 		),
 		UserService: services.NewUserService(
 			repos.UserRepo,
+			repos.FollowRepo,
 			loggers.UserLogger,
 		),
 		ArticleService: services.NewArticleService(
 			repos.ArticleRepo,
+			repos.TagRepo,
+			repos.CategoryRepo,
+			repos.MediaRepo,
+			repos.CommentRepo,
+			indexer,
+			cfg.LocaleConfig,
 			loggers.ArticleLogger,
 		),
 		CommentService: services.NewCommentService(
 			repos.CommentRepo,
+			repos.ArticleRepo,
+			repos.UserRepo,
+			indexer,
+			spam.NewFilter(
+				repos.SpamRepo,
+				repos.CommentRepo,
+				repos.UserRepo,
+				cfg.SpamConfig,
+				loggers.CommentLogger,
+			),
+			notificationService,
+			cfg.CommentConfig,
 			loggers.CommentLogger,
 		),
 		MediaService: services.NewMediaService(
@@ -139,20 +278,156 @@ func setupServices(repos *Repositories, cfg *config.Config, loggers *Loggers) *S
 			repos.RoleRepo,
 			loggers.RoleLogger,
 		),
+		SearchService: services.NewSearchService(
+			searchIndex,
+			repos.ArticleRepo,
+			repos.CommentRepo,
+			loggers.SearchLogger,
+		),
+		TagService: services.NewTagService(
+			repos.TagRepo,
+			loggers.TaxonomyLogger,
+		),
+		CategoryService: services.NewCategoryService(
+			repos.CategoryRepo,
+			loggers.TaxonomyLogger,
+		),
+		ContentTypeService: services.NewContentTypeService(
+			repos.ContentTypeRepo,
+			loggers.ContentLogger,
+		),
+		EntryService: services.NewEntryService(
+			repos.EntryRepo,
+			repos.ContentTypeRepo,
+			repos.MediaRepo,
+			loggers.ContentLogger,
+		),
+		TranslationService: services.NewTranslationService(
+			repos.TranslationRepo,
+			repos.ArticleRepo,
+			cfg.LocaleConfig,
+			loggers.ArticleLogger,
+		),
+		FeedService: services.NewFeedService(
+			repos.ArticleRepo,
+			repos.TagRepo,
+			repos.CategoryRepo,
+			repos.UserRepo,
+			repos.SeriesRepo,
+			cfg.SiteConfig,
+			cfg.LocaleConfig,
+			loggers.ArticleLogger,
+		),
+		SitemapService: services.NewSitemapService(
+			repos.ArticleRepo,
+			cfg.SiteConfig,
+			cfg.SEOConfig,
+			loggers.ArticleLogger,
+		),
+		PreviewService: services.NewPreviewService(
+			repos.PreviewTokenRepo,
+			repos.ArticleRepo,
+			cfg.PreviewConfig,
+			cfg.SiteConfig,
+			loggers.ArticleLogger,
+		),
+		TrashService: services.NewTrashService(
+			repos.ArticleRepo,
+			repos.CommentRepo,
+			repos.MediaRepo,
+			indexer,
+			cfg.TrashConfig,
+			loggers.ArticleLogger,
+		),
+		SeriesService: services.NewSeriesService(
+			repos.SeriesRepo,
+			repos.ArticleRepo,
+			loggers.ArticleLogger,
+		),
+		AnalyticsService: services.NewAnalyticsService(
+			repos.AnalyticsRepo,
+			repos.ArticleRepo,
+			recorder,
+			cfg.AnalyticsConfig,
+			loggers.AnalyticsLogger,
+		),
+		ReactionService: services.NewReactionService(
+			repos.ReactionRepo,
+			repos.ArticleRepo,
+			repos.CommentRepo,
+			cfg.ReactionConfig,
+			loggers.CommentLogger,
+		),
+		ReadingListService: services.NewReadingListService(
+			repos.ReadingListRepo,
+			repos.ArticleRepo,
+			loggers.UserLogger,
+		),
+		FollowService: services.NewFollowService(
+			repos.FollowRepo,
+			repos.UserRepo,
+			repos.TagRepo,
+			repos.ArticleRepo,
+			loggers.UserLogger,
+		),
+		ReportService: services.NewReportService(
+			repos.ReportRepo,
+			repos.ArticleRepo,
+			repos.CommentRepo,
+			repos.UserRepo,
+			indexer,
+			cfg.ReportConfig,
+			loggers.CommentLogger,
+		),
+		NotificationService: notificationService,
 	}
 }
 
 // setupControllers инициализирует контроллеры
 func setupControllers(services *Services, cfg *config.Config) *Controllers {
 	return &Controllers{
-		AuthCtrl:    controllers.NewAuthController(services.AuthService),
-		UserCtrl:    controllers.NewUserController(services.UserService),
-		ArticleCtrl: controllers.NewArticleController(services.ArticleService),
+		AuthCtrl: controllers.NewAuthController(services.AuthService),
+		UserCtrl: controllers.NewUserController(services.UserService),
+		ArticleCtrl: controllers.NewArticleController(
+			services.ArticleService,
+			services.AnalyticsService,
+			services.ReadingListService,
+			cfg.LocaleConfig,
+			cfg.SiteConfig,
+		),
 		CommentCtrl: controllers.NewCommentController(services.CommentService),
 		MediaCtrl: controllers.NewMediaController(
 			services.MediaService,
 			cfg.MediaConfig,
 		),
-		RoleCtrl: controllers.NewRoleController(services.RoleService),
+		RoleCtrl:        controllers.NewRoleController(services.RoleService),
+		SearchCtrl:      controllers.NewSearchController(services.SearchService),
+		TagCtrl:         controllers.NewTagController(services.TagService),
+		CategoryCtrl:    controllers.NewCategoryController(services.CategoryService),
+		ContentTypeCtrl: controllers.NewContentTypeController(services.ContentTypeService),
+		EntryCtrl:       controllers.NewEntryController(services.EntryService),
+		TranslationCtrl: controllers.NewTranslationController(services.TranslationService),
+		FeedCtrl:        controllers.NewFeedController(services.FeedService),
+		SitemapCtrl:     controllers.NewSitemapController(services.SitemapService),
+		PreviewCtrl: controllers.NewPreviewController(
+			services.PreviewService,
+			cfg.SiteConfig,
+		),
+		TrashCtrl:     controllers.NewTrashController(services.TrashService),
+		SeriesCtrl:    controllers.NewSeriesController(services.SeriesService),
+		AnalyticsCtrl: controllers.NewAnalyticsController(services.AnalyticsService),
+		ReactionCtrl:  controllers.NewReactionController(services.ReactionService),
+		ReadingListCtrl: controllers.NewReadingListController(
+			services.ReadingListService,
+			cfg.SiteConfig,
+		),
+		FollowCtrl: controllers.NewFollowController(
+			services.FollowService,
+			services.ReadingListService,
+			cfg.LocaleConfig,
+			cfg.SiteConfig,
+		),
+		ReportCtrl:       controllers.NewReportController(services.ReportService),
+		NotificationCtrl: controllers.NewNotificationController(services.NotificationService),
 	}
 }
@@ -7,13 +7,21 @@ const (
 
 // Ошибки, связанные с комментариями
 const (
-	ErrCommentNotFound = "comment not found"
+	ErrCommentNotFound       = "comment not found"
+	ErrInvalidCommentID      = "invalid comment ID"
+	ErrParentCommentNotFound = "parent comment not found"
+	ErrParentCommentMismatch = "parent comment belongs to another article"
+	ErrCommentThreadLocked   = "comment thread is locked"
+	ErrCommentEditExpired    = "comment can no longer be edited"
 )
 
 // Ошибки, связанные со статьями
 const (
-	ErrArticleNotFound  = "article not found"
-	ErrInvalidArticleID = "invalid article ID"
+	ErrArticleNotFound      = "article not found"
+	ErrInvalidArticleID     = "invalid article ID"
+	ErrInvalidContentBlocks = "invalid content blocks"
+	ErrInvalidOGImage       = "open graph image must reference an existing image"
+	ErrInvalidArticleFilter = "invalid article filter: check author, word count, reading time and sort parameters"
 )
 
 // Ошибки, связанные с пользователями
@@ -29,7 +37,7 @@ const (
 	ErrInvalidCredentials     = "invalid credentials"
 	ErrFailedToCreateUser     = "failed to create user"
 	ErrFailedToGenerateTokens = "failed to generate tokens"
-	ErrUserAlreadyExists      = "user with this email already exists"
+	ErrUserAlreadyExists      = "user with this email or username already exists"
 )
 
 // Ошибки, связанные с ролями
@@ -50,3 +58,108 @@ const (
 	ErrMediaNotFound       = "media not found"
 	ErrInvalidMediaID      = "invalid media ID"
 )
+
+// Ошибки, связанные с поиском
+const (
+	ErrEmptySearchQuery = "search query is required"
+	ErrReindexFailed    = "failed to rebuild search index"
+)
+
+// Ошибки, связанные с тегами и рубриками
+const (
+	ErrTagNotFound           = "tag not found"
+	ErrInvalidTagID          = "invalid tag ID"
+	ErrTagAlreadyExists      = "tag with this slug already exists"
+	ErrTagNameAlreadyExists  = "tag with this name already exists"
+	ErrCategoryNotFound      = "category not found"
+	ErrInvalidCategoryID     = "invalid category ID"
+	ErrCategoryAlreadyExists = "category with this slug already exists"
+	ErrCategoryHasChildren   = "category has subcategories"
+	ErrCategoryCycle         = "category cannot be moved under itself or its descendant"
+	ErrInvalidSlug           = "slug must contain letters or digits"
+)
+
+// Ошибки, связанные с пользовательскими типами контента
+const (
+	ErrContentTypeNotFound      = "content type not found"
+	ErrContentTypeAlreadyExists = "content type with this slug already exists"
+	ErrContentTypeHasEntries    = "content type has entries"
+	ErrInvalidContentTypeFields = "invalid content type fields"
+	ErrEntryNotFound            = "entry not found"
+	ErrInvalidEntryID           = "invalid entry ID"
+	ErrInvalidEntryData         = "invalid entry data"
+)
+
+// Ошибки, связанные с переводами
+const (
+	ErrUnsupportedLocale     = "unsupported locale"
+	ErrTranslationNotFound   = "translation not found"
+	ErrTranslationSameLocale = "translation locale matches the article's original locale"
+)
+
+// Ошибки, связанные с картой сайта
+const (
+	ErrSitemapNotFound = "sitemap not found"
+)
+
+// Ошибки, связанные со ссылками предпросмотра
+const (
+	ErrPreviewTokenNotFound  = "preview token not found"
+	ErrInvalidPreviewTokenID = "invalid preview token ID"
+	ErrInvalidPreviewToken   = "preview link is invalid"
+	ErrPreviewTokenExpired   = "preview link has expired or was revoked"
+	ErrPreviewTTLTooLong     = "preview link lifetime exceeds the allowed maximum"
+)
+
+// Ошибки, связанные с корзиной
+const (
+	ErrTrashItemNotFound = "item not found in trash"
+)
+
+// Ошибки, связанные с сериями статей
+const (
+	ErrSeriesNotFound       = "series not found"
+	ErrInvalidSeriesID      = "invalid series ID"
+	ErrSeriesAlreadyExists  = "series with this slug already exists"
+	ErrDuplicateSeriesPart  = "article is listed in the series more than once"
+	ErrArticleInOtherSeries = "article already belongs to another series"
+)
+
+// Ошибки, связанные со статистикой просмотров
+const (
+	ErrInvalidDateRange = "invalid date range"
+)
+
+// Ошибки, связанные с реакциями
+const (
+	ErrUnsupportedReactionType = "unsupported reaction type"
+)
+
+// Ошибки, связанные с закладками и списками для чтения
+const (
+	ErrReadingListNotFound         = "reading list not found"
+	ErrInvalidReadingListID        = "invalid reading list ID"
+	ErrArticleAlreadyInReadingList = "article is already in the reading list"
+	ErrArticleNotInReadingList     = "article is not in the reading list"
+	ErrInvalidReadingListOrder     = "article_ids must list articles of the reading list without duplicates"
+)
+
+// Ошибки, связанные с жалобами
+const (
+	ErrUnsupportedReportReason = "unsupported report reason"
+	ErrCannotReportOwnContent  = "you cannot report your own content"
+	ErrNoOpenReports           = "no open reports for this content"
+	ErrReportAuthorDeleted     = "the author of deleted content cannot be warned or banned"
+	ErrUserBanned              = "user is banned"
+)
+
+// Ошибки, связанные с уведомлениями
+const (
+	ErrUnsupportedNotificationType = "unsupported notification type"
+)
+
+// Ошибки, связанные с подписками
+const (
+	ErrCannotFollowYourself = "you cannot follow yourself"
+	ErrInvalidCursor        = "invalid cursor"
+)
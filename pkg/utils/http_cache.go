@@ -0,0 +1,38 @@
+package utils
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NotModified выставляет заголовки ETag и Last-Modified и проверяет условный запрос.
+// Возвращает true, если клиентская копия актуальна и ответ 304 уже отправлен.
+func NotModified(ctx *gin.Context, etag string, lastModified time.Time) bool {
+	ctx.Header("ETag", etag)
+	if !lastModified.IsZero() {
+		ctx.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if match := ctx.GetHeader("If-None-Match"); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+				ctx.Status(http.StatusNotModified)
+				return true
+			}
+		}
+		return false
+	}
+
+	if since := ctx.GetHeader("If-Modified-Since"); since != "" && !lastModified.IsZero() {
+		sinceTime, err := http.ParseTime(since)
+		if err == nil && !lastModified.Truncate(time.Second).After(sinceTime) {
+			ctx.Status(http.StatusNotModified)
+			return true
+		}
+	}
+	return false
+}
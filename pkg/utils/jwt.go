@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/AsterOzlob/content_managment_api/config"
@@ -80,3 +81,42 @@ func validateToken(tokenString, secret string) (*jwt.Token, error) {
 
 	return token, nil
 }
+
+// GeneratePreviewToken создает подписанный токен предпросмотра статьи с заданным сроком действия.
+func GeneratePreviewToken(tokenID, articleID uint, expiresAt time.Time, cfg *config.PreviewConfig) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"jti":        strconv.FormatUint(uint64(tokenID), 10),
+		"article_id": articleID,
+		"exp":        expiresAt.Unix(),
+	})
+
+	tokenString, err := token.SignedString([]byte(cfg.TokenSecret))
+	if err != nil {
+		return "", fmt.Errorf("failed to sign preview token: %w", err)
+	}
+
+	return tokenString, nil
+}
+
+// ParsePreviewToken проверяет подпись и срок действия токена предпросмотра
+// и возвращает идентификаторы токена и статьи.
+func ParsePreviewToken(tokenString string, cfg *config.PreviewConfig) (tokenID, articleID uint, err error) {
+	token, err := validateToken(tokenString, cfg.TokenSecret)
+	if err != nil {
+		return 0, 0, err
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid preview token claims")
+	}
+	jti, _ := claims["jti"].(string)
+	id, err := strconv.ParseUint(jti, 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid preview token id: %w", err)
+	}
+	article, ok := claims["article_id"].(float64)
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid preview token article id")
+	}
+	return uint(id), uint(article), nil
+}
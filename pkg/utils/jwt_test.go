@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AsterOzlob/content_managment_api/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestParsePreviewToken(t *testing.T) {
+	cfg := &config.PreviewConfig{TokenSecret: "preview-secret"}
+
+	signed, err := GeneratePreviewToken(7, 42, time.Now().Add(time.Hour), cfg)
+	if err != nil {
+		t.Fatalf("GeneratePreviewToken() error = %v", err)
+	}
+	tokenID, articleID, err := ParsePreviewToken(signed, cfg)
+	if err != nil || tokenID != 7 || articleID != 42 {
+		t.Fatalf("ParsePreviewToken() = %d, %d, %v, want 7, 42, nil", tokenID, articleID, err)
+	}
+
+	expired, _ := GeneratePreviewToken(7, 42, time.Now().Add(-time.Minute), cfg)
+	if _, _, err := ParsePreviewToken(expired, cfg); err == nil {
+		t.Error("ParsePreviewToken() accepted an expired token")
+	}
+
+	otherSecret, _ := GeneratePreviewToken(7, 42, time.Now().Add(time.Hour), &config.PreviewConfig{TokenSecret: "other-secret"})
+	if _, _, err := ParsePreviewToken(otherSecret, cfg); err == nil {
+		t.Error("ParsePreviewToken() accepted a token signed with another secret")
+	}
+
+	// Подмена статьи в payload с сохранением подписи
+	parts := strings.Split(signed, ".")
+	forged, _ := GeneratePreviewToken(7, 43, time.Now().Add(time.Hour), cfg)
+	parts[1] = strings.Split(forged, ".")[1]
+	if _, _, err := ParsePreviewToken(strings.Join(parts, "."), cfg); err == nil {
+		t.Error("ParsePreviewToken() accepted a token with a swapped payload")
+	}
+
+	unsigned, _ := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{
+		"jti":        "7",
+		"article_id": 42,
+		"exp":        time.Now().Add(time.Hour).Unix(),
+	}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if _, _, err := ParsePreviewToken(unsigned, cfg); err == nil {
+		t.Error("ParsePreviewToken() accepted an unsigned token")
+	}
+}
+
+func TestParsePreviewTokenRejectsAccessToken(t *testing.T) {
+	cfg := &config.PreviewConfig{TokenSecret: "preview-secret"}
+
+	access, _ := GenerateAccessToken(1, "admin", &config.JWTConfig{AccessTokenSecret: "access-secret", AccessTokenTTL: 15})
+	if _, _, err := ParsePreviewToken(access, cfg); err == nil {
+		t.Error("ParsePreviewToken() accepted an access token")
+	}
+
+	// Даже при совпадающих секретах у access-токена нет идентификатора записи предпросмотра
+	sameSecret, _ := GenerateAccessToken(1, "admin", &config.JWTConfig{AccessTokenSecret: cfg.TokenSecret, AccessTokenTTL: 15})
+	if _, _, err := ParsePreviewToken(sameSecret, cfg); err == nil {
+		t.Error("ParsePreviewToken() accepted an access token signed with the preview secret")
+	}
+}
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ResolveLocale определяет локаль запроса.
+// Приоритет: параметр ?lang=, затем заголовок Accept-Language, затем fallback.
+// Возвращаются только локали из списка supported.
+func ResolveLocale(ctx *gin.Context, supported []string, fallback string) string {
+	if lang := NormalizeLocale(ctx.Query("lang")); lang != "" && slices.Contains(supported, lang) {
+		return lang
+	}
+	for _, lang := range parseAcceptLanguage(ctx.GetHeader("Accept-Language")) {
+		if slices.Contains(supported, lang) {
+			return lang
+		}
+	}
+	return fallback
+}
+
+// NormalizeLocale приводит языковой тег к основному подтегу в нижнем регистре ("en-US" → "en").
+func NormalizeLocale(tag string) string {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	return tag
+}
+
+// parseAcceptLanguage возвращает языки из заголовка Accept-Language в порядке убывания веса.
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		lang string
+		q    float64
+	}
+	var langs []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		lang := NormalizeLocale(tag)
+		if lang == "" || lang == "*" {
+			continue
+		}
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q > 0 {
+			langs = append(langs, weighted{lang: lang, q: q})
+		}
+	}
+	sort.SliceStable(langs, func(i, j int) bool { return langs[i].q > langs[j].q })
+
+	result := make([]string, 0, len(langs))
+	for _, l := range langs {
+		result = append(result, l.lang)
+	}
+	return result
+}
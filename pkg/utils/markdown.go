@@ -0,0 +1,155 @@
+package utils
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	chromahtml "github.com/alecthomas/chroma/v2/formatters/html"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	highlighting "github.com/yuin/goldmark-highlighting/v2"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/util"
+	nethtml "golang.org/x/net/html"
+)
+
+// markdown — конвертер Markdown в HTML с поддержкой GFM, якорей заголовков
+// и подсветки кода CSS-классами chroma.
+var markdown = goldmark.New(
+	goldmark.WithExtensions(
+		extension.GFM,
+		highlighting.NewHighlighting(
+			highlighting.WithFormatOptions(chromahtml.WithClasses(true)),
+		),
+	),
+	goldmark.WithParserOptions(parser.WithAutoHeadingID()),
+	goldmark.WithRendererOptions(
+		html.WithUnsafe(),
+		renderer.WithNodeRenderers(util.Prioritized(rawHTMLRenderer{}, 100)),
+	),
+)
+
+// MarkdownPolicy — политика санитизации HTML, полученного из Markdown.
+// Дополнительно к UGCPolicy разрешает классы подсветки кода и якоря заголовков.
+var MarkdownPolicy = newMarkdownPolicy()
+
+func newMarkdownPolicy() *bluemonday.Policy {
+	policy := bluemonday.UGCPolicy()
+	policy.AllowAttrs("class").Matching(regexp.MustCompile(`^[a-zA-Z0-9\-_ ]+$`)).OnElements("pre", "code", "span")
+	policy.AllowAttrs("id").Matching(regexp.MustCompile(`^[\p{L}\p{N}\-_]+$`)).OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	// Из полей ввода допускаются только флажки списков задач GFM
+	policy.AllowAttrs("type").Matching(regexp.MustCompile(`^checkbox$`)).OnElements("input")
+	policy.AllowAttrs("checked", "disabled").OnElements("input")
+	return policy
+}
+
+// rawHTMLRenderer выводит HTML, вставленный в Markdown напрямую, без атрибутов id и class.
+// MarkdownPolicy разрешает их только ради якорей заголовков и подсветки кода, которые создаёт
+// сам рендерер; из исходного текста они позволили бы подменять элементы страницы.
+type rawHTMLRenderer struct{}
+
+func (r rawHTMLRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(ast.KindRawHTML, r.renderRawHTML)
+	reg.Register(ast.KindHTMLBlock, r.renderHTMLBlock)
+}
+
+func (r rawHTMLRenderer) renderRawHTML(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		segments := node.(*ast.RawHTML).Segments
+		var raw bytes.Buffer
+		for i := 0; i < segments.Len(); i++ {
+			segment := segments.At(i)
+			raw.Write(segment.Value(source))
+		}
+		_, _ = w.WriteString(stripIdentityAttrs(raw.String()))
+	}
+	return ast.WalkSkipChildren, nil
+}
+
+func (r rawHTMLRenderer) renderHTMLBlock(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if entering {
+		block := node.(*ast.HTMLBlock)
+		var raw bytes.Buffer
+		for i := 0; i < block.Lines().Len(); i++ {
+			line := block.Lines().At(i)
+			raw.Write(line.Value(source))
+		}
+		if block.HasClosure() {
+			raw.Write(block.ClosureLine.Value(source))
+		}
+		_, _ = w.WriteString(stripIdentityAttrs(raw.String()))
+	}
+	return ast.WalkContinue, nil
+}
+
+// stripIdentityAttrs удаляет атрибуты id и class из тегов фрагмента HTML.
+// Остальная разметка выводится как есть и очищается MarkdownPolicy.
+func stripIdentityAttrs(fragment string) string {
+	var b strings.Builder
+	tokenizer := nethtml.NewTokenizer(strings.NewReader(fragment))
+	for {
+		if tokenizer.Next() == nethtml.ErrorToken {
+			return b.String()
+		}
+		raw := string(tokenizer.Raw())
+		token := tokenizer.Token()
+		if token.Type != nethtml.StartTagToken && token.Type != nethtml.SelfClosingTagToken {
+			b.WriteString(raw)
+			continue
+		}
+		attrs := token.Attr[:0]
+		for _, attr := range token.Attr {
+			if attr.Key != "id" && attr.Key != "class" {
+				attrs = append(attrs, attr)
+			}
+		}
+		if len(attrs) == len(token.Attr) {
+			b.WriteString(raw)
+			continue
+		}
+		token.Attr = attrs
+		b.WriteString(token.String())
+	}
+}
+
+// headingIDs формирует якоря заголовков через Slugify, сохраняя кириллицу,
+// и добавляет числовой суффикс к повторяющимся якорям.
+type headingIDs struct {
+	used map[string]struct{}
+}
+
+func (h *headingIDs) Generate(value []byte, kind ast.NodeKind) []byte {
+	base := Slugify(string(value))
+	if base == "" {
+		base = "heading"
+	}
+	id := base
+	for i := 1; ; i++ {
+		if _, exists := h.used[id]; !exists {
+			break
+		}
+		id = base + "-" + strconv.Itoa(i)
+	}
+	h.used[id] = struct{}{}
+	return []byte(id)
+}
+
+func (h *headingIDs) Put(value []byte) {
+	h.used[string(value)] = struct{}{}
+}
+
+// RenderMarkdown преобразует Markdown в безопасный HTML.
+func RenderMarkdown(source string) (string, error) {
+	var buf bytes.Buffer
+	ctx := parser.NewContext(parser.WithIDs(&headingIDs{used: make(map[string]struct{})}))
+	if err := markdown.Convert([]byte(source), &buf, parser.WithContext(ctx)); err != nil {
+		return "", err
+	}
+	return MarkdownPolicy.Sanitize(buf.String()), nil
+}
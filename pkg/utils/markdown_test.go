@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  string
+		want    []string // Фрагменты, которые должны быть в HTML.
+		notWant []string // Фрагменты, которых в HTML быть не должно.
+	}{
+		{
+			name:    "script tag",
+			source:  "text <script>alert(1)</script>\n\n<script>\nalert(2)\n</script>",
+			want:    []string{"<p>text"},
+			notWant: []string{"<script", "alert("},
+		},
+		{
+			name:    "javascript link in markdown",
+			source:  "[click](javascript:alert(1)) and [ok](https://example.com)",
+			want:    []string{`<a href="https://example.com" rel="nofollow">ok</a>`},
+			notWant: []string{"javascript:"},
+		},
+		{
+			name:    "javascript link in raw html",
+			source:  `<a href="JavaScript:alert(1)">click</a>`,
+			notWant: []string{"href", "alert"},
+		},
+		{
+			name:    "event handler attributes",
+			source:  `<img src="cat.png" onerror="alert(1)"> <b onmouseover="alert(2)">bold</b>`,
+			want:    []string{`<img src="cat.png">`, "<b>bold</b>"},
+			notWant: []string{"onerror", "onmouseover"},
+		},
+		{
+			name:    "id and class in inline raw html",
+			source:  `text <span id="login-form" class="chroma">x</span>`,
+			want:    []string{"<span>x</span>"},
+			notWant: []string{"login-form", "class="},
+		},
+		{
+			name:    "id and class in raw html block",
+			source:  "<h2 id=\"comments\" class=\"admin\" title=\"t\">Injected</h2>\n\n## Real",
+			want:    []string{`<h2 title="t">Injected</h2>`, `<h2 id="real">Real</h2>`},
+			notWant: []string{"comments", "admin"},
+		},
+		{
+			name:    "heading attribute syntax is not parsed",
+			source:  "## Title {#login .admin}",
+			notWant: []string{`id="login"`, `class="admin"`},
+		},
+		{
+			name:   "duplicate cyrillic headings",
+			source: "## Введение\n\n## Введение",
+			want:   []string{`<h2 id="введение">`, `<h2 id="введение-1">`},
+		},
+		{
+			name:   "fenced code is highlighted with chroma classes",
+			source: "```go\nfunc main() {}\n```",
+			want:   []string{`<pre class="chroma">`, `<span class="kd">func</span>`, `<span class="nf">main</span>`},
+		},
+		{
+			name:    "markup inside fenced code is escaped",
+			source:  "```html\n<script>alert(1)</script>\n```",
+			want:    []string{"&lt;", "script"},
+			notWant: []string{"<script"},
+		},
+		{
+			name:   "task list checkbox",
+			source: "- [x] done",
+			want:   []string{`<input checked="" disabled="" type="checkbox">`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderMarkdown(tt.source)
+			if err != nil {
+				t.Fatalf("RenderMarkdown() error = %v", err)
+			}
+			for _, fragment := range tt.want {
+				if !strings.Contains(got, fragment) {
+					t.Errorf("RenderMarkdown() = %q, want it to contain %q", got, fragment)
+				}
+			}
+			for _, fragment := range tt.notWant {
+				if strings.Contains(got, fragment) {
+					t.Errorf("RenderMarkdown() = %q, want no %q", got, fragment)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package utils
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Параметры пагинации по умолчанию.
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+// GetPaginationFromQuery извлекает limit и offset из query-параметров запроса.
+// Отсутствующие значения заменяются значениями по умолчанию, limit ограничивается MaxPageLimit.
+func GetPaginationFromQuery(ctx *gin.Context) (int, int, error) {
+	limit := DefaultPageLimit
+	offset := 0
+
+	if limitStr := ctx.Query("limit"); limitStr != "" {
+		parsed, err := strconv.Atoi(limitStr)
+		if err != nil || parsed <= 0 {
+			return 0, 0, errors.New("invalid limit")
+		}
+		limit = parsed
+	}
+	if limit > MaxPageLimit {
+		limit = MaxPageLimit
+	}
+
+	if offsetStr := ctx.Query("offset"); offsetStr != "" {
+		parsed, err := strconv.Atoi(offsetStr)
+		if err != nil || parsed < 0 {
+			return 0, 0, errors.New("invalid offset")
+		}
+		offset = parsed
+	}
+
+	return limit, offset, nil
+}
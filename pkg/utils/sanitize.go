@@ -1,9 +1,18 @@
 package utils
 
-import "github.com/microcosm-cc/bluemonday"
+import (
+	"html"
+
+	"github.com/microcosm-cc/bluemonday"
+)
 
 var Policy = bluemonday.UGCPolicy()
 
 func Sanitize(s string) string {
 	return Policy.Sanitize(s)
 }
+
+// StripHTML удаляет всю HTML-разметку и возвращает простой текст.
+func StripHTML(s string) string {
+	return html.UnescapeString(bluemonday.StrictPolicy().Sanitize(s))
+}
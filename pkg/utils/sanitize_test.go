@@ -0,0 +1,31 @@
+package utils
+
+import "testing"
+
+func TestStripHTML(t *testing.T) {
+	cases := map[string]string{
+		"<p>Hello <b>world</b></p>":         "Hello world",
+		"<script>alert(1)</script>text":     "text",
+		"<p>Tom &amp; Jerry&#39;s</p>":      "Tom & Jerry's",
+		`<a href="javascript:x()">link</a>`: "link",
+	}
+	for in, want := range cases {
+		if got := StripHTML(in); got != want {
+			t.Errorf("StripHTML(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestStripHTMLKeepsEscapedMarkupAsText(t *testing.T) {
+	cases := map[string]string{
+		"Use the <code>&lt;br&gt;</code> tag":                       "Use the <br> tag",
+		"I love &lt;3 and a &lt; b":                                 "I love <3 and a < b",
+		`<pre><span class="nt">&lt;script&gt;</span>alert(1)</pre>`: "<script>alert(1)",
+		"&amp;lt;b&amp;gt;":                                         "&lt;b&gt;",
+	}
+	for in, want := range cases {
+		if got := StripHTML(in); got != want {
+			t.Errorf("StripHTML(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
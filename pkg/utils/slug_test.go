@@ -0,0 +1,34 @@
+package utils
+
+import "testing"
+
+func TestSlugify(t *testing.T) {
+	cases := map[string]string{
+		"Hello World":          "hello-world",
+		"Новости Науки":        "новости-науки",
+		"Go 1.22 — что нового": "go-1-22-что-нового",
+		"C++ / C#":             "c-c",
+		"  -Tag!- ":            "tag",
+		"a  --  b":             "a-b",
+	}
+	for in, want := range cases {
+		if got := Slugify(in); got != want {
+			t.Errorf("Slugify(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestSlugifyWithoutLettersOrDigits(t *testing.T) {
+	for _, in := range []string{"", "   ", "!!! ???", "—"} {
+		if got := Slugify(in); got != "" {
+			t.Errorf("Slugify(%q) = %q, want empty slug", in, got)
+		}
+	}
+}
+
+func TestSlugifyIsIdempotent(t *testing.T) {
+	slug := Slugify("Машинное обучение: введение")
+	if again := Slugify(slug); again != slug {
+		t.Errorf("Slugify(%q) = %q, want the slug unchanged", slug, again)
+	}
+}
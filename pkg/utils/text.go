@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Excerpt нормализует пробелы и обрезает текст до maxLength символов по границе слова.
+func Excerpt(text string, maxLength int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) <= maxLength {
+		return text
+	}
+	cut := string([]rune(text)[:maxLength-1])
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+	return cut + "…"
+}
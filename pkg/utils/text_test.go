@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestExcerptKeepsShortText(t *testing.T) {
+	if got := Excerpt("Привет,\n\t мир", 11); got != "Привет, мир" {
+		t.Errorf("Excerpt() = %q, want whitespace normalized and the text kept", got)
+	}
+}
+
+func TestExcerptCutsAtWordBoundary(t *testing.T) {
+	if got := Excerpt("Tom & Jerry & Spike", 13); got != "Tom & Jerry…" {
+		t.Errorf("Excerpt() = %q, want %q", got, "Tom & Jerry…")
+	}
+	if got := Excerpt("Supercalifragilistic", 6); got != "Super…" {
+		t.Errorf("Excerpt() of a single long word = %q, want %q", got, "Super…")
+	}
+}
+
+func TestExcerptNeverExceedsLimit(t *testing.T) {
+	text := strings.Repeat("слово ", 100)
+	for _, limit := range []int{1, 2, 10, 160} {
+		if got := Excerpt(text, limit); utf8.RuneCountInString(got) > limit {
+			t.Errorf("Excerpt(_, %d) = %q has %d characters", limit, got, utf8.RuneCountInString(got))
+		}
+	}
+}